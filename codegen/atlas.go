@@ -0,0 +1,239 @@
+// Copyright (c) 2020 Sam Blenny
+// SPDX-License-Identifier: Apache-2.0 OR MIT
+//
+package main
+
+import (
+	"fmt"
+	"guilib/codegen/font"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path"
+	"strings"
+)
+
+// Colors of the atlas images
+var atlasPalette = color.Palette{
+	color.Gray{0xff},                   // Background of a cell
+	color.Gray{0x00},                   // Set pixels and labels
+	color.Gray{0xa0},                   // Lines between cells
+	color.Gray{0xe0},                   // Pattern box (w x h at yOffset) of a glyph
+	color.RGBA{0xff, 0x90, 0x90, 0xff}, // Baseline
+	color.Gray{0xaa},                   // Pixels with 1/3 coverage (2-bit patterns)
+	color.Gray{0x55},                   // Pixels with 2/3 coverage (2-bit patterns)
+}
+
+// Indexes into atlasPalette
+const (
+	atlasBackground = iota
+	atlasInk
+	atlasGrid
+	atlasBox
+	atlasBaseline
+	atlasInkLight
+	atlasInkMid
+)
+
+// Return the palette index for a set pixel of a pattern with 1 or 2 bits per
+// pixel
+func atlasInkIndex(px int, bits int) uint8 {
+	if bits == 2 {
+		return [4]uint8{atlasBackground, atlasInkLight, atlasInkMid, atlasInk}[px&3]
+	}
+	return atlasInk
+}
+
+// Layout of atlas cells, in px
+const (
+	atlasPad         = 2 // Space around the line box and label of a cell
+	atlasCharW       = 4 // Advance of a label character (3 px glyph + 1 px space)
+	atlasLineH       = 6 // Advance of a label line (5 px glyph + 1 px space)
+	atlasDefaultCols = 16
+)
+
+// 3x5 px glyphs for labels, one string of 5 rows per character
+var atlasLabelFont = map[rune]string{
+	'0': "###" + "#.#" + "#.#" + "#.#" + "###",
+	'1': ".#." + "##." + ".#." + ".#." + "###",
+	'2': "###" + "..#" + "###" + "#.." + "###",
+	'3': "###" + "..#" + ".##" + "..#" + "###",
+	'4': "#.#" + "#.#" + "###" + "..#" + "..#",
+	'5': "###" + "#.." + "###" + "..#" + "###",
+	'6': "###" + "#.." + "###" + "#.#" + "###",
+	'7': "###" + "..#" + ".#." + ".#." + ".#.",
+	'8': "###" + "#.#" + "###" + "#.#" + "###",
+	'9': "###" + "#.#" + "###" + "..#" + "###",
+	'a': ".#." + "#.#" + "###" + "#.#" + "#.#",
+	'b': "##." + "#.#" + "##." + "#.#" + "##.",
+	'c': ".##" + "#.." + "#.." + "#.." + ".##",
+	'd': "##." + "#.#" + "#.#" + "#.#" + "##.",
+	'e': "###" + "#.." + "##." + "#.." + "###",
+	'f': "###" + "#.." + "##." + "#.." + "#..",
+	'-': "..." + "..." + "###" + "..." + "...",
+}
+
+// Decode the packed glyph patterns in the DATA array of each font, and render
+// them into a PNG atlas image in dir, named after the font. Each cell of the
+// grid has the line box of the font with the glyph at its yOffset, so glyphs
+// share a common baseline, and the hex grapheme cluster of the glyph below it.
+func writeAtlases(dir string) {
+	fonts, err := readManifest(manifestFile)
+	if err != nil {
+		reportErrors(err)
+		os.Exit(1)
+	}
+	var errs font.ErrorList
+	for _, f := range fonts {
+		// Keep going after problems with the character map or aliases so that
+		// problems with the sprite sheet get reported too, but only skip the
+		// atlas of the font with problems
+		var fontErrs font.ErrorList
+		csList, err := f.CharSpecs()
+		fontErrs.Add(err)
+		aliasList, err := f.GCAliases()
+		fontErrs.Add(err)
+		rb, err := rustyBlitsForFont(f.Spec, csList, aliasList)
+		fontErrs.Add(err)
+		if fontErrs.Err() != nil {
+			errs.Add(fontErrs)
+			continue
+		}
+		img, err := renderAtlas(f.Spec, rb)
+		if err != nil {
+			errs.Add(err)
+			continue
+		}
+		name := path.Join(dir, cIdentifier(f.Spec.Name)+"_atlas.png")
+		fmt.Println("Writing to", name)
+		if err := writePNGFile(name, img); err != nil {
+			errs.Add(&font.FileError{Context: font.Context{Font: f.Spec.Name, File: name}, Err: err})
+		}
+	}
+	if errs.Err() != nil {
+		reportErrors(errs)
+		os.Exit(1)
+	}
+}
+
+// Render the packed glyph patterns of rb.Data into an atlas image. Patterns
+// are decoded from their headers, and the length of each one must match the
+// pattern it was packed from.
+func renderAtlas(fs font.FontSpec, rb RustyBlits) (image.Image, error) {
+	type atlasGlyph struct {
+		pxMatrix font.Matrix
+		width    int
+		yOffset  int
+		bits     int
+		label    []string
+	}
+	var glyphs []atlasGlyph
+	lineBoxH := fs.Size
+	innerW := 6*atlasCharW - 1 // Widest codepoint label, like "1f3f4-"
+	offset := 0
+	for _, p := range rb.Patterns {
+		if offset >= len(rb.Data) {
+			return nil, fmt.Errorf("%s: DATA ends before the pattern for %s", fs.Name, p.CS.HexCluster)
+		}
+		header := rb.Data[offset]
+		w := int((header >> 16) & 0xff)
+		h := int((header >> 8) & 0xff)
+		n := font.PatternWords(header)
+		if n != len(p.Bytes) || offset+n > len(rb.Data) {
+			return nil, fmt.Errorf("%s: pattern header at DATA[%d] for %s gives %d words, but the pattern has %d",
+				fs.Name, offset, p.CS.HexCluster, n, len(p.Bytes))
+		}
+		pxMatrix, yOffset := font.ConvertPatternToMatrix(rb.Data[offset : offset+n])
+		offset += n
+		if w > innerW {
+			innerW = w
+		}
+		if int(yOffset)+h > lineBoxH {
+			lineBoxH = int(yOffset) + h
+		}
+		glyphs = append(glyphs, atlasGlyph{pxMatrix, w, int(yOffset), font.PatternBits(header), nil})
+	}
+	// Wrap labels to fit the cell width, breaking lines between codepoints
+	labelLines := 1
+	for i, p := range rb.Patterns {
+		var lines []string
+		for _, hexCP := range strings.Split(strings.ToLower(p.CS.HexCluster), "-") {
+			n := len(lines)
+			if n > 0 && (len(lines[n-1])+1+len(hexCP))*atlasCharW-1 <= innerW {
+				lines[n-1] += "-" + hexCP
+			} else {
+				lines = append(lines, hexCP)
+			}
+		}
+		glyphs[i].label = lines
+		if len(lines) > labelLines {
+			labelLines = len(lines)
+		}
+	}
+	cols := fs.Cols
+	if cols < 1 {
+		cols = atlasDefaultCols
+	}
+	rows := (len(glyphs) + cols - 1) / cols
+	cellW := innerW + 2*atlasPad
+	cellH := lineBoxH + labelLines*atlasLineH + 3*atlasPad
+	img := image.NewPaletted(image.Rect(0, 0, cols*(cellW+1)+1, rows*(cellH+1)+1), atlasPalette)
+	for i := range img.Pix {
+		img.Pix[i] = atlasGrid
+	}
+	for i, g := range glyphs {
+		x0 := 1 + (i%cols)*(cellW+1)
+		y0 := 1 + (i/cols)*(cellH+1)
+		fillRect(img, x0, y0, cellW, cellH, atlasBackground)
+		top := y0 + atlasPad
+		fillRect(img, x0+atlasPad, top+fs.Ascent, innerW, 1, atlasBaseline)
+		left := x0 + atlasPad + (innerW-g.width)/2
+		fillRect(img, left, top+g.yOffset, g.width, len(g.pxMatrix), atlasBox)
+		for y, row := range g.pxMatrix {
+			for x, px := range row {
+				if px > 0 {
+					img.SetColorIndex(left+x, top+g.yOffset+y, atlasInkIndex(px, g.bits))
+				}
+			}
+		}
+		labelTop := top + lineBoxH + atlasPad
+		for n, line := range g.label {
+			drawLabel(img, x0+atlasPad, labelTop+n*atlasLineH, line)
+		}
+	}
+	return img, nil
+}
+
+// Fill a rectangle of an atlas image with a palette color
+func fillRect(img *image.Paletted, x0 int, y0 int, w int, h int, c uint8) {
+	for y := y0; y < y0+h; y++ {
+		for x := x0; x < x0+w; x++ {
+			img.SetColorIndex(x, y, c)
+		}
+	}
+}
+
+// Draw a line of label text with the top left corner at (x0, y0)
+func drawLabel(img *image.Paletted, x0 int, y0 int, text string) {
+	for i, r := range text {
+		for n, px := range atlasLabelFont[r] {
+			if px == '#' {
+				img.SetColorIndex(x0+i*atlasCharW+n%3, y0+n/3, atlasInk)
+			}
+		}
+	}
+}
+
+// Encode an image to the specified PNG file
+func writePNGFile(name string, img image.Image) error {
+	pngFile, err := os.Create(name)
+	if err != nil {
+		return err
+	}
+	if err := png.Encode(pngFile, img); err != nil {
+		pngFile.Close()
+		return err
+	}
+	return pngFile.Close()
+}
@@ -0,0 +1,301 @@
+// Copyright (c) 2020 Sam Blenny
+// SPDX-License-Identifier: Apache-2.0 OR MIT
+//
+
+// Package blob reads and writes fonts as self-describing binary blobs, so a
+// font can live in its own flash partition (or file) and be swapped without
+// rebuilding the code that uses it.
+//
+// Blob layout (all offsets are in bytes from the start of the blob, and all
+// multi-byte fields use the byte order given by the byte order mark):
+//
+//  Header (BaseHeaderSize bytes):
+//   [0..4]:   Magic "GFNT"
+//   [4..6]:   Byte order mark, u16 0xFEFF written in the blob's byte order
+//   [6..8]:   Format version (u16): 1 when all patterns are 1-bit (format 0),
+//             or 2 when some have 2-bit coverage values (format 1)
+//   [8..10]:  Header size (u16)
+//   [10..12]: Maximum glyph height (u16)
+//   [12..16]: Murmur3 seed for the hash tables (u32)
+//   [16..20]: Number of Unicode blocks (u32)
+//   [20..24]: Offset of block table (u32)
+//   [24..28]: Offset of DATA (u32)
+//   [28..32]: Length of DATA in u32 words (u32)
+//   [32..36]: Total length of blob, including checksum (u32)
+//
+//  Extended header (only when the header size is HeaderSize, not BaseHeaderSize):
+//   [36..40]: Flags for the optional sections the blob has (u32): FlagLineMetrics,
+//             FlagGlyphMetrics, FlagKerning, and FlagClusters
+//   [40..42]: Ascent, px from the top of the line down to the baseline (u16)
+//   [42..44]: Descent, px from the baseline down to the bottom of the line (u16)
+//   [44..48]: Number of glyph metrics records (u32)
+//   [48..52]: Offset of glyph metrics table (u32)
+//   [52..56]: Number of kerning pairs (u32)
+//   [56..60]: Offset of kerning table (u32)
+//   [60..64]: Offset of cluster table (u32)
+//  Fields for sections that are not flagged are 0. Blobs with no optional
+//  sections use the base header, so older readers can still load them.
+//
+//  Block table (BlockEntrySize bytes per block, sorted by first codepoint):
+//   [0..4]:   First codepoint of Unicode block (u32)
+//   [4..8]:   Last codepoint of Unicode block (u32)
+//   [8..12]:  Number of index entries (u32)
+//   [12..16]: Offset of hash table, n * u32 murmur3 hashes sorted ascending
+//   [16..20]: Offset of offset table, n * u32 DATA offsets; sort matches hashes
+//   [20..24]: Offset of cluster length list, m * u8 codepoint counts
+//   [24..28]: Number of cluster lengths (m) (u32)
+//
+//  Tables: hash, offset, and cluster length tables for each block, with each
+//  table padded to a multiple of 4 bytes
+//
+//  Glyph metrics table (FlagGlyphMetrics): n * u32 DATA offsets of glyphs that
+//  do not have the default metrics, sorted ascending, then n * u32 records of
+//  ((advance as u16) << 16) | (left bearing as i16 as u16); sort matches offsets
+//
+//  Kerning table (FlagKerning): n * u32 DATA offsets of left glyphs, n * u32
+//  DATA offsets of right glyphs, and n * i8 px adjustments to the advance of the
+//  left glyph, padded to a multiple of 4 bytes. Pairs are sorted by left offset,
+//  then right offset.
+//
+//  Cluster table (FlagClusters): ClusterEntrySize bytes per block, in block
+//  table order, for checking that a hash match is not a collision:
+//   [0..4]:   Offset of cluster start list, n+1 * u32 indexes into the
+//             codepoint list; sort matches hashes, and the last one is the end
+//   [4..8]:   Offset of codepoint list, u32 codepoints of each cluster packed
+//             end to end
+//
+//  DATA: Packed blit patterns as u32 words, with the same record format as the
+//  DATA array in generated rust font files
+//
+//  Checksum: CRC-32 (IEEE) of all preceding bytes of the blob (u32)
+package blob
+
+import (
+	"encoding/binary"
+	"hash/crc32"
+	"unicode/utf8"
+)
+
+const (
+	Magic            = "GFNT"
+	Version          = 2  // Newest format version, for blobs with 2-bit patterns
+	Version1Bit      = 1  // Format version for blobs with only 1-bit patterns
+	HeaderSize       = 64 // Size of the extended header
+	BaseHeaderSize   = 36 // Size of the header for blobs with no optional sections
+	BlockEntrySize   = 28
+	ClusterEntrySize = 8
+	byteOrderMark    = 0xFEFF
+)
+
+// Header flags for optional sections
+const (
+	FlagLineMetrics  = 1 << iota // Ascent and descent
+	FlagGlyphMetrics             // Glyph metrics table
+	FlagKerning                  // Kerning table
+	FlagClusters                 // Cluster table
+	knownFlags       = FlagLineMetrics | FlagGlyphMetrics | FlagKerning | FlagClusters
+)
+
+// Holds a font's glyph patterns and grapheme cluster index, along with the
+// optional line metrics, glyph metrics, and kerning pairs
+type Font struct {
+	MaxHeight int
+	Seed      uint32
+	Ascent    int // Line metrics; both 0 when the blob has none
+	Descent   int
+	Metrics   []Metrics // Glyphs without the default metrics, sorted by Offset
+	Kerns     []Kern    // Sorted by Left, then Right
+	Blocks    []Block
+	Data      []uint32
+}
+
+// Holds the metrics of the glyph with its pattern at DATA[Offset]
+type Metrics struct {
+	Offset  uint32
+	Advance int // Px to move the pen right after drawing the glyph
+	Bearing int // Px from the pen position right to the left of the pattern
+}
+
+// Holds a kerning pair of glyphs by the DATA offsets of their patterns
+type Kern struct {
+	Left   uint32
+	Right  uint32
+	Adjust int // Px to add to the advance of the left glyph
+}
+
+// Holds the grapheme cluster index for one Unicode block
+type Block struct {
+	Low      uint32
+	High     uint32
+	GCLens   []int    // Cluster lengths to try, longest first
+	Hashes   []uint32 // murmur3(grapheme cluster), sorted
+	Offsets  []uint32 // DATA offsets; sort matches Hashes
+	Clusters []string // Grapheme clusters to confirm matches; sort matches Hashes
+}
+
+// Return the format version for a font. Fonts with only 1-bit patterns keep
+// version 1, so readers that predate 2-bit patterns can still load them.
+func (f *Font) version() int {
+	for _, b := range f.Blocks {
+		for _, offset := range b.Offsets {
+			if int(offset) < len(f.Data) && f.Data[offset]>>24 != 0 {
+				return Version
+			}
+		}
+	}
+	return Version1Bit
+}
+
+// Return the header flags for the optional sections a font has
+func (f *Font) flags() uint32 {
+	var flags uint32
+	if f.Ascent != 0 || f.Descent != 0 {
+		flags |= FlagLineMetrics
+	}
+	if len(f.Metrics) > 0 {
+		flags |= FlagGlyphMetrics
+	}
+	if len(f.Kerns) > 0 {
+		flags |= FlagKerning
+	}
+	for _, b := range f.Blocks {
+		if len(b.Clusters) > 0 {
+			flags |= FlagClusters
+		}
+	}
+	return flags
+}
+
+// Return the size of a table after padding to a multiple of 4 bytes
+func padded(n int) int {
+	return (n + 3) &^ 3
+}
+
+// Encode a font as a binary blob using the specified byte order. Blocks must
+// be sorted by first codepoint. When any block has Clusters, every block needs
+// one cluster for each hash.
+func Encode(f *Font, order binary.ByteOrder) []byte {
+	// Work out where everything goes
+	flags := f.flags()
+	headerSize := BaseHeaderSize
+	if flags != 0 {
+		headerSize = HeaderSize
+	}
+	blockTable := headerSize
+	tables := blockTable + len(f.Blocks)*BlockEntrySize
+	size := tables
+	for _, b := range f.Blocks {
+		size += 8*len(b.Hashes) + padded(len(b.GCLens))
+	}
+	metricsTable := 0
+	if flags&FlagGlyphMetrics != 0 {
+		metricsTable = size
+		size += 8 * len(f.Metrics)
+	}
+	kernTable := 0
+	if flags&FlagKerning != 0 {
+		kernTable = size
+		size += 8*len(f.Kerns) + padded(len(f.Kerns))
+	}
+	clusterTable := 0
+	if flags&FlagClusters != 0 {
+		clusterTable = size
+		size += len(f.Blocks) * ClusterEntrySize
+		for _, b := range f.Blocks {
+			size += 4 * (len(b.Clusters) + 1)
+			for _, cluster := range b.Clusters {
+				size += 4 * utf8.RuneCountInString(cluster)
+			}
+		}
+	}
+	dataOffset := size
+	size += 4*len(f.Data) + 4
+	buf := make([]byte, size)
+	// Header
+	copy(buf[0:4], Magic)
+	order.PutUint16(buf[4:], byteOrderMark)
+	order.PutUint16(buf[6:], uint16(f.version()))
+	order.PutUint16(buf[8:], uint16(headerSize))
+	order.PutUint16(buf[10:], uint16(f.MaxHeight))
+	order.PutUint32(buf[12:], f.Seed)
+	order.PutUint32(buf[16:], uint32(len(f.Blocks)))
+	order.PutUint32(buf[20:], uint32(blockTable))
+	order.PutUint32(buf[24:], uint32(dataOffset))
+	order.PutUint32(buf[28:], uint32(len(f.Data)))
+	order.PutUint32(buf[32:], uint32(size))
+	if headerSize == HeaderSize {
+		order.PutUint32(buf[36:], flags)
+		order.PutUint16(buf[40:], uint16(f.Ascent))
+		order.PutUint16(buf[42:], uint16(f.Descent))
+		order.PutUint32(buf[48:], uint32(metricsTable))
+		order.PutUint32(buf[56:], uint32(kernTable))
+		order.PutUint32(buf[60:], uint32(clusterTable))
+		if metricsTable != 0 {
+			order.PutUint32(buf[44:], uint32(len(f.Metrics)))
+		}
+		if kernTable != 0 {
+			order.PutUint32(buf[52:], uint32(len(f.Kerns)))
+		}
+	}
+	// Block table and index tables
+	next := tables
+	for i, b := range f.Blocks {
+		entry := buf[blockTable+i*BlockEntrySize:]
+		n := len(b.Hashes)
+		order.PutUint32(entry[0:], b.Low)
+		order.PutUint32(entry[4:], b.High)
+		order.PutUint32(entry[8:], uint32(n))
+		order.PutUint32(entry[12:], uint32(next))
+		order.PutUint32(entry[16:], uint32(next+4*n))
+		order.PutUint32(entry[20:], uint32(next+8*n))
+		order.PutUint32(entry[24:], uint32(len(b.GCLens)))
+		for j := 0; j < n; j++ {
+			order.PutUint32(buf[next+4*j:], b.Hashes[j])
+			order.PutUint32(buf[next+4*(n+j):], b.Offsets[j])
+		}
+		next += 8 * n
+		for j, gcLen := range b.GCLens {
+			buf[next+j] = byte(gcLen)
+		}
+		next += padded(len(b.GCLens))
+	}
+	// Glyph metrics table
+	n := len(f.Metrics)
+	for i, m := range f.Metrics {
+		order.PutUint32(buf[metricsTable+4*i:], m.Offset)
+		order.PutUint32(buf[metricsTable+4*(n+i):], uint32(m.Advance)<<16|uint32(uint16(int16(m.Bearing))))
+	}
+	// Kerning table
+	n = len(f.Kerns)
+	for i, k := range f.Kerns {
+		order.PutUint32(buf[kernTable+4*i:], k.Left)
+		order.PutUint32(buf[kernTable+4*(n+i):], k.Right)
+		buf[kernTable+8*n+i] = byte(int8(k.Adjust))
+	}
+	// Cluster table, followed by the start and codepoint lists of each block
+	if clusterTable != 0 {
+		next = clusterTable + len(f.Blocks)*ClusterEntrySize
+		for i, b := range f.Blocks {
+			entry := buf[clusterTable+i*ClusterEntrySize:]
+			order.PutUint32(entry[0:], uint32(next))
+			codepoints := next + 4*(len(b.Clusters)+1)
+			order.PutUint32(entry[4:], uint32(codepoints))
+			start := 0
+			for j, cluster := range b.Clusters {
+				order.PutUint32(buf[next+4*j:], uint32(start))
+				for _, c := range cluster {
+					order.PutUint32(buf[codepoints+4*start:], uint32(c))
+					start++
+				}
+			}
+			order.PutUint32(buf[next+4*len(b.Clusters):], uint32(start))
+			next = codepoints + 4*start
+		}
+	}
+	// Glyph patterns
+	for i, word := range f.Data {
+		order.PutUint32(buf[dataOffset+4*i:], word)
+	}
+	order.PutUint32(buf[size-4:], crc32.ChecksumIEEE(buf[:size-4]))
+	return buf
+}
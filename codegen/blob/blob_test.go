@@ -0,0 +1,230 @@
+// Copyright (c) 2020 Sam Blenny
+// SPDX-License-Identifier: Apache-2.0 OR MIT
+//
+package blob
+
+import (
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// Return a small font with glyphs for "A" and "B" in one block. The "A"
+// pattern is 3x2 px, and the "B" pattern is 2x2 px.
+func testFont() *Font {
+	f := &Font{
+		MaxHeight: 4,
+		Seed:      0,
+		Data: []uint32{
+			0x00030201, 0xB6000000, // "A"
+			0x00020200, 0xF0000000, // "B"
+		},
+	}
+	b := Block{Low: 0x20, High: 0x7F, GCLens: []int{1}}
+	type entry struct{ hash, offset uint32 }
+	var entries []entry
+	for i, cluster := range []string{"A", "B"} {
+		hash, _ := Murmur3(cluster, f.Seed, 1)
+		entries = append(entries, entry{hash, uint32(2 * i)})
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].hash < entries[j].hash })
+	for _, e := range entries {
+		b.Hashes = append(b.Hashes, e.hash)
+		b.Offsets = append(b.Offsets, e.offset)
+	}
+	f.Blocks = []Block{b}
+	return f
+}
+
+// Return a blob with its checksum updated after changes to its contents
+func fixChecksum(buf []byte, order binary.ByteOrder) []byte {
+	order.PutUint32(buf[len(buf)-4:], crc32.ChecksumIEEE(buf[:len(buf)-4]))
+	return buf
+}
+
+// Decode a blob and make sure it fails with a FormatError that mentions msg
+func expectFormatError(t *testing.T, name string, buf []byte, msg string) {
+	t.Helper()
+	_, err := Decode(buf)
+	var fe *FormatError
+	if !errors.As(err, &fe) {
+		t.Errorf("%s: expected a FormatError, got %v", name, err)
+		return
+	}
+	if !strings.Contains(fe.Msg, msg) {
+		t.Errorf("%s: expected an error about %q, got %v", name, msg, err)
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	for _, order := range []binary.ByteOrder{binary.LittleEndian, binary.BigEndian} {
+		f := testFont()
+		buf := Encode(f, order)
+		if v := order.Uint16(buf[6:]); v != Version1Bit {
+			t.Errorf("%v: blob with only 1-bit patterns has version %d, expected %d", order, v, Version1Bit)
+		}
+		got, err := Decode(buf)
+		if err != nil {
+			t.Fatalf("%v: %v", order, err)
+		}
+		if !reflect.DeepEqual(got, f) {
+			t.Errorf("%v: decoded font does not match:\n got %+v\nwant %+v", order, got, f)
+		}
+		for i, cluster := range []string{"A", "B"} {
+			offset, bytesUsed, err := got.Lookup(cluster + "x")
+			if err != nil || offset != 2*i || bytesUsed != 1 {
+				t.Errorf("%v: Lookup(%q) = %d, %d, %v, expected %d, 1, nil", order, cluster+"x", offset, bytesUsed, err, 2*i)
+			}
+		}
+		if _, _, err := got.Lookup("C"); err != ErrGlyphNotFound {
+			t.Errorf("%v: Lookup(\"C\") = %v, expected ErrGlyphNotFound", order, err)
+		}
+	}
+}
+
+func TestRoundTrip2Bit(t *testing.T) {
+	// A 3x2 px pattern with 2-bit coverage values still fits in one word
+	f := testFont()
+	f.Data[0] |= 1 << 24
+	buf := Encode(f, binary.LittleEndian)
+	if v := binary.LittleEndian.Uint16(buf[6:]); v != Version {
+		t.Errorf("blob with 2-bit patterns has version %d, expected %d", v, Version)
+	}
+	got, err := Decode(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, f) {
+		t.Errorf("decoded font does not match:\n got %+v\nwant %+v", got, f)
+	}
+	if got.Bits(0) != 2 || got.Bits(2) != 1 {
+		t.Errorf("pattern bits are %d and %d, expected 2 and 1", got.Bits(0), got.Bits(2))
+	}
+	// Version 1 blobs can't have 2-bit patterns
+	binary.LittleEndian.PutUint16(buf[6:], Version1Bit)
+	expectFormatError(t, "2-bit pattern in version 1 blob", fixChecksum(buf, binary.LittleEndian), "unknown format 1")
+}
+
+// Return the test font with every optional section
+func testFontWithSections() *Font {
+	f := testFont()
+	f.Ascent, f.Descent = 3, 1
+	f.Metrics = []Metrics{{Offset: 0, Advance: 5, Bearing: -1}}
+	f.Kerns = []Kern{{Left: 0, Right: 2, Adjust: -1}}
+	b := &f.Blocks[0]
+	for _, hash := range b.Hashes {
+		for _, cluster := range []string{"A", "B"} {
+			if h, _ := Murmur3(cluster, f.Seed, 1); h == hash {
+				b.Clusters = append(b.Clusters, cluster)
+			}
+		}
+	}
+	return f
+}
+
+func TestRoundTripSections(t *testing.T) {
+	for _, order := range []binary.ByteOrder{binary.LittleEndian, binary.BigEndian} {
+		f := testFontWithSections()
+		buf := Encode(f, order)
+		if n, flags := order.Uint16(buf[8:]), order.Uint32(buf[36:]); n != HeaderSize || flags != knownFlags {
+			t.Errorf("%v: header size is %d with flags 0x%X, expected %d with 0x%X", order, n, flags, HeaderSize, knownFlags)
+		}
+		got, err := Decode(buf)
+		if err != nil {
+			t.Fatalf("%v: %v", order, err)
+		}
+		if !reflect.DeepEqual(got, f) {
+			t.Errorf("%v: decoded font does not match:\n got %+v\nwant %+v", order, got, f)
+		}
+		if adv, bearing := got.GlyphMetrics(0); adv != 5 || bearing != -1 {
+			t.Errorf("%v: metrics of \"A\" are %d, %d, expected 5, -1", order, adv, bearing)
+		}
+		if adv, bearing := got.GlyphMetrics(2); adv != 5 || bearing != 1 {
+			t.Errorf("%v: default metrics of \"B\" are %d, %d, expected 5, 1", order, adv, bearing)
+		}
+		if k, none := got.Kerning(0, 2), got.Kerning(2, 0); k != -1 || none != 0 {
+			t.Errorf("%v: kerning of \"AB\" and \"BA\" is %d and %d, expected -1 and 0", order, k, none)
+		}
+		if offset, _, err := got.Lookup("B"); err != nil || offset != 2 {
+			t.Errorf("%v: Lookup(\"B\") = %d, %v, expected 2, nil", order, offset, err)
+		}
+	}
+	// Fonts with only some of the sections leave the fields of the others at 0
+	f := testFont()
+	f.Kerns = []Kern{{Left: 2, Right: 0, Adjust: 2}}
+	buf := Encode(f, binary.LittleEndian)
+	if flags := binary.LittleEndian.Uint32(buf[36:]); flags != FlagKerning {
+		t.Errorf("font with only kerning has flags 0x%X, expected 0x%X", flags, FlagKerning)
+	}
+	if got, err := Decode(buf); err != nil || !reflect.DeepEqual(got, f) {
+		t.Errorf("font with only kerning decodes as %+v, %v", got, err)
+	}
+}
+
+func TestBadSections(t *testing.T) {
+	le := binary.LittleEndian
+	for _, c := range []struct {
+		name   string
+		change func(buf []byte)
+		msg    string
+	}{
+		{"unknown flag", func(buf []byte) { buf[39] = 0x80 }, "unknown flags"},
+		{"flag missing for a section", func(buf []byte) { buf[36] &^= FlagKerning }, "its section is not flagged"},
+		{"line metrics past max height", func(buf []byte) { le.PutUint16(buf[42:], 2) }, "is not max height"},
+		{"metrics for a bad offset", func(buf []byte) { le.PutUint32(buf[le.Uint32(buf[48:]):], 5) }, "past end of"},
+		{"metrics table past end", func(buf []byte) { le.PutUint32(buf[44:], 100) }, "outside of"},
+		{"kerning pair with no adjustment", func(buf []byte) { buf[le.Uint32(buf[56:])+8] = 0 }, "no adjustment"},
+		{"cluster with the wrong hash", func(buf []byte) {
+			codepoints := le.Uint32(buf[le.Uint32(buf[60:])+4:])
+			le.PutUint32(buf[codepoints:], 'C')
+		}, "has hash"},
+		{"cluster with a codepoint outside of its block", func(buf []byte) {
+			codepoints := le.Uint32(buf[le.Uint32(buf[60:])+4:])
+			le.PutUint32(buf[codepoints:], 0x100)
+		}, "is not valid here"},
+	} {
+		buf := Encode(testFontWithSections(), le)
+		c.change(buf)
+		expectFormatError(t, c.name, fixChecksum(buf, le), c.msg)
+	}
+}
+
+func TestTruncated(t *testing.T) {
+	buf := Encode(testFont(), binary.LittleEndian)
+	for _, n := range []int{0, 4, HeaderSize, len(buf) - 4, len(buf) - 1} {
+		_, err := Decode(buf[:n])
+		var fe *FormatError
+		if !errors.As(err, &fe) {
+			t.Errorf("blob truncated to %d of %d bytes: expected a FormatError, got %v", n, len(buf), err)
+		}
+	}
+}
+
+func TestBadChecksum(t *testing.T) {
+	buf := Encode(testFont(), binary.LittleEndian)
+	buf[len(buf)-8] ^= 0x01
+	expectFormatError(t, "changed pattern word", buf, "checksum")
+}
+
+func TestBadVersion(t *testing.T) {
+	buf := Encode(testFont(), binary.LittleEndian)
+	binary.LittleEndian.PutUint16(buf[6:], Version+1)
+	expectFormatError(t, "unknown version", fixChecksum(buf, binary.LittleEndian), "unsupported format version")
+}
+
+func TestBadPatternLength(t *testing.T) {
+	// The last pattern claims to be 16x4 px, which needs 2 words after its
+	// header, but DATA ends 1 word after it
+	f := testFont()
+	f.Data[2] = 0x00100400
+	expectFormatError(t, "pattern past end of DATA", Encode(f, binary.LittleEndian), "runs past end of DATA")
+	// Patterns must also fit within the max height
+	f = testFont()
+	f.Data[0] = 0x00030400
+	f.MaxHeight = 3
+	expectFormatError(t, "pattern taller than max height", Encode(f, binary.LittleEndian), "taller than max height")
+}
@@ -0,0 +1,463 @@
+// Copyright (c) 2020 Sam Blenny
+// SPDX-License-Identifier: Apache-2.0 OR MIT
+//
+package blob
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"math/bits"
+	"sort"
+	"unicode/utf8"
+)
+
+// Error for when the font has no glyph to match a grapheme cluster query
+var ErrGlyphNotFound = errors.New("font has no glyph for requested grapheme cluster")
+
+// Error for a blob that does not match the format
+type FormatError struct {
+	Offset int // Byte offset of the problem within the blob
+	Msg    string
+}
+
+func (e *FormatError) Error() string {
+	return fmt.Sprintf("font blob: offset %d: %s", e.Offset, e.Msg)
+}
+
+// Return a FormatError for offset with a formatted message
+func formatError(offset int, format string, a ...interface{}) error {
+	return &FormatError{offset, fmt.Sprintf(format, a...)}
+}
+
+// Decode a binary blob after checking that its header, checksum, index tables,
+// glyph pattern headers, and optional sections are all consistent
+func Decode(buf []byte) (*Font, error) {
+	if len(buf) < BaseHeaderSize+4 {
+		return nil, formatError(0, "blob is too short (%d bytes)", len(buf))
+	}
+	if string(buf[0:4]) != Magic {
+		return nil, formatError(0, "bad magic %q (expected %q)", buf[0:4], Magic)
+	}
+	var order binary.ByteOrder
+	switch binary.LittleEndian.Uint16(buf[4:]) {
+	case byteOrderMark:
+		order = binary.LittleEndian
+	case bits.ReverseBytes16(byteOrderMark):
+		order = binary.BigEndian
+	default:
+		return nil, formatError(4, "bad byte order mark 0x%04X", binary.LittleEndian.Uint16(buf[4:]))
+	}
+	maxFormat := uint32(1)
+	switch v := order.Uint16(buf[6:]); v {
+	case Version:
+	case Version1Bit:
+		maxFormat = 0
+	default:
+		return nil, formatError(6, "unsupported format version %d (expected %d or %d)", v, Version1Bit, Version)
+	}
+	headerSize := int(order.Uint16(buf[8:]))
+	if headerSize != BaseHeaderSize && headerSize != HeaderSize {
+		return nil, formatError(8, "bad header size %d (expected %d or %d)", headerSize, BaseHeaderSize, HeaderSize)
+	}
+	if len(buf) < headerSize+4 {
+		return nil, formatError(0, "blob is too short (%d bytes)", len(buf))
+	}
+	if n := order.Uint32(buf[32:]); int(n) != len(buf) {
+		return nil, formatError(32, "blob length field is %d, but blob has %d bytes", n, len(buf))
+	}
+	end := len(buf) - 4
+	if sum, want := crc32.ChecksumIEEE(buf[:end]), order.Uint32(buf[end:]); sum != want {
+		return nil, formatError(end, "checksum is 0x%08X, but blob contents give 0x%08X", want, sum)
+	}
+	f := &Font{
+		MaxHeight: int(order.Uint16(buf[10:])),
+		Seed:      order.Uint32(buf[12:]),
+	}
+	// Glyph patterns
+	dataOffset := int(order.Uint32(buf[24:]))
+	dataLen := int(order.Uint32(buf[28:]))
+	if err := checkTable(24, dataOffset, 4*dataLen, headerSize, end); err != nil {
+		return nil, err
+	}
+	f.Data = make([]uint32, dataLen)
+	for i := range f.Data {
+		f.Data[i] = order.Uint32(buf[dataOffset+4*i:])
+	}
+	// Block table
+	blockCount := int(order.Uint32(buf[16:]))
+	blockTable := int(order.Uint32(buf[20:]))
+	if err := checkTable(20, blockTable, blockCount*BlockEntrySize, headerSize, end); err != nil {
+		return nil, err
+	}
+	for i := 0; i < blockCount; i++ {
+		b, err := decodeBlock(buf, order, blockTable+i*BlockEntrySize, headerSize, end)
+		if err != nil {
+			return nil, err
+		}
+		if b.Low > b.High {
+			return nil, formatError(blockTable+i*BlockEntrySize, "block range %X..%X is backwards", b.Low, b.High)
+		}
+		if i > 0 && b.Low <= f.Blocks[i-1].High {
+			return nil, formatError(blockTable+i*BlockEntrySize, "block %X..%X overlaps or is out of order", b.Low, b.High)
+		}
+		for j, offset := range b.Offsets {
+			if err := f.checkPattern(int(offset), maxFormat); err != nil {
+				return nil, formatError(int(order.Uint32(buf[blockTable+i*BlockEntrySize+16:]))+4*j, "%v", err)
+			}
+		}
+		f.Blocks = append(f.Blocks, b)
+	}
+	if headerSize == HeaderSize {
+		d := &sectionDecoder{f, buf, order, end, maxFormat}
+		if err := d.decode(); err != nil {
+			return nil, err
+		}
+	}
+	return f, nil
+}
+
+// Holds what is needed to decode the optional sections of a blob
+type sectionDecoder struct {
+	f         *Font
+	buf       []byte
+	order     binary.ByteOrder
+	end       int // Offset of the checksum
+	maxFormat uint32
+}
+
+// Decode and check the optional sections that the extended header flags.
+// Fields of sections that are not flagged must be 0.
+func (d *sectionDecoder) decode() error {
+	flags := d.order.Uint32(d.buf[36:])
+	if flags&^knownFlags != 0 {
+		return formatError(36, "unknown flags 0x%08X", flags&^knownFlags)
+	}
+	for _, s := range []struct {
+		flag   uint32
+		fields []int // Offsets of the section's header words
+		decode func() error
+	}{
+		{FlagLineMetrics, []int{40}, d.lineMetrics},
+		{FlagGlyphMetrics, []int{44, 48}, d.glyphMetrics},
+		{FlagKerning, []int{52, 56}, d.kerning},
+		{FlagClusters, []int{60}, d.clusters},
+	} {
+		if flags&s.flag != 0 {
+			if err := s.decode(); err != nil {
+				return err
+			}
+			continue
+		}
+		for _, field := range s.fields {
+			if d.order.Uint32(d.buf[field:]) != 0 {
+				return formatError(field, "field is set, but its section is not flagged")
+			}
+		}
+	}
+	return nil
+}
+
+// Decode the ascent and descent, which must add up to the max height
+func (d *sectionDecoder) lineMetrics() error {
+	d.f.Ascent = int(d.order.Uint16(d.buf[40:]))
+	d.f.Descent = int(d.order.Uint16(d.buf[42:]))
+	if d.f.Ascent+d.f.Descent != d.f.MaxHeight {
+		return formatError(40, "ascent %d plus descent %d is not max height %d", d.f.Ascent, d.f.Descent, d.f.MaxHeight)
+	}
+	return nil
+}
+
+// Decode the glyph metrics table. Offsets must be sorted and point to patterns,
+// and metrics must be in the same range as the ones codegen makes.
+func (d *sectionDecoder) glyphMetrics() error {
+	n := int(d.order.Uint32(d.buf[44:]))
+	table := int(d.order.Uint32(d.buf[48:]))
+	if err := checkTable(48, table, 8*n, HeaderSize, d.end); err != nil {
+		return err
+	}
+	for i := 0; i < n; i++ {
+		record := d.order.Uint32(d.buf[table+4*(n+i):])
+		m := Metrics{d.order.Uint32(d.buf[table+4*i:]), int(record >> 16), int(int16(uint16(record)))}
+		if i > 0 && m.Offset <= d.f.Metrics[i-1].Offset {
+			return formatError(table+4*i, "glyph metrics offsets are not sorted or have a duplicate")
+		}
+		if err := d.f.checkPattern(int(m.Offset), d.maxFormat); err != nil {
+			return formatError(table+4*i, "%v", err)
+		}
+		if m.Advance > 0xff || m.Bearing < -0xff || m.Bearing > 0xff {
+			return formatError(table+4*(n+i), "advance %d or left bearing %d is more than 255 px", m.Advance, m.Bearing)
+		}
+		d.f.Metrics = append(d.f.Metrics, m)
+	}
+	return nil
+}
+
+// Decode the kerning table. Pairs must be sorted, point to patterns, and have
+// an adjustment.
+func (d *sectionDecoder) kerning() error {
+	n := int(d.order.Uint32(d.buf[52:]))
+	table := int(d.order.Uint32(d.buf[56:]))
+	if err := checkTable(56, table, 8*n+padded(n), HeaderSize, d.end); err != nil {
+		return err
+	}
+	for i := 0; i < n; i++ {
+		k := Kern{d.order.Uint32(d.buf[table+4*i:]), d.order.Uint32(d.buf[table+4*(n+i):]), int(int8(d.buf[table+8*n+i]))}
+		if i > 0 {
+			prev := d.f.Kerns[i-1]
+			if k.Left < prev.Left || k.Left == prev.Left && k.Right <= prev.Right {
+				return formatError(table+4*i, "kerning pairs are not sorted or have a duplicate")
+			}
+		}
+		for j, offset := range []uint32{k.Left, k.Right} {
+			if err := d.f.checkPattern(int(offset), d.maxFormat); err != nil {
+				return formatError(table+4*(j*n+i), "%v", err)
+			}
+		}
+		if k.Adjust == 0 {
+			return formatError(table+8*n+i, "kerning pair has no adjustment")
+		}
+		d.f.Kerns = append(d.f.Kerns, k)
+	}
+	return nil
+}
+
+// Decode the cluster table. Each cluster must have a codepoint count from its
+// block's cluster length list, start with a codepoint in the block, and hash
+// to the hash it confirms.
+func (d *sectionDecoder) clusters() error {
+	table := int(d.order.Uint32(d.buf[60:]))
+	if err := checkTable(60, table, len(d.f.Blocks)*ClusterEntrySize, HeaderSize, d.end); err != nil {
+		return err
+	}
+	for i := range d.f.Blocks {
+		b := &d.f.Blocks[i]
+		entry := table + i*ClusterEntrySize
+		n := len(b.Hashes)
+		starts := int(d.order.Uint32(d.buf[entry:]))
+		if err := checkTable(entry, starts, 4*(n+1), HeaderSize, d.end); err != nil {
+			return err
+		}
+		codepoints := int(d.order.Uint32(d.buf[entry+4:]))
+		count := int(d.order.Uint32(d.buf[starts+4*n:]))
+		if err := checkTable(entry+4, codepoints, 4*count, HeaderSize, d.end); err != nil {
+			return err
+		}
+		b.Clusters = []string{}
+		for j := 0; j < n; j++ {
+			start := int(d.order.Uint32(d.buf[starts+4*j:]))
+			next := int(d.order.Uint32(d.buf[starts+4*(j+1):]))
+			if start != 0 && j == 0 || start >= next || next > count {
+				return formatError(starts+4*j, "cluster start list is not in ascending order from 0 to %d", count)
+			}
+			var cluster []rune
+			for k := start; k < next; k++ {
+				c := d.order.Uint32(d.buf[codepoints+4*k:])
+				if c > utf8.MaxRune || (k == start && (c < b.Low || c > b.High)) {
+					return formatError(codepoints+4*k, "codepoint %X is not valid here", c)
+				}
+				cluster = append(cluster, rune(c))
+			}
+			if !b.hasGCLen(len(cluster)) {
+				return formatError(starts+4*j, "cluster has %d codepoints, which is not in the cluster length list", len(cluster))
+			}
+			if hash, _ := Murmur3(string(cluster), d.f.Seed, len(cluster)); hash != b.Hashes[j] {
+				return formatError(starts+4*j, "cluster %q has hash 0x%08X, not 0x%08X", string(cluster), hash, b.Hashes[j])
+			}
+			b.Clusters = append(b.Clusters, string(cluster))
+		}
+	}
+	return nil
+}
+
+// Return true if a block's cluster length list has gcLen
+func (b Block) hasGCLen(gcLen int) bool {
+	for _, n := range b.GCLens {
+		if n == gcLen {
+			return true
+		}
+	}
+	return false
+}
+
+// Make sure a table at offset with size bytes is word aligned and fits between
+// low and high. The field argument is where the table's offset was found.
+func checkTable(field int, offset int, size int, low int, high int) error {
+	if offset%4 != 0 {
+		return formatError(field, "table offset %d is not a multiple of 4", offset)
+	}
+	if size < 0 || offset < low || offset > high || size > high-offset {
+		return formatError(field, "table at offset %d with %d bytes is outside of %d..%d", offset, size, low, high)
+	}
+	return nil
+}
+
+// Decode and check the block table entry at offset. Tables must be between low
+// and end.
+func decodeBlock(buf []byte, order binary.ByteOrder, offset int, low int, end int) (Block, error) {
+	entry := buf[offset : offset+BlockEntrySize]
+	b := Block{Low: order.Uint32(entry[0:]), High: order.Uint32(entry[4:])}
+	n := int(order.Uint32(entry[8:]))
+	hashes := int(order.Uint32(entry[12:]))
+	offsets := int(order.Uint32(entry[16:]))
+	lens := int(order.Uint32(entry[20:]))
+	m := int(order.Uint32(entry[24:]))
+	if err := checkTable(offset+12, hashes, 4*n, low, end); err != nil {
+		return b, err
+	}
+	if err := checkTable(offset+16, offsets, 4*n, low, end); err != nil {
+		return b, err
+	}
+	if err := checkTable(offset+20, lens, m, low, end); err != nil {
+		return b, err
+	}
+	for i := 0; i < n; i++ {
+		b.Hashes = append(b.Hashes, order.Uint32(buf[hashes+4*i:]))
+		b.Offsets = append(b.Offsets, order.Uint32(buf[offsets+4*i:]))
+		if i > 0 && b.Hashes[i] <= b.Hashes[i-1] {
+			return b, formatError(hashes+4*i, "hash table is not sorted or has a duplicate hash")
+		}
+	}
+	for i := 0; i < m; i++ {
+		gcLen := int(buf[lens+i])
+		if gcLen == 0 || (i > 0 && gcLen >= b.GCLens[i-1]) {
+			return b, formatError(lens+i, "cluster length list is not in descending order")
+		}
+		b.GCLens = append(b.GCLens, gcLen)
+	}
+	return b, nil
+}
+
+// Make sure the pattern at offset has a header with a format of at most
+// maxFormat, and fits within Data
+func (f *Font) checkPattern(offset int, maxFormat uint32) error {
+	if offset >= len(f.Data) {
+		return fmt.Errorf("pattern offset %d is past end of %d word DATA", offset, len(f.Data))
+	}
+	w, h, yOffset := f.Header(offset)
+	if h+yOffset > f.MaxHeight {
+		return fmt.Errorf("pattern at DATA[%d] is taller than max height %d", offset, f.MaxHeight)
+	}
+	format := f.Data[offset] >> 24
+	if format > maxFormat {
+		return fmt.Errorf("pattern at DATA[%d] has unknown format %d (expected at most %d for this version)", offset, format, maxFormat)
+	}
+	words := (w*h*f.Bits(offset) + 31) / 32
+	if offset+1+words > len(f.Data) {
+		return fmt.Errorf("pattern at DATA[%d] needs %d words but runs past end of DATA", offset, words+1)
+	}
+	return nil
+}
+
+// Unpack a glyph pattern header of format: (w:u8)<<16 | (h:u8)<<8 | yOffset:u8
+func (f *Font) Header(offset int) (w int, h int, yOffset int) {
+	header := f.Data[offset]
+	return int((header >> 16) & 0xff), int((header >> 8) & 0xff), int(header & 0xff)
+}
+
+// Return the bits per pixel of the pattern at offset, from the format in the top
+// byte of its header: 1 for 1-bit pixels (format 0), or 2 for 2-bit coverage
+// values (format 1)
+func (f *Font) Bits(offset int) int {
+	if f.Data[offset]>>24 == 1 {
+		return 2
+	}
+	return 1
+}
+
+// Return the offset into Data for the start of the blit pattern for the
+// grapheme cluster at the start of a string, and how many bytes of the string
+// were matched. Longer clusters are matched first, the same as the rust
+// get_blit_pattern_offset().
+func (f *Font) Lookup(cluster string) (offset int, bytesUsed int, err error) {
+	firstChar, size := utf8.DecodeRuneInString(cluster)
+	if size == 0 {
+		return 0, 0, ErrGlyphNotFound
+	}
+	for _, b := range f.Blocks {
+		if uint32(firstChar) < b.Low || uint32(firstChar) > b.High {
+			continue
+		}
+		for _, limit := range b.GCLens {
+			key, bytesHashed := Murmur3(cluster, f.Seed, limit)
+			if i, ok := b.find(key); ok && (b.Clusters == nil || b.Clusters[i] == cluster[:bytesHashed]) {
+				return int(b.Offsets[i]), bytesHashed, nil
+			}
+		}
+		break
+	}
+	return 0, 0, ErrGlyphNotFound
+}
+
+// Return the advance and left bearing in px of the glyph with its pattern at
+// DATA[offset]. Glyphs without a metrics record get the default spacing of
+// blit.rs: a left bearing of 1, and an advance of the pattern width plus 3.
+func (f *Font) GlyphMetrics(offset int) (advance int, bearing int) {
+	i := sort.Search(len(f.Metrics), func(i int) bool { return int(f.Metrics[i].Offset) >= offset })
+	if i < len(f.Metrics) && int(f.Metrics[i].Offset) == offset {
+		return f.Metrics[i].Advance, f.Metrics[i].Bearing
+	}
+	w, _, _ := f.Header(offset)
+	return w + 3, 1
+}
+
+// Return the kerning adjustment in px to add to the advance of the glyph with
+// its pattern at DATA[left] when the glyph at DATA[right] follows it
+func (f *Font) Kerning(left int, right int) int {
+	i := sort.Search(len(f.Kerns), func(i int) bool {
+		k := f.Kerns[i]
+		return int(k.Left) > left || int(k.Left) == left && int(k.Right) >= right
+	})
+	if i < len(f.Kerns) && int(f.Kerns[i].Left) == left && int(f.Kerns[i].Right) == right {
+		return f.Kerns[i].Adjust
+	}
+	return 0
+}
+
+// Use binary search on a block's table of hashes to find the index of key
+func (b Block) find(key uint32) (int, bool) {
+	low, high := 0, len(b.Hashes)
+	for low < high {
+		mid := low + (high-low)/2
+		switch {
+		case b.Hashes[mid] < key:
+			low = mid + 1
+		case b.Hashes[mid] > key:
+			high = mid
+		default:
+			return mid, true
+		}
+	}
+	return 0, false
+}
+
+// Compute Murmur3 hash of the first limit codepoints of a string, using each
+// codepoint as a u32 block. Return the hash and how many bytes were hashed.
+func Murmur3(key string, seed uint32, limit int) (uint32, int) {
+	h := seed
+	n := 0
+	bytesHashed := len(key)
+	for i, c := range key {
+		if n >= limit {
+			bytesHashed = i
+			break
+		}
+		k := uint32(c)
+		k *= 0xcc9e2d51
+		k = bits.RotateLeft32(k, 15)
+		k *= 0x1b873593
+		h ^= k
+		h = bits.RotateLeft32(h, 13)
+		h *= 5
+		h += 0xe6546b64
+		n++
+	}
+	h ^= uint32(bytesHashed)
+	// Finalize with avalanche
+	h ^= h >> 16
+	h *= 0x85ebca6b
+	h ^= h >> 13
+	h *= 0xc2b2ae35
+	return h ^ (h >> 16), bytesHashed
+}
@@ -0,0 +1,51 @@
+// Copyright (c) 2020 Sam Blenny
+// SPDX-License-Identifier: Apache-2.0 OR MIT
+//
+package main
+
+import (
+	"encoding/binary"
+	"fmt"
+	"guilib/codegen/blob"
+	"guilib/codegen/font"
+)
+
+// Generate a binary font blob with the same glyph data, index, line metrics,
+// glyph metrics, and kerning pairs as the rust font file. Like the rust file,
+// the blob only has cluster verification tables when the font turns them on.
+// The blob gets decoded again before it is returned, so a blob that the reader
+// would reject never gets written.
+func genBlobFile(fs font.FontSpec, blobOut string, blobOrder string, rb RustyBlits) (OutputFile, error) {
+	f := &blob.Font{MaxHeight: fs.Size, Seed: rb.Seed, Ascent: fs.Ascent, Descent: fs.Size - fs.Ascent, Data: rb.Data}
+	for _, k := range rb.IndexKeys() {
+		dex := rb.Index[k]
+		b := blob.Block{Low: k.Low, High: k.High, GCLens: dex.ClusterLengthList()}
+		for _, entry := range dex {
+			b.Hashes = append(b.Hashes, entry.M3Hash)
+			b.Offsets = append(b.Offsets, uint32(entry.DataOffset))
+			if fs.Verify {
+				b.Clusters = append(b.Clusters, entry.Cluster)
+			}
+		}
+		f.Blocks = append(f.Blocks, b)
+	}
+	offset := 0
+	for _, p := range rb.Patterns {
+		if hasMetricsRecord(p) {
+			f.Metrics = append(f.Metrics, blob.Metrics{Offset: uint32(offset), Advance: p.Metrics.Advance, Bearing: p.Metrics.Bearing})
+		}
+		offset += len(p.Bytes)
+	}
+	for _, k := range rb.Kerns {
+		f.Kerns = append(f.Kerns, blob.Kern{Left: uint32(k.Left), Right: uint32(k.Right), Adjust: k.Adjust})
+	}
+	var order binary.ByteOrder = binary.LittleEndian
+	if blobOrder == "big" {
+		order = binary.BigEndian
+	}
+	buf := blob.Encode(f, order)
+	if _, err := blob.Decode(buf); err != nil {
+		return OutputFile{}, fmt.Errorf("%s: generated font blob is not valid: %v", fs.Name, err)
+	}
+	return OutputFile{blobOut, string(buf), true}, nil
+}
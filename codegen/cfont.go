@@ -0,0 +1,438 @@
+// Copyright (c) 2020 Sam Blenny
+// SPDX-License-Identifier: Apache-2.0 OR MIT
+//
+package main
+
+import (
+	"guilib/codegen/font"
+	"path"
+	"strings"
+)
+
+// Generate a C header and source file pair for a font. The pair has the same
+// DATA words and per-block hash/offset tables as the rust font file, plus C
+// versions of get_blit_pattern_offset() and murmur3().
+func genCFontFiles(fs font.FontSpec, cOut string, rb RustyBlits) []OutputFile {
+	prefix := cIdentifier(fs.Name)
+	context := struct {
+		Font   font.FontSpec
+		Prefix string
+		Macro  string
+		Header string
+		RB     RustyBlits
+		Verify bool
+	}{fs, prefix, strings.ToUpper(prefix), path.Base(cOut) + ".h", rb, fs.Verify}
+	return []OutputFile{
+		{cOut + ".h", renderTemplate(cHeaderTemplate, "cheader", context), false},
+		{cOut + ".c", renderTemplate(cSourceTemplate, "csource", context), false},
+	}
+}
+
+// Convert a font name to a lowercase C identifier (e.g. "Bold" -> "bold")
+func cIdentifier(name string) string {
+	var id []rune
+	for _, r := range strings.ToLower(name) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			id = append(id, r)
+		} else {
+			id = append(id, '_')
+		}
+	}
+	if len(id) == 0 || (id[0] >= '0' && id[0] <= '9') {
+		id = append([]rune("font_"), id...)
+	}
+	return string(id)
+}
+
+// Template with C source code for the header of a font
+const cHeaderTemplate = `// DO NOT MAKE EDITS HERE because this file is automatically generated.
+// To make changes, see guilib/codegen/main.go
+//
+// Copyright (c) 2020 Sam Blenny
+// SPDX-License-Identifier: Apache-2.0 OR MIT
+//
+// {{.Font.Name}} Font
+#ifndef GUILIB_FONT_{{.Macro}}_H
+#define GUILIB_FONT_{{.Macro}}_H
+
+#include <stddef.h>
+#include <stdint.h>
+
+// Maximum height of glyph patterns in this bitmap typeface.
+// This will be true: h + y_offset <= {{.Macro}}_MAX_HEIGHT
+#define {{.Macro}}_MAX_HEIGHT {{.Font.Size}}
+
+// Distance in pixels from the top of the line down to the baseline, and from
+// the baseline down to the bottom of the line.
+// This will be true: ASCENT + DESCENT == MAX_HEIGHT
+#define {{.Macro}}_ASCENT {{.Font.Ascent}}
+#define {{.Macro}}_DESCENT {{.Font.Descent}}
+
+// Seed for Murmur3 hashes in the hash index tables
+#define {{.Macro}}_M3_SEED {{.RB.Seed}}u
+
+// Number of words in {{.Prefix}}_data[]
+#define {{.Macro}}_DATA_LEN {{.RB.DataLen}}
+
+// Packed glyph pattern data (same record format as DATA in the rust font file)
+extern const uint32_t {{.Prefix}}_data[{{.Macro}}_DATA_LEN];
+
+// Find the blit pattern for the grapheme cluster at the start of a UTF-8
+// string of len bytes. On success, return 0 and set *offset to the start of
+// the blit pattern in {{.Prefix}}_data[] and *bytes_used to how many bytes
+// of the string were matched. Return -1 if the font has no matching glyph.
+int {{.Prefix}}_get_blit_pattern_offset(const char *cluster, size_t len, size_t *offset, size_t *bytes_used);
+
+// Find the metrics of the glyph with its blit pattern at offset in
+// {{.Prefix}}_data[]. On success, return 0 and set *advance to how many pixels
+// the pen moves after drawing the glyph and *bearing to how many pixels right
+// of the pen the left edge of the pattern goes (negative is to the left).
+// Glyphs without a metrics record get the spacing of blit.rs: a bearing of 1,
+// and an advance of the pattern width plus 3. Return -1 if offset is past the
+// end of {{.Prefix}}_data[].
+int {{.Prefix}}_get_glyph_metrics(size_t offset, int *advance, int *bearing);
+
+// Return the kerning adjustment in pixels to add to the advance of the glyph
+// with its blit pattern at offset left in {{.Prefix}}_data[] when the glyph at
+// offset right follows it. Negative adjustments move the glyphs closer together.
+int {{.Prefix}}_get_kerning(size_t left, size_t right);
+
+// Compute Murmur3 hash of the first limit codepoints of a UTF-8 string of len
+// bytes, using each codepoint as a u32 block. Set *bytes_hashed to how many
+// bytes of the string were hashed.
+uint32_t {{.Prefix}}_murmur3(const char *key, size_t len, uint32_t seed, uint32_t limit, size_t *bytes_hashed);
+
+#endif
+`
+
+// Template with C source code for the data, index, and lookup functions of a font
+const cSourceTemplate = `// DO NOT MAKE EDITS HERE because this file is automatically generated.
+// To make changes, see guilib/codegen/main.go
+//
+// Copyright (c) 2020 Sam Blenny
+// SPDX-License-Identifier: Apache-2.0 OR MIT
+//
+// NOTE: The copyright notice above applies to the C source code in this file,
+// but not to the bitmap graphics encoded in the data array (see credits).
+//
+// CREDITS:
+{{LineComment .Font.Legal}}
+// This code includes an adaptation of the the murmur3 hash algorithm.
+// The murmur3 public domain notice, as retrieved on August 3, 2020 from
+// https://github.com/aappleby/smhasher/blob/master/src/MurmurHash3.cpp,
+// states:
+// > MurmurHash3 was written by Austin Appleby, and is placed in the public
+// > domain. The author hereby disclaims copyright to this source code.
+//
+// {{.Font.Name}} Font
+#include "{{.Header}}"
+
+// Index of the grapheme clusters for one Unicode block
+struct block_index {
+    uint32_t low;                   // First codepoint of block
+    uint32_t high;                  // Last codepoint of block
+    size_t count;                   // Number of index entries
+    const uint32_t *hashes;         // murmur3(grapheme cluster), sorted
+    const uint32_t *offsets;        // Blit pattern offsets; sort matches hashes
+    const uint32_t *gc_lens;        // Cluster lengths to try, longest first
+    size_t gc_len_count;            // Number of cluster lengths to try
+{{- if .Verify}}
+    const uint32_t *clusters;       // Codepoints of clusters, packed end to end
+    const uint32_t *cluster_starts; // Start of each cluster, plus end of last one
+{{- end}}
+};
+{{range $_, $k := .RB.IndexKeys}}
+{{- with $dex := index $.RB.Index $k}}
+// Index of murmur3(grapheme cluster); sort matches offset_{{ToLower $k.Name}}
+static const uint32_t hash_{{ToLower $k.Name}}[{{len $dex}}] = {
+    {{$dex.RustCodeForClusterHashes}}
+};
+
+// Lookup table of blit pattern offsets; sort matches hash_{{ToLower $k.Name}}
+static const uint32_t offset_{{ToLower $k.Name}}[{{len $dex}}] = {
+    {{$dex.RustCodeForOffsets}}
+};
+
+// Grapheme cluster lengths in codepoints, longest first
+static const uint32_t gc_lens_{{ToLower $k.Name}}[{{len $dex.ClusterLengthList}}] = {
+    {{range $i, $n := $dex.ClusterLengthList}}{{if $i}}, {{end}}{{$n}}{{end}}
+};
+{{- if $.Verify}}
+
+// Codepoints of each grapheme cluster, packed end to end; sort matches hash_{{ToLower $k.Name}}
+static const uint32_t cluster_{{ToLower $k.Name}}[{{$dex.ClusterCodepoints}}] = {
+    {{$dex.RustCodeForClusters}}
+};
+
+// Start of each cluster in cluster_{{ToLower $k.Name}}, plus end of the last one
+static const uint32_t cluster_start_{{ToLower $k.Name}}[{{len $dex}} + 1] = {
+    {{$dex.RustCodeForClusterStarts}}
+};
+{{- end}}
+{{end}}
+{{- end}}
+// Unicode blocks included in this font
+static const struct block_index blocks[{{len .RB.IndexKeys}}] = {
+{{- range $_, $k := .RB.IndexKeys}}
+{{- with $dex := index $.RB.Index $k}}
+    {0x{{printf "%X" $k.Low}}, 0x{{printf "%X" $k.High}}, {{len $dex}}, hash_{{ToLower $k.Name}}, offset_{{ToLower $k.Name}}, gc_lens_{{ToLower $k.Name}}, {{len $dex.ClusterLengthList}}
+    {{- if $.Verify}}, cluster_{{ToLower $k.Name}}, cluster_start_{{ToLower $k.Name}}{{end}}},
+{{- end}}
+{{- end}}
+};
+
+// Decode one UTF-8 character from the start of s. Return its length in bytes,
+// or 0 if s is empty or does not start with a valid UTF-8 sequence.
+static size_t utf8_decode(const char *s, size_t len, uint32_t *codepoint)
+{
+    const unsigned char *u = (const unsigned char *)s;
+    size_t n, i;
+    uint32_t c;
+    if (len == 0) {
+        return 0;
+    } else if (u[0] < 0x80) {
+        *codepoint = u[0];
+        return 1;
+    } else if ((u[0] & 0xE0) == 0xC0) {
+        n = 2;
+        c = u[0] & 0x1F;
+    } else if ((u[0] & 0xF0) == 0xE0) {
+        n = 3;
+        c = u[0] & 0x0F;
+    } else if ((u[0] & 0xF8) == 0xF0) {
+        n = 4;
+        c = u[0] & 0x07;
+    } else {
+        return 0;
+    }
+    if (len < n) {
+        return 0;
+    }
+    for (i = 1; i < n; i++) {
+        if ((u[i] & 0xC0) != 0x80) {
+            return 0;
+        }
+        c = (c << 6) | (u[i] & 0x3F);
+    }
+    *codepoint = c;
+    return n;
+}
+
+static uint32_t rotl32(uint32_t x, int r)
+{
+    return (x << r) | (x >> (32 - r));
+}
+
+uint32_t {{.Prefix}}_murmur3(const char *key, size_t len, uint32_t seed, uint32_t limit, size_t *bytes_hashed)
+{
+    uint32_t h = seed;
+    uint32_t k;
+    uint32_t c;
+    uint32_t n = 0;
+    size_t i = 0;
+    size_t step;
+    // Hash each codepoint as its own u32 block
+    while (i < len && n < limit) {
+        step = utf8_decode(key + i, len - i, &c);
+        if (step == 0) {
+            break;
+        }
+        k = c * 0xcc9e2d51u;
+        k = rotl32(k, 15);
+        k *= 0x1b873593u;
+        h ^= k;
+        h = rotl32(h, 13);
+        h = h * 5u + 0xe6546b64u;
+        i += step;
+        n++;
+    }
+    h ^= (uint32_t)i;
+    // Finalize with avalanche
+    h ^= h >> 16;
+    h *= 0x85ebca6bu;
+    h ^= h >> 13;
+    h *= 0xc2b2ae35u;
+    h ^= h >> 16;
+    *bytes_hashed = i;
+    return h;
+}
+{{- if .Verify}}
+
+// Return 1 when the codepoints of the first len bytes of key exactly match the
+// expected codepoints from a cluster verification table, or 0 otherwise
+static int cluster_matches(const char *key, size_t len, const uint32_t *codepoints, size_t count)
+{
+    size_t i = 0;
+    size_t n = 0;
+    size_t step;
+    uint32_t c;
+    while (i < len) {
+        step = utf8_decode(key + i, len - i, &c);
+        if (step == 0 || n >= count || c != codepoints[n]) {
+            return 0;
+        }
+        i += step;
+        n++;
+    }
+    return n == count;
+}
+{{- end}}
+
+// Use binary search on a block's table of grapheme cluster hashes to find the
+// blit pattern for a grapheme cluster of length limit codepoints
+static int find(const struct block_index *b, const char *cluster, size_t len, uint32_t limit, size_t *offset, size_t *bytes_used)
+{
+    size_t bytes_hashed;
+    uint32_t key = {{.Prefix}}_murmur3(cluster, len, {{.Macro}}_M3_SEED, limit, &bytes_hashed);
+    size_t low = 0;
+    size_t high = b->count;
+    while (low < high) {
+        size_t mid = low + (high - low) / 2;
+        if (b->hashes[mid] < key) {
+            low = mid + 1;
+        } else if (b->hashes[mid] > key) {
+            high = mid;
+        } else {
+{{- if .Verify}}
+            uint32_t start = b->cluster_starts[mid];
+            uint32_t end = b->cluster_starts[mid + 1];
+            if (!cluster_matches(cluster, bytes_hashed, &b->clusters[start], end - start)) {
+                return 0;
+            }
+{{- end}}
+            *offset = b->offsets[mid];
+            *bytes_used = bytes_hashed;
+            return 1;
+        }
+    }
+    return 0;
+}
+
+int {{.Prefix}}_get_blit_pattern_offset(const char *cluster, size_t len, size_t *offset, size_t *bytes_used)
+{
+    uint32_t first_char;
+    size_t i;
+    size_t j;
+    if (utf8_decode(cluster, len, &first_char) == 0) {
+        return -1;
+    }
+    // Pre-filter on the first character's Unicode block before doing an
+    // expensive lookup for the whole cluster
+    for (i = 0; i < sizeof(blocks) / sizeof(blocks[0]); i++) {
+        const struct block_index *b = &blocks[i];
+        if (first_char < b->low || first_char > b->high) {
+            continue;
+        }
+        for (j = 0; j < b->gc_len_count; j++) {
+            if (find(b, cluster, len, b->gc_lens[j], offset, bytes_used)) {
+                return 0;
+            }
+        }
+        return -1;
+    }
+    return -1;
+}
+
+{{if .RB.MetricsCount -}}
+// Lookup table of blit pattern offsets in data order, for glyphs that do not
+// have the default metrics; sort matches metrics
+static const uint32_t metrics_offset[{{.RB.MetricsCount}}] = {
+    {{.RB.RustCodeForMetricsOffsets}}
+};
+
+// Glyph metrics; sort matches metrics_offset.
+// Record format: ((advance as u16) << 16) | (left bearing as i16 as u16)
+static const uint32_t metrics[{{.RB.MetricsCount}}] = {
+    {{.RB.RustCodeForMetrics}}
+};
+
+{{end -}}
+int {{.Prefix}}_get_glyph_metrics(size_t offset, int *advance, int *bearing)
+{
+{{- if .RB.MetricsCount}}
+    size_t low = 0;
+    size_t high = sizeof(metrics) / sizeof(metrics[0]);
+    while (low < high) {
+        size_t mid = low + (high - low) / 2;
+        if (metrics_offset[mid] < offset) {
+            low = mid + 1;
+        } else if (metrics_offset[mid] > offset) {
+            high = mid;
+        } else {
+            *advance = (int)(metrics[mid] >> 16);
+            *bearing = (int)(int16_t)(uint16_t)metrics[mid];
+            return 0;
+        }
+    }
+{{- end}}
+    // Glyphs without a metrics record get the spacing of blit.rs
+    if (offset >= {{.Macro}}_DATA_LEN) {
+        return -1;
+    }
+    *advance = (int)(({{.Prefix}}_data[offset] >> 16) & 0xff) + 3;
+    *bearing = 1;
+    return 0;
+}
+
+{{if .RB.Kerns -}}
+{{$keyType := "uint32_t"}}{{if eq .RB.KernKeyShift 32}}{{$keyType = "uint64_t"}}{{end -}}
+// Kerning pairs as ((left data offset) << {{.RB.KernKeyShift}}) | (right data offset), sorted
+static const {{$keyType}} kern_key[{{len .RB.Kerns}}] = {
+    {{.RB.RustCodeForKernKeys}}
+};
+
+// Kerning adjustments in pixels; sort matches kern_key
+static const int8_t kern_adjust[{{len .RB.Kerns}}] = {
+    {{.RB.RustCodeForKernAdjustments}}
+};
+
+int {{.Prefix}}_get_kerning(size_t left, size_t right)
+{
+    {{$keyType}} key = (({{$keyType}})left << {{.RB.KernKeyShift}}) | ({{$keyType}})right;
+    size_t low = 0;
+    size_t high = sizeof(kern_key) / sizeof(kern_key[0]);
+    while (low < high) {
+        size_t mid = low + (high - low) / 2;
+        if (kern_key[mid] < key) {
+            low = mid + 1;
+        } else if (kern_key[mid] > key) {
+            high = mid;
+        } else {
+            return kern_adjust[mid];
+        }
+    }
+    return 0;
+}
+{{- else -}}
+// This font has no kerning pairs.
+int {{.Prefix}}_get_kerning(size_t left, size_t right)
+{
+    (void)left;
+    (void)right;
+    return 0;
+}
+{{- end}}
+
+// Packed glyph pattern data.
+// Record format:
+{{- if eq .Font.Bits 2}}
+//  [offset+0]: (1 << 24) | ((w as u8) << 16) | ((h as u8) << 8) | (yOffset as u8)
+//  [offset+1..=ceil(w*h/16)]: packed 2-bit coverage values; 0=clear,
+//     1=1/3 ink, 2=2/3 ink, 3=full ink
+// Pixels are packed in top to bottom, left to right order with the 2 MSBs of
+// the first pixel word containing the top left pixel. The top byte of the
+// header is the pattern format, which is 1 for 2-bit coverage values, or 0
+// (like in fonts with 1-bit patterns) for 1-bit pixels.
+{{- else}}
+//  [offset+0]: ((w as u8) << 16) | ((h as u8) << 8) | (yOffset as u8)
+//  [offset+1..=ceil(w*h/32)]: packed 1-bit pixels; 0=clear, 1=set
+// Pixels are packed in top to bottom, left to right order with MSB of first
+// pixel word containing the top left pixel.
+{{- end}}
+//  w: Width of pattern in pixels
+//  h: Height of pattern in pixels
+//  yOffset: Vertical offset (pixels downward from top of line) to position
+//     glyph pattern properly relative to text baseline
+const uint32_t {{.Prefix}}_data[{{.Macro}}_DATA_LEN] = {
+{{.RB.Code}}};
+`
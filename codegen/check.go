@@ -0,0 +1,155 @@
+// Copyright (c) 2020 Sam Blenny
+// SPDX-License-Identifier: Apache-2.0 OR MIT
+//
+package main
+
+import (
+	"fmt"
+	"guilib/codegen/font"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Regenerate every font in memory and compare it byte-for-byte against the
+// existing source files. Exit with non-zero status if any file differs.
+func checkFontFiles() {
+	fonts, err := readManifest(manifestFile)
+	if err != nil {
+		reportErrors(err)
+		os.Exit(1)
+	}
+	drift := false
+	var errs font.ErrorList
+	for _, f := range fonts {
+		files, err := genFontFiles(f)
+		if err != nil {
+			errs.Add(err)
+			continue
+		}
+		for _, of := range files {
+			if checkFile(f.Spec.Name, of) {
+				drift = true
+			}
+		}
+	}
+	if errs.Err() != nil {
+		reportErrors(errs)
+		os.Exit(1)
+	}
+	if drift {
+		fmt.Println("To update the font files, run: go run .", confirm)
+		os.Exit(1)
+	}
+}
+
+// Compare one generated file against the existing file. Return true if they differ.
+func checkFile(fontName string, of OutputFile) bool {
+	got, err := ioutil.ReadFile(of.Path)
+	if err != nil {
+		fmt.Printf("%s: %s font: %v\n", of.Path, fontName, err)
+		return true
+	}
+	if string(got) == of.Code {
+		fmt.Printf("%s: %s font is up to date\n", of.Path, fontName)
+		return false
+	}
+	if of.Binary {
+		fmt.Printf("%s: %s font differs from generated blob at %s\n", of.Path, fontName, describeBlobDrift(of.Code, string(got)))
+		return true
+	}
+	fmt.Printf("%s: %s font differs from generated code at %s\n", of.Path, fontName, describeDrift(of.Code, string(got)))
+	return true
+}
+
+// Describe where an existing font file first differs from the generated code.
+// Differences inside the DATA array get reported as a DATA offset, differences
+// inside the HASH_* and OFFSET_* tables get reported as an index entry, and
+// anything else gets reported by line number.
+func describeDrift(want string, got string) string {
+	wantLines := strings.Split(want, "\n")
+	gotLines := strings.Split(got, "\n")
+	// Find first line that differs
+	n := 0
+	for n < len(wantLines) && n < len(gotLines) && wantLines[n] == gotLines[n] {
+		n++
+	}
+	if n == len(wantLines) {
+		return fmt.Sprintf("line %d (file has extra lines at end)", n+1)
+	}
+	if n == len(gotLines) {
+		return fmt.Sprintf("line %d (file ends early)", n+1)
+	}
+	// Walk the generated code up to the differing line to find out which
+	// table it belongs to
+	table := ""
+	entry := 0
+	dataOffset := 0
+	label := ""
+	for _, line := range wantLines[:n] {
+		txt := strings.TrimSpace(line)
+		switch {
+		case strings.HasPrefix(txt, "pub const DATA:"):
+			table = "DATA"
+		case strings.HasPrefix(txt, "const HASH_") || strings.HasPrefix(txt, "const OFFSET_"):
+			table = strings.Fields(txt)[1]
+			table = strings.TrimSuffix(table, ":")
+			entry = 0
+		case txt == "];":
+			table = ""
+		case table == "DATA" && strings.HasPrefix(txt, "// ["):
+			dataOffset, label = parseDataComment(txt)
+		case table == "DATA":
+			dataOffset += len(strings.Split(strings.TrimSuffix(txt, ","), ","))
+		case table != "":
+			entry++
+		}
+	}
+	wantTxt := strings.TrimSpace(wantLines[n])
+	gotTxt := strings.TrimSpace(gotLines[n])
+	switch {
+	case table == "DATA" && strings.HasPrefix(wantTxt, "// ["):
+		offset, label := parseDataComment(wantTxt)
+		return fmt.Sprintf("line %d: DATA[%d] pattern comment (expected %q, found %q)", n+1, offset, label, gotTxt)
+	case table == "DATA":
+		// Find first differing word within the line
+		wantWords := strings.Split(strings.TrimSuffix(wantTxt, ","), ",")
+		gotWords := strings.Split(strings.TrimSuffix(gotTxt, ","), ",")
+		i := 0
+		for i < len(wantWords) && i < len(gotWords) && strings.TrimSpace(wantWords[i]) == strings.TrimSpace(gotWords[i]) {
+			i++
+		}
+		return fmt.Sprintf("line %d: DATA[%d] in pattern for %s", n+1, dataOffset+i, label)
+	case table != "":
+		comment := ""
+		if parts := strings.SplitN(wantTxt, "//", 2); len(parts) == 2 {
+			comment = " for " + strings.TrimSpace(parts[1])
+		}
+		return fmt.Sprintf("line %d: %s[%d]%s", n+1, table, entry, comment)
+	}
+	return fmt.Sprintf("line %d (expected %q, found %q)", n+1, wantTxt, gotTxt)
+}
+
+// Describe where an existing binary font blob first differs from the generated blob
+func describeBlobDrift(want string, got string) string {
+	n := 0
+	for n < len(want) && n < len(got) && want[n] == got[n] {
+		n++
+	}
+	if len(want) != len(got) {
+		return fmt.Sprintf("byte %d (expected %d bytes, found %d)", n, len(want), len(got))
+	}
+	return fmt.Sprintf("byte %d", n)
+}
+
+// Parse a DATA pattern comment like `// [123]: 41 "A"` into its offset and label
+func parseDataComment(txt string) (int, string) {
+	txt = strings.TrimPrefix(txt, "// [")
+	parts := strings.SplitN(txt, "]: ", 2)
+	offset, _ := strconv.Atoi(parts[0])
+	if len(parts) < 2 {
+		return offset, ""
+	}
+	return offset, parts[1]
+}
@@ -0,0 +1,359 @@
+// Copyright (c) 2020 Sam Blenny
+// SPDX-License-Identifier: Apache-2.0 OR MIT
+//
+package font
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Holds the parts of a BDF glyph needed to make a blit pattern
+type bdfGlyph struct {
+	line     int // Line number of STARTCHAR
+	name     string
+	encoding int
+	bbx      [4]int // Width, height, x-offset, y-offset
+	dwidth   int    // Advance width, or -1 if the glyph has no DWIDTH
+	bitmap   []string
+}
+
+// Read the glyphs of a BDF font file and pack them into a list of blit
+// patterns. Each pattern gets a CharSpec for the codepoint from its glyph's
+// ENCODING line (Row and Col are not used). Glyphs with ENCODING -1 get a
+// multi-codepoint cluster if their name follows the BDFFromPatterns convention,
+// and are otherwise skipped. Glyph y-offsets are measured down from the top of
+// the line, which is FONT_ASCENT pixels above the baseline. Glyphs advance by
+// their DWIDTH (or the font's DWIDTH), and their left bearing comes from the
+// BBX x-offset. Glyphs with problems are left out, and the returned error lists
+// them.
+func BDFPatterns(fs FontSpec, dbg bool) ([]BlitPattern, error) {
+	file, err := os.Open(fs.BDF)
+	if err != nil {
+		return nil, &FileError{Context{fs.Name, fs.BDF, 0}, err}
+	}
+	defer file.Close()
+	var errs ErrorList
+	report := func(line int, format string, a ...interface{}) {
+		errs.Add(&SourceError{Context{fs.Name, fs.BDF, line}, fmt.Sprintf(format, a...)})
+	}
+	ascent := -1
+	fbbAscent := -1
+	fontDWidth := -1
+	registry, charsetEncoding := "", ""
+	var glyphs []bdfGlyph
+	var cur *bdfGlyph
+	inBitmap := false
+	scanner := bufio.NewScanner(file)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		if inBitmap {
+			if fields[0] == "ENDCHAR" {
+				inBitmap = false
+				glyphs = append(glyphs, *cur)
+				cur = nil
+			} else {
+				cur.bitmap = append(cur.bitmap, fields[0])
+			}
+			continue
+		}
+		switch fields[0] {
+		case "FONTBOUNDINGBOX":
+			if n, ok := bdfInts(fields[1:], 4); ok {
+				fbbAscent = n[1] + n[3]
+			} else {
+				report(lineNum, "bad FONTBOUNDINGBOX %q", scanner.Text())
+			}
+		case "FONT_ASCENT":
+			if n, ok := bdfInts(fields[1:], 1); ok {
+				ascent = n[0]
+			} else {
+				report(lineNum, "bad FONT_ASCENT %q", scanner.Text())
+			}
+		case "CHARSET_REGISTRY":
+			registry = strings.ToUpper(strings.Trim(strings.Join(fields[1:], " "), "\""))
+		case "CHARSET_ENCODING":
+			charsetEncoding = strings.Trim(strings.Join(fields[1:], " "), "\"")
+		case "STARTCHAR":
+			cur = &bdfGlyph{line: lineNum, name: strings.Join(fields[1:], " "), encoding: -1, dwidth: -1}
+		case "ENCODING":
+			n, err := strconv.Atoi(strings.Join(fields[1:2], ""))
+			if cur == nil || err != nil {
+				report(lineNum, "bad ENCODING %q", scanner.Text())
+			} else {
+				cur.encoding = n
+			}
+		case "DWIDTH":
+			n, ok := bdfInts(fields[1:], 2)
+			switch {
+			case !ok || n[0] < 0 || n[0] > 0xff:
+				report(lineNum, "bad DWIDTH %q (advance must be 0 to 255 px)", scanner.Text())
+			case cur == nil:
+				fontDWidth = n[0]
+			default:
+				cur.dwidth = n[0]
+			}
+		case "BBX":
+			n, ok := bdfInts(fields[1:], 4)
+			if cur == nil || !ok || n[0] < 0 || n[1] < 0 {
+				report(lineNum, "bad BBX %q", scanner.Text())
+			} else {
+				copy(cur.bbx[:], n)
+			}
+		case "BITMAP":
+			if cur == nil {
+				report(lineNum, "BITMAP is outside of a STARTCHAR...ENDCHAR glyph")
+			} else {
+				inBitmap = true
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, &FileError{Context{fs.Name, fs.BDF, 0}, err}
+	}
+	if inBitmap || cur != nil {
+		report(lineNum, "file ends inside of a glyph")
+	}
+	switch {
+	case registry == "" || registry == "ISO10646":
+	case registry == "ISO8859" && charsetEncoding == "1":
+		// Codepoints of ISO 8859-1 are the same as Unicode
+	default:
+		report(0, "charset %s-%s is not Unicode, so ENCODING values are not codepoints", registry, charsetEncoding)
+	}
+	if ascent < 0 {
+		ascent = fbbAscent
+	}
+	if ascent < 0 {
+		report(0, "font has no FONT_ASCENT or FONTBOUNDINGBOX to locate the baseline")
+	}
+	if errs.Err() != nil {
+		return nil, errs
+	}
+	// Convert glyphs to blit patterns
+	var patternList []BlitPattern
+	seen := map[string]int{}
+	for _, g := range glyphs {
+		hexGC := fmt.Sprintf("%x", g.encoding)
+		if g.encoding < 0 {
+			var ok bool
+			if hexGC, ok = hexClusterFromBDFName(g.name); !ok {
+				// Glyph has no standard encoding, so it can't go in the index
+				continue
+			}
+		} else if g.encoding > unicode.MaxRune {
+			report(g.line, "glyph %q ENCODING %d is not a Unicode codepoint", g.name, g.encoding)
+			continue
+		}
+		if prev, dup := seen[hexGC]; dup {
+			report(g.line, "glyph %q has the same grapheme cluster %q as the glyph on line %d", g.name, hexGC, prev)
+			continue
+		}
+		seen[hexGC] = g.line
+		if g.dwidth < 0 {
+			g.dwidth = fontDWidth
+		}
+		cs := CharSpec{hexGC, 0, 0}
+		pattern, err := bdfGlyphToPattern(fs, g, ascent, cs, dbg)
+		if err != nil {
+			errs.Add(err)
+			continue
+		}
+		patternList = append(patternList, pattern)
+	}
+	return patternList, errs.Err()
+}
+
+// Parse the first n fields as integers
+func bdfInts(fields []string, n int) ([]int, bool) {
+	if len(fields) < n {
+		return nil, false
+	}
+	ints := make([]int, n)
+	for i := range ints {
+		var err error
+		if ints[i], err = strconv.Atoi(fields[i]); err != nil {
+			return nil, false
+		}
+	}
+	return ints, true
+}
+
+// Convert a BDF glyph bitmap to a blit pattern. The BBX is the pattern box, as
+// is, so padding around the ink (like for the syslatin radio strength bars)
+// and the size of blank glyphs survive a round trip through BDF. Glyphs without
+// a DWIDTH advance by the right edge of their BBX.
+func bdfGlyphToPattern(fs FontSpec, g bdfGlyph, ascent int, cs CharSpec, dbg bool) (BlitPattern, error) {
+	glyphError := func(format string, a ...interface{}) error {
+		return &SourceError{Context{fs.Name, fs.BDF, g.line}, fmt.Sprintf("glyph %q: ", g.name) + fmt.Sprintf(format, a...)}
+	}
+	w, h, xOff, yOff := g.bbx[0], g.bbx[1], g.bbx[2], g.bbx[3]
+	if len(g.bitmap) != h {
+		return BlitPattern{}, glyphError("BITMAP has %d rows but BBX height is %d", len(g.bitmap), h)
+	}
+	pxMatrix := Matrix{}
+	for _, hexRow := range g.bitmap {
+		if len(hexRow) < 2*((w+7)/8) {
+			return BlitPattern{}, glyphError("BITMAP row %q is too short for BBX width %d", hexRow, w)
+		}
+		var row MatrixRow
+		for x := 0; x < w; x++ {
+			nibble, err := strconv.ParseUint(hexRow[x/4:x/4+1], 16, 8)
+			if err != nil {
+				return BlitPattern{}, glyphError("bad BITMAP row %q", hexRow)
+			}
+			row = append(row, int(nibble>>(3-uint(x%4)))&1)
+		}
+		pxMatrix = append(pxMatrix, row)
+	}
+	// Top of the BBX is ascent - (yOff + h) pixels below the top of the line
+	yOffset := ascent - (yOff + h)
+	bearing := xOff
+	advance := g.dwidth
+	if advance < 0 {
+		advance = xOff + w
+	}
+	if yOffset < 0 {
+		return BlitPattern{}, glyphError("top of glyph is %d px above the font ascent", -yOffset)
+	}
+	if yOffset+len(pxMatrix) > fs.Size {
+		return BlitPattern{}, glyphError("bottom of glyph is %d px below the top of a %d px line", yOffset+len(pxMatrix), fs.Size)
+	}
+	if len(pxMatrix) > 0 && len(pxMatrix[0]) > 0xff {
+		return BlitPattern{}, glyphError("is %d px wide (max 255)", len(pxMatrix[0]))
+	}
+	debugMatrix(cs, pxMatrix, 1, dbg)
+	metrics := glyphMetrics(advance, bearing, pxMatrix)
+	if err := metrics.check(); err != nil {
+		return BlitPattern{}, glyphError("%v", err)
+	}
+	return BlitPattern{convertMatrixToPattern(pxMatrix, uint32(yOffset), 1), cs, metrics}, nil
+}
+
+// Return the BDF glyph name for a grapheme cluster. Names follow the Adobe
+// Glyph List convention: "uniXXXX" for BMP codepoints, "uXXXXX" for the other
+// planes, and component names joined by "_" for multi-codepoint clusters (for
+// example, "u1F3C4_uni200D_uni2640_uniFE0F").
+func bdfGlyphName(cluster string) string {
+	var parts []string
+	for _, c := range cluster {
+		if c <= 0xFFFF {
+			parts = append(parts, fmt.Sprintf("uni%04X", c))
+		} else {
+			parts = append(parts, fmt.Sprintf("u%X", c))
+		}
+	}
+	return strings.Join(parts, "_")
+}
+
+// Parse a glyph name made by bdfGlyphName into a hex grapheme cluster
+func hexClusterFromBDFName(name string) (string, bool) {
+	var hexCPs []string
+	for _, part := range strings.Split(name, "_") {
+		hex := ""
+		switch {
+		case strings.HasPrefix(part, "uni") && len(part) == 7:
+			hex = part[3:]
+		case strings.HasPrefix(part, "u") && len(part) >= 6 && len(part) <= 7:
+			hex = part[1:]
+		default:
+			return "", false
+		}
+		c, err := strconv.ParseUint(hex, 16, 32)
+		if err != nil || c > unicode.MaxRune {
+			return "", false
+		}
+		hexCPs = append(hexCPs, fmt.Sprintf("%x", c))
+	}
+	return strings.Join(hexCPs, "-"), true
+}
+
+// Make a BDF font file from a list of blit patterns.
+//
+// The font's line height is fs.Size with the baseline fs.Ascent px below the
+// top of the line, so FONT_ASCENT is fs.Ascent and FONT_DESCENT is the rest.
+// Each glyph's DWIDTH is its advance, and the x-offset of its BBX is its left
+// bearing, so BDFPatterns reads the metrics back unchanged.
+//
+// Single-codepoint clusters get their codepoint as ENCODING. Multi-codepoint
+// clusters (emoji ZWJ sequences, flags, keycaps, etc.) have no BDF encoding,
+// so they get ENCODING -1, and the cluster is spelled out by the glyph name
+// (see bdfGlyphName). BDFPatterns reads such glyphs back as clusters.
+func BDFFromPatterns(fs FontSpec, pl []BlitPattern) string {
+	descent := fs.Size - fs.Ascent
+	minX, maxX := 0, 0
+	totalW := 0
+	for _, p := range pl {
+		w := int((p.Bytes[0] >> 16) & 0xff)
+		totalW += p.Metrics.Advance
+		if w > 0 && p.Metrics.Bearing < minX {
+			minX = p.Metrics.Bearing
+		}
+		if p.Metrics.Bearing+w > maxX {
+			maxX = p.Metrics.Bearing + w
+		}
+	}
+	avgW := 0
+	if len(pl) > 0 {
+		avgW = (10*totalW + len(pl)/2) / len(pl)
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "STARTFONT 2.1\n")
+	for _, line := range strings.Split(fs.Legal, "\n") {
+		fmt.Fprintf(&b, "COMMENT %s\n", strings.TrimRight(line, " "))
+	}
+	fmt.Fprintf(&b, "FONT -guilib-%s-Medium-R-Normal--%d-%d-72-72-P-%d-ISO10646-1\n",
+		fs.Name, fs.Size, 10*fs.Size, avgW)
+	fmt.Fprintf(&b, "SIZE %d 72 72\n", fs.Size)
+	fmt.Fprintf(&b, "FONTBOUNDINGBOX %d %d %d %d\n", maxX-minX, fs.Size, minX, -descent)
+	fmt.Fprintf(&b, "STARTPROPERTIES 6\n")
+	fmt.Fprintf(&b, "FAMILY_NAME %q\n", fs.Name)
+	fmt.Fprintf(&b, "SPACING \"P\"\n")
+	fmt.Fprintf(&b, "FONT_ASCENT %d\n", fs.Ascent)
+	fmt.Fprintf(&b, "FONT_DESCENT %d\n", descent)
+	fmt.Fprintf(&b, "CHARSET_REGISTRY \"ISO10646\"\n")
+	fmt.Fprintf(&b, "CHARSET_ENCODING \"1\"\n")
+	fmt.Fprintf(&b, "ENDPROPERTIES\n")
+	fmt.Fprintf(&b, "CHARS %d\n", len(pl))
+	for _, p := range pl {
+		cluster := p.CS.GraphemeCluster()
+		encoding := -1
+		if len([]rune(cluster)) == 1 {
+			encoding = int(p.CS.FirstCodepoint())
+		}
+		pxMatrix, yOffset := ConvertPatternToMatrix(p.Bytes)
+		w := int((p.Bytes[0] >> 16) & 0xff)
+		h := len(pxMatrix)
+		fmt.Fprintf(&b, "STARTCHAR %s\n", bdfGlyphName(cluster))
+		fmt.Fprintf(&b, "ENCODING %d\n", encoding)
+		advance := p.Metrics.Advance
+		fmt.Fprintf(&b, "SWIDTH %d 0\n", (1000*advance+fs.Size/2)/fs.Size)
+		fmt.Fprintf(&b, "DWIDTH %d 0\n", advance)
+		fmt.Fprintf(&b, "BBX %d %d %d %d\n", w, h, p.Metrics.Bearing, fs.Ascent-int(yOffset)-h)
+		fmt.Fprintf(&b, "BITMAP\n")
+		for _, row := range pxMatrix {
+			rowBytes := make([]byte, (w+7)/8)
+			if len(rowBytes) == 0 {
+				rowBytes = []byte{0}
+			}
+			for x, px := range row {
+				if px == 1 {
+					rowBytes[x/8] |= 0x80 >> uint(x%8)
+				}
+			}
+			fmt.Fprintf(&b, "%X\n", rowBytes)
+		}
+		fmt.Fprintf(&b, "ENDCHAR\n")
+	}
+	fmt.Fprintf(&b, "ENDFONT\n")
+	return b.String()
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2020 Sam Blenny
+// SPDX-License-Identifier: Apache-2.0 OR MIT
+//
+package font
+
+import "testing"
+
+// A BDF font with one 4x2 px glyph
+const testBDF = `STARTFONT 2.1
+FONT test
+SIZE 16 75 75
+FONTBOUNDINGBOX 8 16 0 -4
+STARTPROPERTIES 1
+FONT_ASCENT 12
+ENDPROPERTIES
+CHARS 1
+STARTCHAR A
+ENCODING 65
+SWIDTH 500 0
+DWIDTH 8 0
+BBX 4 2 1 0
+BITMAP
+60
+90
+ENDCHAR
+ENDFONT
+`
+
+func TestBDFParseErrors(t *testing.T) {
+	testSourceErrors(t, "test.bdf", testBDF, []sourceErrorCase{
+		{"bad BBX", "BBX 4 2 1 0", "BBX 4 x 1 0", 13, "bad BBX"},
+		{"bad ENCODING", "ENCODING 65", "ENCODING A", 10, "bad ENCODING"},
+		{"bad DWIDTH", "DWIDTH 8 0", "DWIDTH 300 0", 12, "bad DWIDTH"},
+		{"missing bitmap row", "BBX 4 2 1 0", "BBX 4 3 1 0", 9, "BITMAP has 2 rows but BBX height is 3"},
+		{"bad bitmap digit", "60\n90", "G0\n90", 9, "bad BITMAP row"},
+		{"glyph above ascent", "BBX 4 2 1 0", "BBX 4 2 1 11", 9, "above the font ascent"},
+		{"file ends in glyph", "ENDCHAR\nENDFONT\n", "", 16, "file ends inside of a glyph"},
+		{"no baseline", "FONTBOUNDINGBOX 8 16 0 -4\nSTARTPROPERTIES 1\nFONT_ASCENT 12\n", "", 0, "no FONT_ASCENT"},
+		{"not Unicode", "FONT test\n", "FONT test\nCHARSET_REGISTRY \"KOI8\"\n", 0, "is not Unicode"},
+		{"duplicate glyph", "ENDCHAR\n", "ENDCHAR\nSTARTCHAR B\nENCODING 65\nBBX 0 0 0 0\nBITMAP\nENDCHAR\n", 18, "same grapheme cluster"},
+	}, func(path string) error {
+		_, err := BDFPatterns(FontSpec{Name: "Test", BDF: path, Size: 16}, false)
+		return err
+	})
+}
+
+func TestBDFRoundTrip(t *testing.T) {
+	fs := FontSpec{Name: "Test", Size: 16, Ascent: 12}
+	pl := []BlitPattern{
+		testPattern("41", []string{"..##..", ".#..#.", "######", "#....#"}, 6, 8, 1),
+		// The BBX keeps blank glyphs and padding around the ink
+		testPattern("20", []string{"....", "...."}, 12, 7, 1),
+		testPattern("42", []string{"......", ".####.", ".#..#.", "......"}, 4, 9, 1),
+		testPattern("6a", []string{"...#", "....", "...#", "...#", "#..#", ".##."}, 5, 5, -1),
+		testPattern("1f1fa-1f1f8", []string{"#########", "#.......#", "#########"}, 2, 12, 1),
+	}
+	fs.BDF = writeTestFile(t, "test.bdf", BDFFromPatterns(fs, pl))
+	rt, err := BDFPatterns(fs, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rt) != len(pl) {
+		t.Fatalf("read back %d glyphs instead of %d", len(rt), len(pl))
+	}
+	for i, p := range pl {
+		if !samePattern(rt[i], p) {
+			t.Errorf("glyph %s reads back as pattern %X with %+v instead of pattern %X with %+v",
+				p.CS.HexCluster, rt[i].Bytes, rt[i].Metrics, p.Bytes, p.Metrics)
+		}
+	}
+}
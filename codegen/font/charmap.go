@@ -4,10 +4,10 @@
 package font
 
 import (
-	"fmt"
 	"io/ioutil"
 	"strconv"
 	"strings"
+	"unicode"
 )
 
 // Holds specification for a Unicode block (codepoint bounds and identifying string)
@@ -54,13 +54,13 @@ func KnownBlocks() []UBlock {
 }
 
 // Given a Unicode codepoint, return the Unicode block it belongs to
-func Block(c uint32) UBlock {
+func Block(c uint32) (UBlock, error) {
 	for _, b := range KnownBlocks() {
 		if b.Low <= c && c <= b.High {
-			return b
+			return b, nil
 		}
 	}
-	panic(fmt.Errorf("Codepoint %X belongs to an unknown Unicode block", c))
+	return UBlock{}, &UnknownBlockError{Codepoint: c}
 }
 
 // Holds mappings from extended grapheme clusters to sprite sheet glyph grid coordinates
@@ -72,45 +72,73 @@ type CharSpec struct {
 
 // Parse and return the first codepoint of a hex grapheme cluster string.
 // For example, "1f3c4-200d-2640-fe0f" -> 0x1F3C4
+// Precondition: HexCluster was checked by CheckCharSpecs (or returns 0)
 func (cs CharSpec) FirstCodepoint() uint32 {
-	utf8 := StringFromHexGC(cs.HexCluster)
-	codepoints := []rune(utf8)
+	codepoints := []rune(cs.GraphemeCluster())
+	if len(codepoints) < 1 {
+		return 0
+	}
 	return uint32(codepoints[0])
 }
 
 // Convert a hex grapheme cluster string to a regular utf8 string.
 // For example, "1f3c4-200d-2640-fe0f" -> "\U0001F3C4\u200d\u2640\ufe0f"
+// Precondition: HexCluster was checked by CheckCharSpecs (or returns "")
 func (cs CharSpec) GraphemeCluster() string {
-	return StringFromHexGC(cs.HexCluster)
+	cluster, err := StringFromHexGC(cs.HexCluster)
+	if err != nil {
+		return ""
+	}
+	return cluster
 }
 
 // Parse a hex-codepoint format grapheme cluster into a utf-8 string
 // For example, "1f3c4-200d-2640-fe0f" -> "\U0001F3C4\u200d\u2640\ufe0f"
-func StringFromHexGC(hexGC string) string {
+func StringFromHexGC(hexGC string) (string, error) {
 	base := 16
 	bits := 32
 	cluster := ""
-	hexCodepoints := strings.Split(hexGC, "-")
-	if len(hexCodepoints) < 1 {
-		panic(fmt.Errorf("unexpected value for hexGC: %q", hexGC))
-	}
-	for _, hc := range hexCodepoints {
+	for _, hc := range strings.Split(hexGC, "-") {
 		n, err := strconv.ParseUint(hc, base, bits)
-		if err != nil {
-			panic(fmt.Errorf("unexpected value for hexGC: %q", hexGC))
+		if err != nil || n > unicode.MaxRune {
+			return "", &HexClusterError{HexCluster: hexGC}
 		}
 		cluster += string(rune(n))
 	}
-	return cluster
+	return cluster, nil
+}
+
+// Parse a hex-codepoint format grapheme cluster and find the Unicode block of
+// its first codepoint
+func parseHexGC(hexGC string) (string, UBlock, error) {
+	cluster, err := StringFromHexGC(hexGC)
+	if err != nil {
+		return "", UBlock{}, err
+	}
+	block, err := Block(uint32([]rune(cluster)[0]))
+	return cluster, block, err
+}
+
+// Check that each CharSpec has a parsable grapheme cluster with a first
+// codepoint in one of the KnownBlocks()
+func CheckCharSpecs(csList []CharSpec) error {
+	var errs ErrorList
+	for _, cs := range csList {
+		_, _, err := parseHexGC(cs.HexCluster)
+		errs.Add(err)
+	}
+	return errs.Err()
 }
 
 // Return mapping of hex-codepoint format grapheme clusters to grid coordinates
-// in a glyph sprite sheet for the emoji font
-func EmojiMap(fs FontSpec, inputFile string) []CharSpec {
+// in a glyph sprite sheet for the emoji font. Lines with problems are left out
+// of the returned list and reported in the returned error.
+func EmojiMap(fs FontSpec, inputFile string) ([]CharSpec, error) {
 	text, err := ioutil.ReadFile(inputFile)
 	if err != nil {
-		panic(err)
+		return nil, &FileError{Context{fs.Name, inputFile, 0}, err}
 	}
+	var errs ErrorList
 	// Start at top left corner of the sprite sheet glyph grid
 	row := 0
 	col := 0
@@ -119,12 +147,17 @@ func EmojiMap(fs FontSpec, inputFile string) []CharSpec {
 	// possible. Order of grapheme cluster lines in the file should match a
 	// row-major order traversal of the glyph grid.
 	csList := []CharSpec{}
-	for _, line := range strings.Split(string(text), "\n") {
+	for i, line := range strings.Split(string(text), "\n") {
 		// Trim comments and leading/trailing whitespace
 		txt := strings.TrimSpace(strings.SplitN(line, "#", 2)[0])
 		if len(txt) > 0 {
-			// Add a CharSpec for this grapheme cluster
-			csList = append(csList, CharSpec{txt, row, col})
+			// Add a CharSpec for this grapheme cluster, or skip over its
+			// grid cell if the cluster is not valid
+			if _, _, err := parseHexGC(txt); err != nil {
+				errs.Add(WithContext(err, Context{fs.Name, inputFile, i + 1}))
+			} else {
+				csList = append(csList, CharSpec{txt, row, col})
+			}
 			// Advance to next glyph position by row-major order
 			col += 1
 			if col == fs.Cols {
@@ -134,7 +167,7 @@ func EmojiMap(fs FontSpec, inputFile string) []CharSpec {
 		}
 		// Skip blank lines and comments
 	}
-	return csList
+	return csList, errs.Err()
 }
 
 // Return mapping of hex-codepoint format grapheme clusters to grid coordinates
@@ -380,31 +413,44 @@ func SysLatinMap() []CharSpec {
 type GCAlias struct {
 	CanonHex string // Cannonical form in the index (has a CharSpec)
 	AliasHex string // This one should map to same glyph as CanonHex
+	File     string // Which aliases file has this alias? ("" for built in aliases)
+	Line     int    // Line number in the aliases file
 }
 
-// Return a list of grapheme cluster aliases for the emoji font
-func EmojiAliases(inputFile string) []GCAlias {
+// Return a list of grapheme cluster aliases for the emoji font. Lines with
+// problems are left out of the returned list and reported in the returned error.
+func EmojiAliases(inputFile string) ([]GCAlias, error) {
 	text, err := ioutil.ReadFile(inputFile)
 	if err != nil {
-		panic(err)
+		return nil, &FileError{Context{"", inputFile, 0}, err}
 	}
+	var errs ErrorList
 	// Parse hex format grapheme cluster alias lines that should look like
 	// "1f004 1f004-fe0f\n". First grapheme cluster is from the primary
 	// index, second cluster is the alias which should get the same glyph.
 	// Comments starting with "#" are possible.
 	gcaList := []GCAlias{}
-	for _, line := range strings.Split(string(text), "\n") {
+	for i, line := range strings.Split(string(text), "\n") {
 		// Trim comments and leading/trailing whitespace
 		txt := strings.TrimSpace(strings.SplitN(line, "#", 2)[0])
 		clusters := strings.Split(txt, " ")
 		if len(clusters) == 2 && len(clusters[0]) > 0 && len(clusters[1]) > 0 {
 			primary := clusters[0]
 			alias := clusters[1]
-			gcaList = append(gcaList, GCAlias{primary, alias})
+			valid := true
+			for _, hexGC := range clusters {
+				if _, _, err := parseHexGC(hexGC); err != nil {
+					errs.Add(WithContext(err, Context{"", inputFile, i + 1}))
+					valid = false
+				}
+			}
+			if valid {
+				gcaList = append(gcaList, GCAlias{primary, alias, inputFile, i + 1})
+			}
 		}
 		// Skip blank lines, comments, etc.
 	}
-	return gcaList
+	return gcaList, errs.Err()
 }
 
 // Return a list of grapheme cluster aliases for the system latin font so that
@@ -413,58 +459,58 @@ func EmojiAliases(inputFile string) []GCAlias {
 // the primary index. This helps avoid the need to normalize UTF-8 strings.
 func SysLatinAliases() []GCAlias {
 	return []GCAlias{
-		GCAlias{"C0", "41-300"}, // nfc: [C0, À],  nfd: [41-300, À]
-		GCAlias{"C1", "41-301"}, // nfc: [C1, Á],  nfd: [41-301, Á]
-		GCAlias{"C2", "41-302"}, // nfc: [C2, Â],  nfd: [41-302, Â]
-		GCAlias{"C3", "41-303"}, // nfc: [C3, Ã],  nfd: [41-303, Ã]
-		GCAlias{"C4", "41-308"}, // nfc: [C4, Ä],  nfd: [41-308, Ä]
-		GCAlias{"C5", "41-30A"}, // nfc: [C5, Å],  nfd: [41-30A, Å]
-		GCAlias{"C7", "43-327"}, // nfc: [C7, Ç],  nfd: [43-327, Ç]
-		GCAlias{"C8", "45-300"}, // nfc: [C8, È],  nfd: [45-300, È]
-		GCAlias{"C9", "45-301"}, // nfc: [C9, É],  nfd: [45-301, É]
-		GCAlias{"CA", "45-302"}, // nfc: [CA, Ê],  nfd: [45-302, Ê]
-		GCAlias{"CB", "45-308"}, // nfc: [CB, Ë],  nfd: [45-308, Ë]
-		GCAlias{"CC", "49-300"}, // nfc: [CC, Ì],  nfd: [49-300, Ì]
-		GCAlias{"CD", "49-301"}, // nfc: [CD, Í],  nfd: [49-301, Í]
-		GCAlias{"CE", "49-302"}, // nfc: [CE, Î],  nfd: [49-302, Î]
-		GCAlias{"CF", "49-308"}, // nfc: [CF, Ï],  nfd: [49-308, Ï]
-		GCAlias{"D1", "4E-303"}, // nfc: [D1, Ñ],  nfd: [4E-303, Ñ]
-		GCAlias{"D2", "4F-300"}, // nfc: [D2, Ò],  nfd: [4F-300, Ò]
-		GCAlias{"D3", "4F-301"}, // nfc: [D3, Ó],  nfd: [4F-301, Ó]
-		GCAlias{"D4", "4F-302"}, // nfc: [D4, Ô],  nfd: [4F-302, Ô]
-		GCAlias{"D5", "4F-303"}, // nfc: [D5, Õ],  nfd: [4F-303, Õ]
-		GCAlias{"D6", "4F-308"}, // nfc: [D6, Ö],  nfd: [4F-308, Ö]
-		GCAlias{"D9", "55-300"}, // nfc: [D9, Ù],  nfd: [55-300, Ù]
-		GCAlias{"DA", "55-301"}, // nfc: [DA, Ú],  nfd: [55-301, Ú]
-		GCAlias{"DB", "55-302"}, // nfc: [DB, Û],  nfd: [55-302, Û]
-		GCAlias{"DC", "55-308"}, // nfc: [DC, Ü],  nfd: [55-308, Ü]
-		GCAlias{"DD", "59-301"}, // nfc: [DD, Ý],  nfd: [59-301, Ý]
-		GCAlias{"E0", "61-300"}, // nfc: [E0, à],  nfd: [61-300, à]
-		GCAlias{"E1", "61-301"}, // nfc: [E1, á],  nfd: [61-301, á]
-		GCAlias{"E2", "61-302"}, // nfc: [E2, â],  nfd: [61-302, â]
-		GCAlias{"E3", "61-303"}, // nfc: [E3, ã],  nfd: [61-303, ã]
-		GCAlias{"E4", "61-308"}, // nfc: [E4, ä],  nfd: [61-308, ä]
-		GCAlias{"E5", "61-30A"}, // nfc: [E5, å],  nfd: [61-30A, å]
-		GCAlias{"E7", "63-327"}, // nfc: [E7, ç],  nfd: [63-327, ç]
-		GCAlias{"E8", "65-300"}, // nfc: [E8, è],  nfd: [65-300, è]
-		GCAlias{"E9", "65-301"}, // nfc: [E9, é],  nfd: [65-301, é]
-		GCAlias{"EA", "65-302"}, // nfc: [EA, ê],  nfd: [65-302, ê]
-		GCAlias{"EB", "65-308"}, // nfc: [EB, ë],  nfd: [65-308, ë]
-		GCAlias{"EC", "69-300"}, // nfc: [EC, ì],  nfd: [69-300, ì]
-		GCAlias{"ED", "69-301"}, // nfc: [ED, í],  nfd: [69-301, í]
-		GCAlias{"EE", "69-302"}, // nfc: [EE, î],  nfd: [69-302, î]
-		GCAlias{"EF", "69-308"}, // nfc: [EF, ï],  nfd: [69-308, ï]
-		GCAlias{"F1", "6E-303"}, // nfc: [F1, ñ],  nfd: [6E-303, ñ]
-		GCAlias{"F2", "6F-300"}, // nfc: [F2, ò],  nfd: [6F-300, ò]
-		GCAlias{"F3", "6F-301"}, // nfc: [F3, ó],  nfd: [6F-301, ó]
-		GCAlias{"F4", "6F-302"}, // nfc: [F4, ô],  nfd: [6F-302, ô]
-		GCAlias{"F5", "6F-303"}, // nfc: [F5, õ],  nfd: [6F-303, õ]
-		GCAlias{"F6", "6F-308"}, // nfc: [F6, ö],  nfd: [6F-308, ö]
-		GCAlias{"F9", "75-300"}, // nfc: [F9, ù],  nfd: [75-300, ù]
-		GCAlias{"FA", "75-301"}, // nfc: [FA, ú],  nfd: [75-301, ú]
-		GCAlias{"FB", "75-302"}, // nfc: [FB, û],  nfd: [75-302, û]
-		GCAlias{"FC", "75-308"}, // nfc: [FC, ü],  nfd: [75-308, ü]
-		GCAlias{"FD", "79-301"}, // nfc: [FD, ý],  nfd: [79-301, ý]
-		GCAlias{"FF", "79-308"}, // nfc: [FF, ÿ],  nfd: [79-308, ÿ]
+		{CanonHex: "C0", AliasHex: "41-300"}, // nfc: [C0, À],  nfd: [41-300, À]
+		{CanonHex: "C1", AliasHex: "41-301"}, // nfc: [C1, Á],  nfd: [41-301, Á]
+		{CanonHex: "C2", AliasHex: "41-302"}, // nfc: [C2, Â],  nfd: [41-302, Â]
+		{CanonHex: "C3", AliasHex: "41-303"}, // nfc: [C3, Ã],  nfd: [41-303, Ã]
+		{CanonHex: "C4", AliasHex: "41-308"}, // nfc: [C4, Ä],  nfd: [41-308, Ä]
+		{CanonHex: "C5", AliasHex: "41-30A"}, // nfc: [C5, Å],  nfd: [41-30A, Å]
+		{CanonHex: "C7", AliasHex: "43-327"}, // nfc: [C7, Ç],  nfd: [43-327, Ç]
+		{CanonHex: "C8", AliasHex: "45-300"}, // nfc: [C8, È],  nfd: [45-300, È]
+		{CanonHex: "C9", AliasHex: "45-301"}, // nfc: [C9, É],  nfd: [45-301, É]
+		{CanonHex: "CA", AliasHex: "45-302"}, // nfc: [CA, Ê],  nfd: [45-302, Ê]
+		{CanonHex: "CB", AliasHex: "45-308"}, // nfc: [CB, Ë],  nfd: [45-308, Ë]
+		{CanonHex: "CC", AliasHex: "49-300"}, // nfc: [CC, Ì],  nfd: [49-300, Ì]
+		{CanonHex: "CD", AliasHex: "49-301"}, // nfc: [CD, Í],  nfd: [49-301, Í]
+		{CanonHex: "CE", AliasHex: "49-302"}, // nfc: [CE, Î],  nfd: [49-302, Î]
+		{CanonHex: "CF", AliasHex: "49-308"}, // nfc: [CF, Ï],  nfd: [49-308, Ï]
+		{CanonHex: "D1", AliasHex: "4E-303"}, // nfc: [D1, Ñ],  nfd: [4E-303, Ñ]
+		{CanonHex: "D2", AliasHex: "4F-300"}, // nfc: [D2, Ò],  nfd: [4F-300, Ò]
+		{CanonHex: "D3", AliasHex: "4F-301"}, // nfc: [D3, Ó],  nfd: [4F-301, Ó]
+		{CanonHex: "D4", AliasHex: "4F-302"}, // nfc: [D4, Ô],  nfd: [4F-302, Ô]
+		{CanonHex: "D5", AliasHex: "4F-303"}, // nfc: [D5, Õ],  nfd: [4F-303, Õ]
+		{CanonHex: "D6", AliasHex: "4F-308"}, // nfc: [D6, Ö],  nfd: [4F-308, Ö]
+		{CanonHex: "D9", AliasHex: "55-300"}, // nfc: [D9, Ù],  nfd: [55-300, Ù]
+		{CanonHex: "DA", AliasHex: "55-301"}, // nfc: [DA, Ú],  nfd: [55-301, Ú]
+		{CanonHex: "DB", AliasHex: "55-302"}, // nfc: [DB, Û],  nfd: [55-302, Û]
+		{CanonHex: "DC", AliasHex: "55-308"}, // nfc: [DC, Ü],  nfd: [55-308, Ü]
+		{CanonHex: "DD", AliasHex: "59-301"}, // nfc: [DD, Ý],  nfd: [59-301, Ý]
+		{CanonHex: "E0", AliasHex: "61-300"}, // nfc: [E0, à],  nfd: [61-300, à]
+		{CanonHex: "E1", AliasHex: "61-301"}, // nfc: [E1, á],  nfd: [61-301, á]
+		{CanonHex: "E2", AliasHex: "61-302"}, // nfc: [E2, â],  nfd: [61-302, â]
+		{CanonHex: "E3", AliasHex: "61-303"}, // nfc: [E3, ã],  nfd: [61-303, ã]
+		{CanonHex: "E4", AliasHex: "61-308"}, // nfc: [E4, ä],  nfd: [61-308, ä]
+		{CanonHex: "E5", AliasHex: "61-30A"}, // nfc: [E5, å],  nfd: [61-30A, å]
+		{CanonHex: "E7", AliasHex: "63-327"}, // nfc: [E7, ç],  nfd: [63-327, ç]
+		{CanonHex: "E8", AliasHex: "65-300"}, // nfc: [E8, è],  nfd: [65-300, è]
+		{CanonHex: "E9", AliasHex: "65-301"}, // nfc: [E9, é],  nfd: [65-301, é]
+		{CanonHex: "EA", AliasHex: "65-302"}, // nfc: [EA, ê],  nfd: [65-302, ê]
+		{CanonHex: "EB", AliasHex: "65-308"}, // nfc: [EB, ë],  nfd: [65-308, ë]
+		{CanonHex: "EC", AliasHex: "69-300"}, // nfc: [EC, ì],  nfd: [69-300, ì]
+		{CanonHex: "ED", AliasHex: "69-301"}, // nfc: [ED, í],  nfd: [69-301, í]
+		{CanonHex: "EE", AliasHex: "69-302"}, // nfc: [EE, î],  nfd: [69-302, î]
+		{CanonHex: "EF", AliasHex: "69-308"}, // nfc: [EF, ï],  nfd: [69-308, ï]
+		{CanonHex: "F1", AliasHex: "6E-303"}, // nfc: [F1, ñ],  nfd: [6E-303, ñ]
+		{CanonHex: "F2", AliasHex: "6F-300"}, // nfc: [F2, ò],  nfd: [6F-300, ò]
+		{CanonHex: "F3", AliasHex: "6F-301"}, // nfc: [F3, ó],  nfd: [6F-301, ó]
+		{CanonHex: "F4", AliasHex: "6F-302"}, // nfc: [F4, ô],  nfd: [6F-302, ô]
+		{CanonHex: "F5", AliasHex: "6F-303"}, // nfc: [F5, õ],  nfd: [6F-303, õ]
+		{CanonHex: "F6", AliasHex: "6F-308"}, // nfc: [F6, ö],  nfd: [6F-308, ö]
+		{CanonHex: "F9", AliasHex: "75-300"}, // nfc: [F9, ù],  nfd: [75-300, ù]
+		{CanonHex: "FA", AliasHex: "75-301"}, // nfc: [FA, ú],  nfd: [75-301, ú]
+		{CanonHex: "FB", AliasHex: "75-302"}, // nfc: [FB, û],  nfd: [75-302, û]
+		{CanonHex: "FC", AliasHex: "75-308"}, // nfc: [FC, ü],  nfd: [75-308, ü]
+		{CanonHex: "FD", AliasHex: "79-301"}, // nfc: [FD, ý],  nfd: [79-301, ý]
+		{CanonHex: "FF", AliasHex: "79-308"}, // nfc: [FF, ÿ],  nfd: [79-308, ÿ]
 	}
 }
@@ -0,0 +1,185 @@
+// Copyright (c) 2020 Sam Blenny
+// SPDX-License-Identifier: Apache-2.0 OR MIT
+//
+package font
+
+import (
+	"image"
+	"image/color"
+	"math"
+)
+
+// Holds a neighbor of a pixel that gets part of its error with error diffusion
+// dithering, and the part of the error it gets
+type ditherWeight struct {
+	dx     int
+	dy     int
+	weight float64
+}
+
+// Error diffusion kernels. Floyd-Steinberg passes on all of the error, while
+// Atkinson passes on 3/4 of it, which keeps more contrast in light and dark
+// areas at the cost of some detail.
+var ditherKernels = map[string][]ditherWeight{
+	"floyd-steinberg": {{1, 0, 7.0 / 16}, {-1, 1, 3.0 / 16}, {0, 1, 5.0 / 16}, {1, 1, 1.0 / 16}},
+	"atkinson":        {{1, 0, 1.0 / 8}, {2, 0, 1.0 / 8}, {-1, 1, 1.0 / 8}, {0, 1, 1.0 / 8}, {1, 1, 1.0 / 8}, {0, 2, 1.0 / 8}},
+}
+
+// 8x8 Bayer matrix for ordered dithering
+var bayer8 = [8][8]int{
+	{0, 32, 8, 40, 2, 34, 10, 42},
+	{48, 16, 56, 24, 50, 18, 58, 26},
+	{12, 44, 4, 36, 14, 46, 6, 38},
+	{60, 28, 52, 20, 62, 30, 54, 22},
+	{3, 35, 11, 43, 1, 33, 9, 41},
+	{51, 19, 59, 27, 49, 17, 57, 25},
+	{15, 47, 7, 39, 13, 45, 5, 37},
+	{63, 31, 55, 23, 61, 29, 53, 21},
+}
+
+// Return the names of the dithering algorithms
+func DitherNames() []string {
+	return []string{"threshold", "bayer", "floyd-steinberg", "atkinson"}
+}
+
+// Convert the pixels of a rectangle of an image to a 1-bit matrix by luma,
+// after adjusting gamma and contrast, with the ordered or error diffusion
+// dithering algorithm of the ink spec. Levels go from 0 (black) to 255 (white),
+// and dark pixels are ink (or light pixels when ink.Invert is set). Pixels that
+// match a color key are never ink, and get no diffused error.
+//
+// With bits = 2, levels get quantized to 4 coverage values instead of 2, with
+// ink.Threshold shifting the cutoffs between them. For "alpha" ink, the level
+// of a pixel is how transparent it is.
+func ditherImageToMatrix(img image.Image, rect image.Rectangle, ink InkSpec, bits int) Matrix {
+	w, h := rect.Dx(), rect.Dy()
+	levels := make([][]float64, h)
+	keyed := make([][]bool, h)
+	for y := range levels {
+		levels[y] = make([]float64, w)
+		keyed[y] = make([]bool, w)
+		for x := range levels[y] {
+			c := img.At(rect.Min.X+x, rect.Min.Y+y)
+			if ink.isColorKey(c) {
+				keyed[y][x] = true
+				continue
+			}
+			if ink.Mode == "alpha" {
+				_, _, _, a := c.RGBA()
+				levels[y][x] = float64(0xff - a>>8)
+			} else {
+				levels[y][x] = ink.adjustLevel(luma(c))
+			}
+			if ink.Invert {
+				levels[y][x] = 0xff - levels[y][x]
+			}
+		}
+	}
+	pxMatrix := Matrix{}
+	threshold := float64(ink.Threshold)
+	if ink.Mode == "alpha" {
+		threshold = 0xff - threshold
+	}
+	if ink.Invert {
+		threshold = 0xff - threshold
+	}
+	coverage := 1
+	if bits == 2 {
+		coverage = 3
+	}
+	step := float64(0xff) / float64(coverage)
+	kernel := ditherKernels[ink.Dither]
+	for y := 0; y < h; y++ {
+		row := make(MatrixRow, w)
+		for x := 0; x < w; x++ {
+			if keyed[y][x] {
+				continue
+			}
+			level := levels[y][x]
+			offset := 0.0
+			if ink.Dither == "bayer" {
+				offset = ((float64(bayer8[y%8][x%8])+0.5)*4 - 128) * step / 0xff
+			}
+			// Cutoffs between coverage values are one step of level apart, with
+			// the middle one at the threshold
+			for k := 1; k <= coverage; k++ {
+				if level < threshold+offset+127.5-(float64(k)-0.5)*step {
+					row[x] = k
+				}
+			}
+			diffError := level - (0xff - float64(row[x])*step)
+			for _, k := range kernel {
+				nx, ny := x+k.dx, y+k.dy
+				if nx >= 0 && nx < w && ny < h && !keyed[ny][nx] {
+					levels[ny][nx] += diffError * k.weight
+				}
+			}
+		}
+		pxMatrix = append(pxMatrix, row)
+	}
+	return pxMatrix
+}
+
+// Return a luma level after adjusting gamma and contrast. Gamma above 1 makes
+// mid tones lighter, and contrast above 1 pushes levels away from the middle.
+func (ink InkSpec) adjustLevel(y int) float64 {
+	level := float64(y)
+	if ink.Gamma > 0 && ink.Gamma != 1 {
+		level = 0xff * math.Pow(level/0xff, 1/ink.Gamma)
+	}
+	if ink.Contrast > 0 && ink.Contrast != 1 {
+		level = math.Max(0, math.Min(0xff, (level-128)*ink.Contrast+128))
+	}
+	return level
+}
+
+// Return true if a pixel matches one of the color keys of an ink spec
+func (ink InkSpec) isColorKey(c color.Color) bool {
+	nc := color.NRGBAModel.Convert(c).(color.NRGBA)
+	for _, k := range ink.ColorKeys {
+		if nc.A != 0 && nc.R == k.R && nc.G == k.G && nc.B == k.B {
+			return true
+		}
+	}
+	return false
+}
+
+// Scale an image to h px tall, keeping its aspect ratio, by averaging the
+// pixels that each new pixel covers. This lets large color art, like 72x72
+// emoji PNGs, be dithered straight into glyphs.
+func ScaleImage(img image.Image, h int) image.Image {
+	src := img.Bounds()
+	if src.Dy() == h || src.Empty() {
+		return img
+	}
+	w := int(math.Round(float64(src.Dx()) * float64(h) / float64(src.Dy())))
+	if w < 1 {
+		w = 1
+	}
+	sx := float64(src.Dx()) / float64(w)
+	sy := float64(src.Dy()) / float64(h)
+	dst := image.NewRGBA64(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		y0, y1 := float64(y)*sy, float64(y+1)*sy
+		for x := 0; x < w; x++ {
+			x0, x1 := float64(x)*sx, float64(x+1)*sx
+			var sum [4]float64
+			area := 0.0
+			for py := int(y0); float64(py) < y1; py++ {
+				cy := math.Min(y1, float64(py+1)) - math.Max(y0, float64(py))
+				for px := int(x0); float64(px) < x1; px++ {
+					cx := math.Min(x1, float64(px+1)) - math.Max(x0, float64(px))
+					r, g, b, a := img.At(src.Min.X+px, src.Min.Y+py).RGBA()
+					for i, v := range [4]uint32{r, g, b, a} {
+						sum[i] += float64(v) * cx * cy
+					}
+					area += cx * cy
+				}
+			}
+			dst.SetRGBA64(x, y, color.RGBA64{
+				uint16(math.Round(sum[0] / area)), uint16(math.Round(sum[1] / area)),
+				uint16(math.Round(sum[2] / area)), uint16(math.Round(sum[3] / area))})
+		}
+	}
+	return dst
+}
@@ -0,0 +1,210 @@
+// Copyright (c) 2020 Sam Blenny
+// SPDX-License-Identifier: Apache-2.0 OR MIT
+//
+package font
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Holds the location of a problem in the sources for a font. Fields that do
+// not apply, or are not known yet, are left as zero values.
+type Context struct {
+	Font string // Name of font
+	File string // Source file (sprite sheet, index, alias list, etc.)
+	Line int    // Line number in File, or 0 for non-text files
+}
+
+// Format context as a prefix for error messages, like "file:line: Font: "
+func (c *Context) prefix() string {
+	s := ""
+	if c.File != "" {
+		s += c.File
+		if c.Line > 0 {
+			s += fmt.Sprintf(":%d", c.Line)
+		}
+		s += ": "
+	}
+	if c.Font != "" {
+		s += c.Font + ": "
+	}
+	return s
+}
+
+// Return a pointer to the context so WithContext can fill in missing fields
+func (c *Context) context() *Context {
+	return c
+}
+
+// Interface for errors from this package that embed a Context
+type contextual interface {
+	context() *Context
+}
+
+// Fill in the missing context fields of an error (or list of errors) from this
+// package. Errors of other types are returned unchanged.
+func WithContext(err error, ctx Context) error {
+	if list, ok := err.(ErrorList); ok {
+		for i := range list {
+			list[i] = WithContext(list[i], ctx)
+		}
+		return list
+	}
+	if ce, ok := err.(contextual); ok {
+		c := ce.context()
+		if c.Font == "" {
+			c.Font = ctx.Font
+		}
+		if c.File == "" {
+			c.File = ctx.File
+			c.Line = ctx.Line
+		}
+	}
+	return err
+}
+
+// Error for a codepoint that is not in any of the KnownBlocks()
+type UnknownBlockError struct {
+	Context
+	Codepoint uint32
+}
+
+func (e *UnknownBlockError) Error() string {
+	return fmt.Sprintf("%scodepoint %X belongs to an unknown Unicode block", e.prefix(), e.Codepoint)
+}
+
+// Error for a hex-codepoint grapheme cluster string that does not parse
+type HexClusterError struct {
+	Context
+	HexCluster string
+}
+
+func (e *HexClusterError) Error() string {
+	return fmt.Sprintf("%sbad hex grapheme cluster %q", e.prefix(), e.HexCluster)
+}
+
+// Error for a CharSpec that points outside of the sprite sheet's glyph grid
+type GridCellError struct {
+	Context
+	HexCluster string
+	Row        int
+	Col        int
+	Rows       int // How many rows the grid has
+	Cols       int // How many columns the grid has
+}
+
+func (e *GridCellError) Error() string {
+	return fmt.Sprintf("%sgrid cell (row %d, col %d) for %q is outside the %d x %d glyph grid",
+		e.prefix(), e.Row, e.Col, e.HexCluster, e.Rows, e.Cols)
+}
+
+// Error for an alias whose canonical grapheme cluster is not in the index
+type MissingCanonError struct {
+	Context
+	CanonHex string
+	AliasHex string
+}
+
+func (e *MissingCanonError) Error() string {
+	return fmt.Sprintf("%salias %q refers to canonical cluster %q which has no glyph",
+		e.prefix(), e.AliasHex, e.CanonHex)
+}
+
+// Error for a grapheme cluster that has no glyph in the index of a font
+type MissingGlyphError struct {
+	Context
+	Cluster string // Parsed UTF-8 form (not hex codepoints)
+	Block   string
+}
+
+func (e *MissingGlyphError) Error() string {
+	return fmt.Sprintf("%sfont has no glyph for grapheme cluster %+q in block %s", e.prefix(), e.Cluster, e.Block)
+}
+
+// Error for two distinct grapheme clusters in the same Unicode block with the
+// same Murmur3 hash, which would make index lookups ambiguous
+type HashCollisionError struct {
+	Context
+	Block    string
+	Seed     uint32
+	Hash     uint32
+	Clusters [2]string // Parsed UTF-8 form (not hex codepoints)
+}
+
+func (e *HashCollisionError) Error() string {
+	return fmt.Sprintf("%s%+q and %+q have the same murmur3 hash 0x%08X (seed %d) in block %s",
+		e.prefix(), e.Clusters[0], e.Clusters[1], e.Hash, e.Seed, e.Block)
+}
+
+// Error for when none of the Murmur3 seeds in a range give an index without
+// hash collisions. Collisions holds the collisions for the first seed.
+type SeedSearchError struct {
+	Context
+	FirstSeed  uint32
+	LastSeed   uint32
+	Collisions []*HashCollisionError
+}
+
+func (e *SeedSearchError) Error() string {
+	var collisions []string
+	for _, c := range e.Collisions {
+		collisions = append(collisions, fmt.Sprintf("%+q and %+q have hash 0x%08X in block %s",
+			c.Clusters[0], c.Clusters[1], c.Hash, c.Block))
+	}
+	return fmt.Sprintf("%sno collision-free murmur3 seed from %d to %d (with seed %d, %s)",
+		e.prefix(), e.FirstSeed, e.LastSeed, e.FirstSeed, strings.Join(collisions, "; "))
+}
+
+// Error for a problem in a font source file (BDF font, Unifont .hex, etc.)
+type SourceError struct {
+	Context
+	Msg string
+}
+
+func (e *SourceError) Error() string {
+	return fmt.Sprintf("%s%s", e.prefix(), e.Msg)
+}
+
+// Error for a source file that could not be read or decoded
+type FileError struct {
+	Context
+	Err error
+}
+
+func (e *FileError) Error() string {
+	return fmt.Sprintf("%s%v", e.prefix(), e.Err)
+}
+
+// Holds a list of errors so a single run can report all problems at once
+type ErrorList []error
+
+func (list ErrorList) Error() string {
+	var lines []string
+	for _, err := range list {
+		lines = append(lines, err.Error())
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Append an error to the list, flattening nested lists and skipping nil
+func (list *ErrorList) Add(err error) {
+	if err == nil {
+		return
+	}
+	if more, ok := err.(ErrorList); ok {
+		for _, e := range more {
+			list.Add(e)
+		}
+		return
+	}
+	*list = append(*list, err)
+}
+
+// Return the list as an error, or nil if the list is empty
+func (list ErrorList) Err() error {
+	if len(list) == 0 {
+		return nil
+	}
+	return list
+}
@@ -0,0 +1,83 @@
+// Copyright (c) 2020 Sam Blenny
+// SPDX-License-Identifier: Apache-2.0 OR MIT
+//
+package font
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// Holds a change to a valid font source that should make it fail to read
+type sourceErrorCase struct {
+	name string
+	old  string // Text of the valid source to replace
+	new  string // Replacement text
+	line int    // Line of the expected error, or 0 for the whole file
+	msg  string // Part of the expected error message
+}
+
+// Write a font source file to a temporary directory and return its path
+func writeTestFile(t *testing.T, name string, text string) string {
+	t.Helper()
+	p := filepath.Join(t.TempDir(), name)
+	if err := ioutil.WriteFile(p, []byte(text), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+// Make each change of cases to the source text, read it from a file named
+// name, and make sure the read fails with a SourceError for the case's line
+func testSourceErrors(t *testing.T, name string, text string, cases []sourceErrorCase, read func(path string) error) {
+	t.Helper()
+	for _, c := range cases {
+		if !strings.Contains(text, c.old) {
+			t.Fatalf("%s: source has no %q to replace", c.name, c.old)
+		}
+		path := writeTestFile(t, name, strings.Replace(text, c.old, c.new, 1))
+		err := read(path)
+		list, ok := err.(ErrorList)
+		found := false
+		for _, e := range list {
+			if se, ok := e.(*SourceError); ok && se.File == path && se.Line == c.line && strings.Contains(se.Msg, c.msg) {
+				found = true
+			}
+		}
+		if !ok || !found {
+			t.Errorf("%s: expected an error on line %d about %q, got:\n%v", c.name, c.line, c.msg, err)
+		}
+	}
+}
+
+func TestWithContext(t *testing.T) {
+	var errs ErrorList
+	errs.Add(&SourceError{Context{File: "a.bdf", Line: 3}, "bad BBX"})
+	errs.Add(ErrorList{&HexClusterError{HexCluster: "zz"}, nil})
+	errs.Add(nil)
+	err := WithContext(errs.Err(), Context{Font: "Test", File: "index.txt", Line: 7})
+	want := "a.bdf:3: Test: bad BBX\nindex.txt:7: Test: bad hex grapheme cluster \"zz\""
+	if err == nil || err.Error() != want {
+		t.Errorf("got error:\n%v\nexpected:\n%s", err, want)
+	}
+	if (ErrorList{}).Err() != nil {
+		t.Errorf("empty ErrorList is not a nil error")
+	}
+}
+
+func TestExportErrors(t *testing.T) {
+	fs := FontSpec{Name: "Test", Size: 8, Ascent: 6}
+	tall := []BlitPattern{testPattern("41", []string{"#", "#", "#"}, 6, 2, 0)}
+	_, err := PSF2FromPatterns(fs, tall, nil)
+	if se, ok := err.(*SourceError); !ok || se.Font != "Test" || !strings.Contains(se.Msg, "below the bottom of the 8 px line") {
+		t.Errorf("PSF2 of a glyph below the line: got %T error %v", err, err)
+	}
+	fs.Size = 200
+	_, _, err = SFNTFromPatterns(fs, tall, nil)
+	err = WithContext(err, Context{File: "test.otf"})
+	if se, ok := err.(*SourceError); !ok || se.Error() != "test.otf: Test: size is 200 px, but OpenType bitmap strikes can be at most 127 px" {
+		t.Errorf("OpenType font with a 200 px size: got %T error %v", err, err)
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright (c) 2020 Sam Blenny
+// SPDX-License-Identifier: Apache-2.0 OR MIT
+//
+package font
+
+import (
+	"fmt"
+	"image"
+	"image/png"
+	"io/ioutil"
+	"os"
+	"path"
+	"sort"
+	"strings"
+)
+
+// Holds the image of one glyph from a glyph directory
+type GlyphImage struct {
+	HexCluster string // Normalized hex grapheme cluster, like "1f3c4-200d-2640-fe0f"
+	File       string // Path of the PNG file
+	Image      image.Image
+}
+
+// Read a directory with one PNG file per glyph and pack the glyphs into a list
+// of blit patterns. Each file is named by the hex grapheme cluster of its glyph,
+// like "1f3c4-200d-2640-fe0f.png", so no character map is needed, and adding a
+// glyph doesn't shift the others. Images must be fs.Size px tall, and may be up
+// to 255 px wide, unless fs.Scale is set, which scales taller or shorter images
+// to fs.Size px tall first. Pixels get converted to 1-bit and trimmed the same
+// way as for sprite sheets with max trim, and each glyph advances by the width
+// of its image. Glyphs with problems are left out, and the
+// returned error lists them.
+func GlyphDirPatterns(fs FontSpec, dbg bool) ([]BlitPattern, error) {
+	glyphs, err := ReadGlyphDir(fs)
+	var errs ErrorList
+	errs.Add(err)
+	var patternList []BlitPattern
+	for _, g := range glyphs {
+		if fs.Scale {
+			g.Image = ScaleImage(g.Image, fs.Size)
+		}
+		bounds := g.Image.Bounds()
+		if bounds.Dy() != fs.Size || bounds.Dx() < 1 || bounds.Dx() > 0xff {
+			errs.Add(&SourceError{Context{fs.Name, g.File, 0}, fmt.Sprintf(
+				"image is %dx%d px, but glyphs must be %d px tall and 1 to 255 px wide",
+				bounds.Dx(), bounds.Dy(), fs.Size)})
+			continue
+		}
+		pxMatrix := convertImageToMatrix(g.Image, bounds, fs.Ink, fs.Bits)
+		trimSpec := fs
+		trimSpec.Trim = "max"
+		pxMatrix, yOffset, leftTrim := trimMatrix(trimSpec, -1, -1, pxMatrix)
+		cs := CharSpec{g.HexCluster, 0, 0}
+		debugMatrix(cs, pxMatrix, fs.Bits, dbg)
+		metrics := glyphMetrics(bounds.Dx(), leftTrim, pxMatrix)
+		patternList = append(patternList, BlitPattern{convertMatrixToPattern(pxMatrix, yOffset, fs.Bits), cs, metrics})
+	}
+	return patternList, errs.Err()
+}
+
+// Read the PNG files of the glyph directory fs.Glyphs. Glyphs are sorted by
+// codepoints, so the order doesn't depend on how file names sort. Files that
+// are not PNG files are ignored. Files with problems are left out, and the
+// returned error lists them.
+func ReadGlyphDir(fs FontSpec) ([]GlyphImage, error) {
+	files, err := ioutil.ReadDir(fs.Glyphs)
+	if err != nil {
+		return nil, &FileError{Context{fs.Name, fs.Glyphs, 0}, err}
+	}
+	var errs ErrorList
+	report := func(name string, format string, a ...interface{}) {
+		errs.Add(&SourceError{Context{fs.Name, path.Join(fs.Glyphs, name), 0}, fmt.Sprintf(format, a...)})
+	}
+	type glyphFile struct {
+		cluster []rune
+		glyph   GlyphImage
+	}
+	var glyphs []glyphFile
+	seen := map[string]string{}
+	for _, info := range files {
+		name := info.Name()
+		if info.IsDir() || strings.ToLower(path.Ext(name)) != ".png" {
+			continue
+		}
+		cluster, err := StringFromHexGC(strings.TrimSuffix(name, path.Ext(name)))
+		if err != nil {
+			report(name, "file name is not a hex grapheme cluster like \"1f3c4-200d-2640-fe0f.png\"")
+			continue
+		}
+		// Normalize the cluster so names like 00E9.png and e9.png match
+		var hexCPs []string
+		for _, c := range cluster {
+			hexCPs = append(hexCPs, fmt.Sprintf("%x", c))
+		}
+		hexGC := strings.Join(hexCPs, "-")
+		if prev, dup := seen[hexGC]; dup {
+			report(name, "grapheme cluster %q is the same as for %s", hexGC, prev)
+			continue
+		}
+		seen[hexGC] = name
+		file, err := os.Open(path.Join(fs.Glyphs, name))
+		if err != nil {
+			errs.Add(&FileError{Context{fs.Name, path.Join(fs.Glyphs, name), 0}, err})
+			continue
+		}
+		img, err := png.Decode(file)
+		file.Close()
+		if err != nil {
+			errs.Add(&FileError{Context{fs.Name, path.Join(fs.Glyphs, name), 0}, err})
+			continue
+		}
+		glyphs = append(glyphs, glyphFile{[]rune(cluster), GlyphImage{hexGC, path.Join(fs.Glyphs, name), img}})
+	}
+	sort.Slice(glyphs, func(i, j int) bool {
+		a, b := glyphs[i].cluster, glyphs[j].cluster
+		for n := 0; n < len(a) && n < len(b); n++ {
+			if a[n] != b[n] {
+				return a[n] < b[n]
+			}
+		}
+		return len(a) < len(b)
+	})
+	var glyphImages []GlyphImage
+	for _, g := range glyphs {
+		glyphImages = append(glyphImages, g.glyph)
+	}
+	return glyphImages, errs.Err()
+}
@@ -0,0 +1,99 @@
+// Copyright (c) 2020 Sam Blenny
+// SPDX-License-Identifier: Apache-2.0 OR MIT
+//
+package font
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"strconv"
+	"strings"
+)
+
+// Holds the rules for converting the pixels of a glyph image to 1-bit ink. The
+// zero value uses the original rule, where pixels with a red channel of 0 are
+// ink, which works for black glyphs on sheets with white (or red) guide marks.
+type InkSpec struct {
+	Mode      string        // Which pixels are ink? ("red", "luma", or "alpha")
+	Threshold int           // Luma below this level, or alpha at or above it, is ink (0 to 255)
+	Invert    bool          // Swap ink and background, for light glyphs on a dark sheet?
+	ColorKeys []color.NRGBA // Colors that are never ink, like gutter grid lines
+	Dither    string        // Dithering algorithm for "luma" (see DitherNames)
+	Gamma     float64       // Gamma adjustment for "luma" (0 or 1 for none)
+	Contrast  float64       // Contrast adjustment for "luma" (0 or 1 for none)
+}
+
+// Default threshold for "luma" and "alpha" ink modes
+const DefaultInkThreshold = 128
+
+// Parse a list of colors to ignore, like "ff00ff, 00ffff", with 6 hex digits of
+// RGB for each color
+func ParseColorKeys(s string) ([]color.NRGBA, error) {
+	var keys []color.NRGBA
+	for _, field := range strings.FieldsFunc(s, func(r rune) bool { return r == ',' || r == ' ' }) {
+		rgb, err := strconv.ParseUint(field, 16, 32)
+		if err != nil || len(field) != 6 {
+			return nil, fmt.Errorf("color key %q is not 6 hex digits of RGB (like \"ff00ff\")", field)
+		}
+		keys = append(keys, color.NRGBA{uint8(rgb >> 16), uint8(rgb >> 8), uint8(rgb), 0xff})
+	}
+	return keys, nil
+}
+
+// Return true if a pixel is ink
+func (ink InkSpec) isInk(c color.Color) bool {
+	if ink.isColorKey(c) {
+		return false
+	}
+	set := false
+	switch ink.Mode {
+	case "luma":
+		set = ink.adjustLevel(luma(c)) < float64(ink.Threshold)
+	case "alpha":
+		_, _, _, a := c.RGBA()
+		set = int(a>>8) >= ink.Threshold
+	default:
+		// Alpha-premultiplied red is also 0 for transparent pixels
+		r, _, _, _ := c.RGBA()
+		set = r == 0
+	}
+	return set != ink.Invert
+}
+
+// Return the luma (0 to 255) of a pixel composited over a white background, so
+// transparent pixels count as background
+func luma(c color.Color) int {
+	r, g, b, a := c.RGBA()
+	bg := 0xffff - a
+	y := 299*(r+bg) + 587*(g+bg) + 114*(b+bg)
+	return int((y/1000 + 128) / 257)
+}
+
+// Convert the pixels of a rectangle of an image from RGBA to a 1-bit matrix,
+// with ink pixels set and the rest clear. With bits = 2, the matrix has coverage
+// values from 0 (clear) to 3 (full ink) instead, where "red" ink is always 0 or
+// 3, and "luma" and "alpha" ink get levels in between.
+func convertImageToMatrix(img image.Image, rect image.Rectangle, ink InkSpec, bits int) Matrix {
+	if (bits == 2 && ink.Mode != "" && ink.Mode != "red") ||
+		(ink.Mode == "luma" && ink.Dither != "" && ink.Dither != "threshold") {
+		return ditherImageToMatrix(img, rect, ink, bits)
+	}
+	set := 1
+	if bits == 2 {
+		set = 3
+	}
+	pxMatrix := Matrix{}
+	for y := rect.Min.Y; y < rect.Max.Y; y++ {
+		var row MatrixRow
+		for x := rect.Min.X; x < rect.Max.X; x++ {
+			if ink.isInk(img.At(x, y)) {
+				row = append(row, set)
+			} else {
+				row = append(row, 0)
+			}
+		}
+		pxMatrix = append(pxMatrix, row)
+	}
+	return pxMatrix
+}
@@ -0,0 +1,99 @@
+// Copyright (c) 2020 Sam Blenny
+// SPDX-License-Identifier: Apache-2.0 OR MIT
+//
+package font
+
+import (
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"testing"
+)
+
+// Return true if a pixel is ink by the rule that came before InkSpec
+func isInkOriginal(c color.Color) bool {
+	r, _, _, _ := c.RGBA()
+	return r == 0
+}
+
+func TestDefaultInk(t *testing.T) {
+	// The zero InkSpec must keep the output of the original rule for every
+	// pixel, including transparent ones and pixels of other color models
+	var colors []color.Color
+	for _, v := range []uint8{0, 1, 127, 128, 254, 255} {
+		for _, a := range []uint8{0, 1, 128, 255} {
+			colors = append(colors,
+				color.NRGBA{v, 0, 0, a}, color.NRGBA{0, v, v, a}, color.NRGBA{v, v, v, a},
+				color.RGBA{v / 2, v, 0, v / 2}, color.Gray{v}, color.Alpha{a})
+		}
+	}
+	ink := InkSpec{}
+	for _, c := range colors {
+		if ink.isInk(c) != isInkOriginal(c) {
+			t.Errorf("%#v: isInk is %v, but the original rule gives %v", c, ink.isInk(c), isInkOriginal(c))
+		}
+	}
+	// Same for every pixel of the sprite sheets
+	for _, name := range []string{"bold.png", "regular.png", "emoji_13_0_32x32_o3x3.png"} {
+		f, err := os.Open("../img/" + name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		img, err := png.Decode(f)
+		f.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		b := img.Bounds()
+		m := convertImageToMatrix(img, b, ink, 1)
+		for y := b.Min.Y; y < b.Max.Y; y++ {
+			for x := b.Min.X; x < b.Max.X; x++ {
+				if (m[y-b.Min.Y][x-b.Min.X] == 1) != isInkOriginal(img.At(x, y)) {
+					t.Fatalf("%s: pixel (%d, %d) does not match the original rule", name, x, y)
+				}
+			}
+		}
+	}
+}
+
+func TestInkModes(t *testing.T) {
+	gray := color.NRGBA{100, 100, 100, 255}
+	faint := color.NRGBA{0, 0, 0, 100}
+	guide := color.NRGBA{0, 0xc0, 0xff, 255}
+	for _, c := range []struct {
+		name string
+		ink  InkSpec
+		c    color.Color
+		want bool
+	}{
+		{"dark gray luma", InkSpec{Mode: "luma", Threshold: 128}, gray, true},
+		{"dark gray luma below threshold", InkSpec{Mode: "luma", Threshold: 90}, gray, false},
+		{"faint black luma", InkSpec{Mode: "luma", Threshold: 128}, faint, false},
+		{"faint black alpha", InkSpec{Mode: "alpha", Threshold: 100}, faint, true},
+		{"transparent alpha", InkSpec{Mode: "alpha", Threshold: 1}, color.NRGBA{}, false},
+		{"inverted luma", InkSpec{Mode: "luma", Threshold: 128, Invert: true}, gray, false},
+		{"inverted red", InkSpec{Invert: true}, color.White, true},
+		{"guide mark", InkSpec{}, guide, true},
+		{"color key guide mark", InkSpec{ColorKeys: []color.NRGBA{guide}}, guide, false},
+		{"inverted color key", InkSpec{Invert: true, ColorKeys: []color.NRGBA{guide}}, guide, false},
+	} {
+		if got := c.ink.isInk(c.c); got != c.want {
+			t.Errorf("%s: isInk is %v, expected %v", c.name, got, c.want)
+		}
+	}
+	keys, err := ParseColorKeys("ff0000, 00c0ff")
+	if err != nil || len(keys) != 2 || keys[1] != guide {
+		t.Errorf("ParseColorKeys = %v, %v", keys, err)
+	}
+	if _, err := ParseColorKeys("f00"); err == nil {
+		t.Errorf("ParseColorKeys accepted a color with 3 hex digits")
+	}
+	// Red ink makes 2-bit matrices of full coverage
+	img := image.NewNRGBA(image.Rect(0, 0, 2, 1))
+	img.Set(1, 0, color.Black)
+	img.Set(0, 0, color.White)
+	if m := convertImageToMatrix(img, img.Bounds(), InkSpec{}, 2); m[0][0] != 0 || m[0][1] != 3 {
+		t.Errorf("2-bit red ink matrix is %v, expected [[0 3]]", m)
+	}
+}
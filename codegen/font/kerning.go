@@ -0,0 +1,195 @@
+// Copyright (c) 2020 Sam Blenny
+// SPDX-License-Identifier: Apache-2.0 OR MIT
+//
+package font
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Holds a kerning adjustment for a pair of grapheme clusters. Adjust is how
+// many px to add to the advance of the left glyph when the right glyph follows
+// it (negative moves the glyphs closer together).
+type KernPair struct {
+	LeftHex  string
+	RightHex string
+	Adjust   int
+	Line     int // Line number in the kerning file, or 0 for derived pairs
+}
+
+// Most px that a kerning adjustment can move a pair either way
+const maxKern = 127
+
+// Read the kerning file fs.KernFile. Lines look like "41 56 -2", with the hex
+// grapheme clusters of the left and right glyphs, then the adjustment in px.
+// Comments starting with "#" are possible. Lines with problems are left out,
+// and the returned error lists them.
+func ReadKerningFile(fs FontSpec) ([]KernPair, error) {
+	text, err := ioutil.ReadFile(fs.KernFile)
+	if err != nil {
+		return nil, &FileError{Context{fs.Name, fs.KernFile, 0}, err}
+	}
+	var errs ErrorList
+	report := func(line int, format string, a ...interface{}) {
+		errs.Add(&SourceError{Context{fs.Name, fs.KernFile, line}, fmt.Sprintf(format, a...)})
+	}
+	var pairs []KernPair
+	seen := map[[2]string]int{}
+	for i, line := range strings.Split(string(text), "\n") {
+		lineNum := i + 1
+		fields := strings.Fields(strings.SplitN(line, "#", 2)[0])
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) != 3 {
+			report(lineNum, "expected `lefthex righthex adjust`, found %q", strings.TrimSpace(line))
+			continue
+		}
+		var clusters [2]string
+		valid := true
+		for n, hexGC := range fields[:2] {
+			if clusters[n], err = StringFromHexGC(hexGC); err != nil {
+				report(lineNum, "bad hex grapheme cluster %q", hexGC)
+				valid = false
+			}
+		}
+		adjust, err := strconv.Atoi(fields[2])
+		if err != nil || adjust < -maxKern || adjust > maxKern {
+			report(lineNum, "adjustment %q is not a number of px from %d to %d", fields[2], -maxKern, maxKern)
+			valid = false
+		}
+		if !valid {
+			continue
+		}
+		if prev, dup := seen[clusters]; dup {
+			report(lineNum, "pair %s %s is the same as on line %d", fields[0], fields[1], prev)
+			continue
+		}
+		seen[clusters] = lineNum
+		pairs = append(pairs, KernPair{fields[0], fields[1], adjust, lineNum})
+	}
+	return pairs, errs.Err()
+}
+
+// Holds the ink of each row of a glyph, measured from the pen position, for
+// finding the space between the edges of two glyphs
+type kernProfile struct {
+	hexGC   string
+	advance int
+	ink     []bool // Does each row of the line have set px?
+	left    []int  // Leftmost set px of each row of the line
+	right   []int  // Rightmost set px of each row of the line
+	minX    int    // Leftmost set px of the glyph
+	maxX    int    // Rightmost set px of the glyph
+	alnum   bool   // Is the glyph a letter or digit, rather than punctuation?
+}
+
+// Derive kerning pairs from the edge profiles of glyphs. Only glyphs for
+// single-codepoint letters, digits, and punctuation get kerned, and at least
+// one glyph of each pair must be a letter or digit, so pairs of symbols like
+// "%%" keep their spacing.
+//
+// For each pair, the gap between the glyphs is the least space between the
+// right edge of the left glyph and the left edge of the right glyph, in rows
+// where the left glyph has ink and the right glyph has ink in the same row or
+// a neighboring one. Pairs with straight facing sides, like "nn", have the same
+// gap as their bounding boxes. Pairs with open shapes, like "AV", "To", and
+// "r.", have more space than that, and get moved closer by half of the extra
+// space. Extra space of less than 1/8 of the line height usually comes from
+// rounded corners, so it doesn't count. Pairs with no rows in common, like
+// "'.", don't get kerned.
+func AutoKerning(fs FontSpec, pl []BlitPattern) []KernPair {
+	minExtra := (fs.Size + 7) / 8
+	var profiles []kernProfile
+	for _, p := range pl {
+		cluster := p.CS.GraphemeCluster()
+		r, n := utf8.DecodeRuneInString(cluster)
+		if n != len(cluster) || !(unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsPunct(r)) {
+			continue
+		}
+		if prof, ok := newKernProfile(fs, p); ok {
+			prof.alnum = unicode.IsLetter(r) || unicode.IsDigit(r)
+			profiles = append(profiles, prof)
+		}
+	}
+	var pairs []KernPair
+	for _, l := range profiles {
+		for _, r := range profiles {
+			if !l.alnum && !r.alnum {
+				continue
+			}
+			if adjust := kernAdjustment(l, r, minExtra); adjust != 0 {
+				pairs = append(pairs, KernPair{l.hexGC, r.hexGC, adjust, 0})
+			}
+		}
+	}
+	return pairs
+}
+
+// Make the edge profile of a glyph. Glyphs with no set pixels have no profile.
+func newKernProfile(fs FontSpec, p BlitPattern) (kernProfile, bool) {
+	pxMatrix, yOffset := ConvertPatternToMatrix(p.Bytes)
+	lines := fs.Size
+	if int(yOffset)+len(pxMatrix) > lines {
+		lines = int(yOffset) + len(pxMatrix)
+	}
+	prof := kernProfile{p.CS.HexCluster, p.Metrics.Advance, make([]bool, lines), make([]int, lines), make([]int, lines), 0, 0, false}
+	found := false
+	for y, row := range pxMatrix {
+		lineY := int(yOffset) + y
+		for x, px := range row {
+			if px == 0 {
+				continue
+			}
+			penX := p.Metrics.Bearing + x
+			if !prof.ink[lineY] {
+				prof.ink[lineY] = true
+				prof.left[lineY], prof.right[lineY] = penX, penX
+			}
+			prof.right[lineY] = penX
+			if !found {
+				found = true
+				prof.minX, prof.maxX = penX, penX
+			}
+			if penX < prof.minX {
+				prof.minX = penX
+			}
+			if penX > prof.maxX {
+				prof.maxX = penX
+			}
+		}
+	}
+	return prof, found
+}
+
+// Return the kerning adjustment for a glyph pair, or 0 for none
+func kernAdjustment(l kernProfile, r kernProfile, minExtra int) int {
+	boxGap := l.advance + r.minX - l.maxX - 1
+	gap, found := 0, false
+	for y, right := range l.right {
+		if !l.ink[y] {
+			continue
+		}
+		for ry := y - 1; ry <= y+1; ry++ {
+			if ry < 0 || ry >= len(r.left) || !r.ink[ry] {
+				continue
+			}
+			if g := l.advance + r.left[ry] - right - 1; !found || g < gap {
+				gap, found = g, true
+			}
+		}
+	}
+	if !found || gap-boxGap < minExtra {
+		return 0
+	}
+	adjust := -(gap - boxGap) / 2
+	if adjust < -maxKern {
+		adjust = -maxKern
+	}
+	return adjust
+}
@@ -0,0 +1,77 @@
+// Copyright (c) 2020 Sam Blenny
+// SPDX-License-Identifier: Apache-2.0 OR MIT
+//
+package font
+
+import (
+	"reflect"
+	"testing"
+)
+
+// A kerning file with two pairs
+const testKerning = `# Test pairs
+41 56 -2
+54 6f -1 # To
+`
+
+func TestReadKerningFile(t *testing.T) {
+	fs := FontSpec{Name: "Test", KernFile: writeTestFile(t, "kerning.txt", testKerning)}
+	pairs, err := ReadKerningFile(fs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []KernPair{{"41", "56", -2, 2}, {"54", "6f", -1, 3}}
+	if !reflect.DeepEqual(pairs, want) {
+		t.Errorf("got pairs %v, expected %v", pairs, want)
+	}
+}
+
+func TestKerningFileErrors(t *testing.T) {
+	testSourceErrors(t, "kerning.txt", testKerning, []sourceErrorCase{
+		{"missing adjustment", "41 56 -2", "41 56", 2, "expected `lefthex righthex adjust`"},
+		{"bad cluster", "41 56", "41 ZZ", 2, "bad hex grapheme cluster \"ZZ\""},
+		{"bad adjustment", "-2", "-2px", 2, "is not a number of px"},
+		{"adjustment too big", "-1 #", "-128 #", 3, "is not a number of px from -127 to 127"},
+		{"duplicate pair", "54 6f", "41 56", 3, "same as on line 2"},
+	}, func(path string) error {
+		_, err := ReadKerningFile(FontSpec{Name: "Test", KernFile: path})
+		return err
+	})
+}
+
+func TestAutoKerning(t *testing.T) {
+	fs := FontSpec{Name: "Test", Size: 16, Ascent: 12}
+	tee := []string{"#####", "..#..", "..#..", "..#..", "..#..", "..#..", "..#.."}
+	oh := []string{".##.", "#..#", "#..#", ".##."}
+	en := []string{"###.", "#..#", "#..#", "#..#"}
+	pl := []BlitPattern{
+		testPattern("54", tee, 4, 7, 1),
+		testPattern("6f", oh, 7, 6, 1),
+		testPattern("6e", en, 7, 6, 1),
+		// Punctuation with the same shapes as "T" and "o"
+		testPattern("2f", tee, 4, 7, 1),
+		testPattern("2e", oh, 7, 6, 1),
+		// Blank glyphs and symbols don't get kerned
+		testPattern("20", []string{"....", "...."}, 12, 7, 1),
+		testPattern("2b", tee, 4, 7, 1),
+	}
+	got := map[[2]string]int{}
+	for _, p := range AutoKerning(fs, pl) {
+		got[[2]string{p.LeftHex, p.RightHex}] = p.Adjust
+		if p.Line != 0 {
+			t.Errorf("derived pair %s %s has line %d", p.LeftHex, p.RightHex, p.Line)
+		}
+	}
+	// The open sides of "T" get moved closer to "o" and "n" by half of the
+	// extra space. Rounded corners of "o" don't count, pairs with straight
+	// sides like "nn" keep their spacing, and so do pairs of punctuation like
+	// "/." with the same shapes as "To".
+	want := map[[2]string]int{
+		{"54", "6f"}: -1, {"6f", "54"}: -1, {"54", "6e"}: -1, {"6e", "54"}: -1,
+		{"54", "2e"}: -1, {"2e", "54"}: -1,
+		{"2f", "6f"}: -1, {"6f", "2f"}: -1, {"2f", "6e"}: -1, {"6e", "2f"}: -1,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got pairs %v, expected %v", got, want)
+	}
+}
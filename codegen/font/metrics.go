@@ -0,0 +1,112 @@
+// Copyright (c) 2020 Sam Blenny
+// SPDX-License-Identifier: Apache-2.0 OR MIT
+//
+package font
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+)
+
+// Holds a line of a metrics file, which overrides the computed metrics of one
+// glyph. Fields given as "-" keep their computed value.
+type MetricsOverride struct {
+	HexCluster string
+	Cluster    string // Parsed UTF-8 form (not hex codepoints)
+	Line       int
+	Advance    int
+	Bearing    int
+	SetAdvance bool
+	SetBearing bool
+}
+
+// Read the metrics file fs.Metrics. Lines look like "41 12 1", with a hex
+// grapheme cluster, an advance in px, and a left bearing in px. Either number
+// may be "-" to keep the computed value. Comments starting with "#" are
+// possible. Lines with problems are left out, and the returned error lists them.
+func ReadMetricsFile(fs FontSpec) ([]MetricsOverride, error) {
+	text, err := ioutil.ReadFile(fs.Metrics)
+	if err != nil {
+		return nil, &FileError{Context{fs.Name, fs.Metrics, 0}, err}
+	}
+	var errs ErrorList
+	report := func(line int, format string, a ...interface{}) {
+		errs.Add(&SourceError{Context{fs.Name, fs.Metrics, line}, fmt.Sprintf(format, a...)})
+	}
+	var overrides []MetricsOverride
+	seen := map[string]int{}
+	for i, line := range strings.Split(string(text), "\n") {
+		lineNum := i + 1
+		fields := strings.Fields(strings.SplitN(line, "#", 2)[0])
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) != 3 {
+			report(lineNum, "expected `hexcluster advance bearing`, found %q", strings.TrimSpace(line))
+			continue
+		}
+		cluster, err := StringFromHexGC(fields[0])
+		if err != nil {
+			report(lineNum, "bad hex grapheme cluster %q", fields[0])
+			continue
+		}
+		if prev, dup := seen[cluster]; dup {
+			report(lineNum, "grapheme cluster %q is the same as on line %d", fields[0], prev)
+			continue
+		}
+		seen[cluster] = lineNum
+		o := MetricsOverride{HexCluster: fields[0], Cluster: cluster, Line: lineNum}
+		valid := true
+		for n, field := range fields[1:] {
+			if field == "-" {
+				continue
+			}
+			v, err := strconv.Atoi(field)
+			if err != nil {
+				report(lineNum, "%q is not a number of px or \"-\"", field)
+				valid = false
+				continue
+			}
+			if n == 0 {
+				o.Advance, o.SetAdvance = v, true
+			} else {
+				o.Bearing, o.SetBearing = v, true
+			}
+		}
+		if err := (GlyphMetrics{o.Advance, o.Bearing}).check(); valid && err != nil {
+			report(lineNum, "%v", err)
+			valid = false
+		}
+		if valid {
+			overrides = append(overrides, o)
+		}
+	}
+	return overrides, errs.Err()
+}
+
+// Apply metrics overrides to the glyphs of a pattern list. Each override must
+// match the grapheme cluster of a glyph, rather than an alias.
+func ApplyMetricsOverrides(fs FontSpec, pl []BlitPattern, overrides []MetricsOverride) error {
+	glyphs := map[string]int{}
+	for i, p := range pl {
+		glyphs[p.CS.GraphemeCluster()] = i
+	}
+	var errs ErrorList
+	for _, o := range overrides {
+		i, ok := glyphs[o.Cluster]
+		if !ok {
+			errs.Add(&SourceError{Context{fs.Name, fs.Metrics, o.Line},
+				fmt.Sprintf("font has no glyph for grapheme cluster %q", o.HexCluster)})
+			continue
+		}
+		if o.SetAdvance {
+			pl[i].Metrics.Advance = o.Advance
+		}
+		if o.SetBearing {
+			pl[i].Metrics.Bearing = o.Bearing
+		}
+	}
+	return errs.Err()
+}
@@ -0,0 +1,141 @@
+// Copyright (c) 2020 Sam Blenny
+// SPDX-License-Identifier: Apache-2.0 OR MIT
+//
+package font
+
+import (
+	"encoding/binary"
+	"fmt"
+	"unicode/utf8"
+)
+
+// PSF2 (PC Screen Font version 2) header fields
+const (
+	psf2Magic        = 0x864ab572
+	psf2HeaderSize   = 32
+	psf2HasUnicode   = 0x01
+	psf2Separator    = 0xFF // Ends the Unicode table entry for a glyph
+	psf2StartSeq     = 0xFE // Starts a multi-codepoint sequence in a Unicode table entry
+	psf2MaxGlyphs    = 512  // Most glyphs the Linux console can use
+	psf2SmallGlyphs  = 256  // Glyph count for fonts that fit in 256 slots
+	psf2MaxCellWidth = 32   // Widest cell the Linux framebuffer console can use
+)
+
+// Make a PSF2 font for the Linux console from a list of blit patterns.
+//
+// Each glyph gets placed in a fixed size cell that is fs.Size px tall and as
+// wide as the widest glyph. Glyphs are centered horizontally, and positioned
+// vertically by their yOffset, so they share the same baseline as in the GUI.
+// Glyphs for single codepoints below 256 go in the slot that matches their
+// codepoint, and the others fill the empty slots in order. The font is padded
+// with blank glyphs to 256 or 512 glyphs, since those are the sizes the
+// console supports.
+//
+// The Unicode table lists the grapheme cluster for each glyph, plus the
+// clusters from the alias list that map to the same glyph. Multi-codepoint
+// clusters (like NFD sequences for accented letters) are written as PSF2
+// sequences, so the console can map decomposed input to the right glyph.
+func PSF2FromPatterns(fs FontSpec, pl []BlitPattern, aliasList []GCAlias) ([]byte, error) {
+	if len(pl) > psf2MaxGlyphs {
+		return nil, &SourceError{Context: Context{Font: fs.Name},
+			Msg: fmt.Sprintf("font has %d glyphs, but PSF2 fonts for the Linux console can have at most %d", len(pl), psf2MaxGlyphs)}
+	}
+	cellW := 1
+	for _, p := range pl {
+		if w := int((p.Bytes[0] >> 16) & 0xff); w > cellW {
+			cellW = w
+		}
+	}
+	if cellW > psf2MaxCellWidth {
+		return nil, &SourceError{Context: Context{Font: fs.Name},
+			Msg: fmt.Sprintf("widest glyph is %d px, but PSF2 fonts for the Linux console can be at most %d px wide", cellW, psf2MaxCellWidth)}
+	}
+	glyphCount := psf2SmallGlyphs
+	if len(pl) > psf2SmallGlyphs {
+		glyphCount = psf2MaxGlyphs
+	}
+	// Assign glyph slots
+	slots := make([]*BlitPattern, glyphCount)
+	var others []*BlitPattern
+	for i := range pl {
+		cluster := pl[i].CS.GraphemeCluster()
+		if c := pl[i].CS.FirstCodepoint(); c < psf2SmallGlyphs && utf8.RuneCountInString(cluster) == 1 && slots[c] == nil {
+			slots[c] = &pl[i]
+		} else {
+			others = append(others, &pl[i])
+		}
+	}
+	next := 0
+	for _, p := range others {
+		for slots[next] != nil {
+			next++
+		}
+		slots[next] = p
+	}
+	// Find the aliases for each canonical grapheme cluster
+	aliases := map[string][]string{}
+	for _, gcAlias := range aliasList {
+		canon, err := StringFromHexGC(gcAlias.CanonHex)
+		if err != nil {
+			return nil, WithContext(err, Context{Font: fs.Name})
+		}
+		alias, err := StringFromHexGC(gcAlias.AliasHex)
+		if err != nil {
+			return nil, WithContext(err, Context{Font: fs.Name})
+		}
+		aliases[canon] = append(aliases[canon], alias)
+	}
+	// Header
+	rowBytes := (cellW + 7) / 8
+	charSize := fs.Size * rowBytes
+	buf := make([]byte, psf2HeaderSize, psf2HeaderSize+glyphCount*charSize)
+	le := binary.LittleEndian
+	le.PutUint32(buf[0:], psf2Magic)
+	le.PutUint32(buf[4:], 0) // Version
+	le.PutUint32(buf[8:], psf2HeaderSize)
+	le.PutUint32(buf[12:], psf2HasUnicode)
+	le.PutUint32(buf[16:], uint32(glyphCount))
+	le.PutUint32(buf[20:], uint32(charSize))
+	le.PutUint32(buf[24:], uint32(fs.Size))
+	le.PutUint32(buf[28:], uint32(cellW))
+	// Glyph bitmaps: rows top to bottom, with the leftmost pixel in the MSB
+	for _, p := range slots {
+		cell := make([]byte, charSize)
+		if p != nil {
+			pxMatrix, yOffset := ConvertPatternToMatrix(p.Bytes)
+			for y, row := range pxMatrix {
+				cellY := int(yOffset) + y
+				if cellY >= fs.Size {
+					return nil, &SourceError{Context: Context{Font: fs.Name},
+						Msg: fmt.Sprintf("glyph %s extends below the bottom of the %d px line", p.CS.HexCluster, fs.Size)}
+				}
+				left := (cellW - len(row)) / 2
+				for x, px := range row {
+					if px == 1 {
+						cell[cellY*rowBytes+(left+x)/8] |= 0x80 >> uint((left+x)%8)
+					}
+				}
+			}
+		}
+		buf = append(buf, cell...)
+	}
+	// Unicode table. Each entry lists the single codepoints for a glyph,
+	// then the multi-codepoint sequences, then a separator.
+	for _, p := range slots {
+		if p != nil {
+			cluster := p.CS.GraphemeCluster()
+			var seqs []byte
+			for _, gc := range append([]string{cluster}, aliases[cluster]...) {
+				if utf8.RuneCountInString(gc) == 1 {
+					buf = append(buf, gc...)
+				} else {
+					seqs = append(seqs, psf2StartSeq)
+					seqs = append(seqs, gc...)
+				}
+			}
+			buf = append(buf, seqs...)
+		}
+		buf = append(buf, psf2Separator)
+	}
+	return buf, nil
+}
@@ -0,0 +1,557 @@
+// Copyright (c) 2020 Sam Blenny
+// SPDX-License-Identifier: Apache-2.0 OR MIT
+//
+package font
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// SFNT (OpenType) constants
+const (
+	sfntVersion       = 0x00010000
+	sfntCheckSumMagic = 0xB1B0AFBA // head.checkSumAdjustment makes the font sum to this
+	sfntHeadMagic     = 0x5F0F3CF5
+	sfntUnitsPerPx    = 64    // Font units for each pixel of the bitmap strike
+	sfntMaxUnitsPerEm = 16384 // Largest unitsPerEm that the head table allows
+)
+
+// Holds one glyph of an SFNT font, along with its metrics in px
+type sfntGlyph struct {
+	pxMatrix Matrix
+	width    int
+	height   int
+	bearingX int // From the pen position right to the left of the glyph
+	bearingY int // From the baseline up to the top of the glyph
+	advance  int
+}
+
+// Holds a run of consecutive codepoints that map to consecutive glyph IDs
+type sfntCmapRun struct {
+	first   rune
+	last    rune
+	glyphID int
+}
+
+// Make a bitmap-only OpenType font (no outlines) from a list of blit patterns.
+//
+// The font has one 1-bit EBDT/EBLC bitmap strike with ppem equal to fs.Size, so
+// it renders exactly like the device at that size, with the baseline fs.Ascent
+// px below the top of the line. Font units are 64 per px, so the metrics are
+// exact at the native size too. The cmap maps each single-codepoint cluster,
+// plus the aliases that map to one, to its glyph. Glyphs for multi-codepoint
+// clusters can't be reached without layout tables, so they are left out, and
+// their hex clusters get returned.
+func SFNTFromPatterns(fs FontSpec, pl []BlitPattern, aliasList []GCAlias) ([]byte, []string, error) {
+	unitsPerEm := fs.Size * sfntUnitsPerPx
+	if fs.Size < 1 || fs.Size > 127 || unitsPerEm > sfntMaxUnitsPerEm {
+		return nil, nil, &SourceError{Context: Context{Font: fs.Name},
+			Msg: fmt.Sprintf("size is %d px, but OpenType bitmap strikes can be at most 127 px", fs.Size)}
+	}
+	// Find the aliases for each canonical grapheme cluster
+	aliases := map[string][]string{}
+	for _, gcAlias := range aliasList {
+		canon, err := StringFromHexGC(gcAlias.CanonHex)
+		if err != nil {
+			return nil, nil, WithContext(err, Context{Font: fs.Name})
+		}
+		alias, err := StringFromHexGC(gcAlias.AliasHex)
+		if err != nil {
+			return nil, nil, WithContext(err, Context{Font: fs.Name})
+		}
+		aliases[canon] = append(aliases[canon], alias)
+	}
+	// Assign glyph IDs. Glyph 0 is .notdef, which has no bitmap.
+	glyphs := []sfntGlyph{{}}
+	glyphIDs := map[rune]int{}
+	var left []string
+	for _, p := range pl {
+		cluster := p.CS.GraphemeCluster()
+		var codepoints []rune
+		for _, gc := range append([]string{cluster}, aliases[cluster]...) {
+			if c, n := utf8.DecodeRuneInString(gc); n == len(gc) && n > 0 {
+				if _, dup := glyphIDs[c]; !dup {
+					codepoints = append(codepoints, c)
+				}
+			}
+		}
+		if len(codepoints) == 0 {
+			left = append(left, p.CS.HexCluster)
+			continue
+		}
+		for _, c := range codepoints {
+			glyphIDs[c] = len(glyphs)
+		}
+		pxMatrix, yOffset := ConvertPatternToMatrix(p.Bytes)
+		g := sfntGlyph{pxMatrix, int((p.Bytes[0] >> 16) & 0xff), len(pxMatrix),
+			p.Metrics.Bearing, fs.Ascent - int(yOffset), p.Metrics.Advance}
+		if g.width > 0xff || g.bearingY > 127 || g.bearingY-g.height < -128 || g.bearingX < -128 || g.bearingX > 127 {
+			return nil, nil, &SourceError{Context: Context{Font: fs.Name},
+				Msg: fmt.Sprintf("metrics of glyph %s don't fit in an OpenType bitmap strike", p.CS.HexCluster)}
+		}
+		glyphs = append(glyphs, g)
+	}
+	if len(glyphs) == 1 {
+		return nil, nil, &SourceError{Context: Context{Font: fs.Name}, Msg: "font has no glyphs that a single codepoint maps to"}
+	}
+	if len(glyphs) > 0xffff {
+		return nil, nil, &SourceError{Context: Context{Font: fs.Name},
+			Msg: fmt.Sprintf("font has %d glyphs, but OpenType fonts can have at most 65535", len(glyphs))}
+	}
+	// Find the extents of the glyphs, in px
+	maxW, maxBeforeBL, minAfterBL := 0, 0, 0
+	maxAdvance, minX, maxX, minRSB := 0, 0, 0, 0
+	for i, g := range glyphs[1:] {
+		if g.width > maxW {
+			maxW = g.width
+		}
+		if g.advance > maxAdvance {
+			maxAdvance = g.advance
+		}
+		if g.width > 0 && g.bearingX < minX {
+			minX = g.bearingX
+		}
+		if g.bearingX+g.width > maxX {
+			maxX = g.bearingX + g.width
+		}
+		if rsb := g.advance - g.bearingX - g.width; i == 0 || rsb < minRSB {
+			minRSB = rsb
+		}
+		if g.height > 0 && g.bearingY > maxBeforeBL {
+			maxBeforeBL = g.bearingY
+		}
+		if g.height > 0 && g.bearingY-g.height < minAfterBL {
+			minAfterBL = g.bearingY - g.height
+		}
+	}
+	m := sfntMetrics{fs, unitsPerEm, len(glyphs), maxW, maxAdvance, minX, maxX, minRSB, maxBeforeBL, minAfterBL}
+	ebdt, eblc := sfntBitmapTables(m, glyphs)
+	tables := map[string][]byte{
+		"EBDT": ebdt,
+		"EBLC": eblc,
+		"OS/2": sfntOS2Table(m, glyphs, glyphIDs),
+		"cmap": sfntCmapTable(glyphIDs),
+		"glyf": {},
+		"head": sfntHeadTable(m),
+		"hhea": sfntHheaTable(m),
+		"hmtx": sfntHmtxTable(m, glyphs),
+		"loca": sfntLocaTable(m),
+		"maxp": sfntMaxpTable(m),
+		"name": sfntNameTable(fs),
+		"post": sfntPostTable(m),
+	}
+	return sfntAssemble(tables), left, nil
+}
+
+// Holds the font-wide metrics that several tables need
+type sfntMetrics struct {
+	fs          FontSpec
+	unitsPerEm  int
+	numGlyphs   int
+	maxW        int // Widest glyph, in px
+	maxAdvance  int // Largest advance, in px
+	minX        int // Leftmost px that a glyph reaches, from the pen position
+	maxX        int // Rightmost px that a glyph reaches, from the pen position
+	minRSB      int // Smallest right side bearing (advance - bearingX - width)
+	maxBeforeBL int // Most px that a glyph reaches above the baseline
+	minAfterBL  int // Most px that a glyph reaches below the baseline (negative)
+}
+
+// Descent of the line below the baseline, in px (negative)
+func (m sfntMetrics) descent() int {
+	return m.fs.Ascent - m.fs.Size
+}
+
+// Make the EBDT and EBLC tables for a single strike with every glyph except
+// .notdef. Glyph data uses image format 1 (small metrics, byte-aligned rows),
+// and EBLC uses one index subtable of format 1 (4-byte offsets).
+func sfntBitmapTables(m sfntMetrics, glyphs []sfntGlyph) ([]byte, []byte) {
+	be := binary.BigEndian
+	ebdt := []byte{0, 2, 0, 0}
+	var offsets []uint32
+	for _, g := range glyphs[1:] {
+		offsets = append(offsets, uint32(len(ebdt)-4))
+		ebdt = append(ebdt, byte(g.height), byte(g.width), byte(int8(g.bearingX)), byte(int8(g.bearingY)), byte(g.advance))
+		rowBytes := (g.width + 7) / 8
+		for _, row := range g.pxMatrix {
+			packed := make([]byte, rowBytes)
+			for x, px := range row {
+				if px == 1 {
+					packed[x/8] |= 0x80 >> uint(x%8)
+				}
+			}
+			ebdt = append(ebdt, packed...)
+		}
+	}
+	offsets = append(offsets, uint32(len(ebdt)-4))
+	// Index subtable
+	firstGlyph, lastGlyph := 1, m.numGlyphs-1
+	subtable := make([]byte, 8, 8+4*len(offsets))
+	be.PutUint16(subtable[0:], 1) // indexFormat
+	be.PutUint16(subtable[2:], 1) // imageFormat
+	be.PutUint32(subtable[4:], 4) // imageDataOffset
+	for _, o := range offsets {
+		subtable = appendUint32(subtable, o)
+	}
+	subtableArray := make([]byte, 8)
+	be.PutUint16(subtableArray[0:], uint16(firstGlyph))
+	be.PutUint16(subtableArray[2:], uint16(lastGlyph))
+	be.PutUint32(subtableArray[4:], uint32(len(subtableArray)))
+	// Header and bitmap size record
+	const headerSize = 8
+	const bitmapSizeRecord = 48
+	eblc := make([]byte, headerSize+bitmapSizeRecord)
+	be.PutUint16(eblc[0:], 2) // majorVersion
+	be.PutUint16(eblc[2:], 0) // minorVersion
+	be.PutUint32(eblc[4:], 1) // numSizes
+	rec := eblc[headerSize:]
+	be.PutUint32(rec[0:], headerSize+bitmapSizeRecord)
+	be.PutUint32(rec[4:], uint32(len(subtableArray)+len(subtable)))
+	be.PutUint32(rec[8:], 1) // numberOfIndexSubTables
+	be.PutUint32(rec[12:], 0)
+	lineMetrics := []byte{
+		byte(int8(m.fs.Ascent)),   // ascender
+		byte(int8(m.descent())),   // descender
+		byte(m.maxW),              // widthMax
+		1,                         // caretSlopeNumerator
+		0,                         // caretSlopeDenominator
+		0,                         // caretOffset
+		byte(int8(m.minX)),        // minOriginSB
+		byte(int8(m.minRSB)),      // minAdvanceSB
+		byte(int8(m.maxBeforeBL)), // maxBeforeBL
+		byte(int8(m.minAfterBL)),  // minAfterBL
+		0, 0,                      // padding
+	}
+	copy(rec[16:], lineMetrics) // hori
+	copy(rec[28:], lineMetrics) // vert
+	be.PutUint16(rec[40:], uint16(firstGlyph))
+	be.PutUint16(rec[42:], uint16(lastGlyph))
+	rec[44] = byte(m.fs.Size) // ppemX
+	rec[45] = byte(m.fs.Size) // ppemY
+	rec[46] = 1               // bitDepth
+	rec[47] = 1               // flags: horizontal metrics
+	eblc = append(eblc, subtableArray...)
+	eblc = append(eblc, subtable...)
+	return ebdt, eblc
+}
+
+// Make the cmap table, with a format 4 subtable for the Basic Multilingual
+// Plane and a format 12 subtable for all codepoints
+func sfntCmapTable(glyphIDs map[rune]int) []byte {
+	var codepoints []rune
+	for c := range glyphIDs {
+		codepoints = append(codepoints, c)
+	}
+	sort.Slice(codepoints, func(i, j int) bool { return codepoints[i] < codepoints[j] })
+	var runs []sfntCmapRun
+	for _, c := range codepoints {
+		id := glyphIDs[c]
+		if n := len(runs); n > 0 && runs[n-1].last+1 == c && runs[n-1].glyphID+int(c-runs[n-1].first) == id {
+			runs[n-1].last = c
+		} else {
+			runs = append(runs, sfntCmapRun{c, c, id})
+		}
+	}
+	be := binary.BigEndian
+	// Format 4 uses idDelta for each segment, and must end with 0xFFFF
+	var bmp []sfntCmapRun
+	for _, r := range runs {
+		if r.first > 0xfffe {
+			break
+		}
+		if r.last > 0xfffe {
+			r.last = 0xfffe
+		}
+		bmp = append(bmp, r)
+	}
+	bmp = append(bmp, sfntCmapRun{0xffff, 0xffff, 0})
+	segCount := len(bmp)
+	searchRange, entrySelector := 2, 0
+	for searchRange*2 <= 2*segCount {
+		searchRange *= 2
+		entrySelector++
+	}
+	f4 := make([]byte, 14)
+	be.PutUint16(f4[0:], 4)
+	be.PutUint16(f4[6:], uint16(2*segCount))
+	be.PutUint16(f4[8:], uint16(searchRange))
+	be.PutUint16(f4[10:], uint16(entrySelector))
+	be.PutUint16(f4[12:], uint16(2*segCount-searchRange))
+	for _, r := range bmp {
+		f4 = appendUint16(f4, uint16(r.last))
+	}
+	f4 = appendUint16(f4, 0) // reservedPad
+	for _, r := range bmp {
+		f4 = appendUint16(f4, uint16(r.first))
+	}
+	for _, r := range bmp {
+		f4 = appendUint16(f4, uint16(r.glyphID-int(r.first)))
+	}
+	for range bmp {
+		f4 = appendUint16(f4, 0) // idRangeOffset
+	}
+	be.PutUint16(f4[2:], uint16(len(f4)))
+	// Format 12
+	f12 := make([]byte, 16)
+	be.PutUint16(f12[0:], 12)
+	be.PutUint32(f12[12:], uint32(len(runs)))
+	for _, r := range runs {
+		f12 = appendUint32(f12, uint32(r.first))
+		f12 = appendUint32(f12, uint32(r.last))
+		f12 = appendUint32(f12, uint32(r.glyphID))
+	}
+	be.PutUint32(f12[4:], uint32(len(f12)))
+	// Header with Windows Unicode BMP and full repertoire encoding records
+	const headerSize = 4 + 2*8
+	cmap := make([]byte, headerSize)
+	be.PutUint16(cmap[2:], 2) // numTables
+	be.PutUint16(cmap[4:], 3)
+	be.PutUint16(cmap[6:], 1)
+	be.PutUint32(cmap[8:], headerSize)
+	be.PutUint16(cmap[12:], 3)
+	be.PutUint16(cmap[14:], 10)
+	be.PutUint32(cmap[16:], uint32(headerSize+len(f4)))
+	cmap = append(cmap, f4...)
+	return append(cmap, f12...)
+}
+
+// Make the head table
+func sfntHeadTable(m sfntMetrics) []byte {
+	be := binary.BigEndian
+	head := make([]byte, 54)
+	be.PutUint16(head[0:], 1)          // majorVersion
+	be.PutUint32(head[4:], 0x00010000) // fontRevision 1.0
+	be.PutUint32(head[12:], sfntHeadMagic)
+	be.PutUint16(head[16:], 0x000B) // Baseline at y=0, lsb at x=0, integer ppem
+	be.PutUint16(head[18:], uint16(m.unitsPerEm))
+	// Created and modified dates stay at 0 so the output is reproducible
+	be.PutUint16(head[36:], uint16(int16(m.minX*sfntUnitsPerPx)))       // xMin
+	be.PutUint16(head[38:], uint16(int16(m.minAfterBL*sfntUnitsPerPx))) // yMin
+	be.PutUint16(head[40:], uint16(m.maxX*sfntUnitsPerPx))              // xMax
+	be.PutUint16(head[42:], uint16(m.maxBeforeBL*sfntUnitsPerPx))       // yMax
+	be.PutUint16(head[46:], uint16(m.fs.Size))                          // lowestRecPPEM
+	be.PutUint16(head[48:], 2)                                          // fontDirectionHint
+	be.PutUint16(head[50:], 0)                                          // indexToLocFormat: short loca offsets
+	return head
+}
+
+// Make the hhea table
+func sfntHheaTable(m sfntMetrics) []byte {
+	be := binary.BigEndian
+	hhea := make([]byte, 36)
+	be.PutUint32(hhea[0:], 0x00010000)
+	be.PutUint16(hhea[4:], uint16(m.fs.Ascent*sfntUnitsPerPx))
+	be.PutUint16(hhea[6:], uint16(int16(m.descent()*sfntUnitsPerPx)))
+	be.PutUint16(hhea[10:], uint16(m.maxAdvance*sfntUnitsPerPx))    // advanceWidthMax
+	be.PutUint16(hhea[12:], uint16(int16(m.minX*sfntUnitsPerPx)))   // minLeftSideBearing
+	be.PutUint16(hhea[14:], uint16(int16(m.minRSB*sfntUnitsPerPx))) // minRightSideBearing
+	be.PutUint16(hhea[16:], uint16(m.maxX*sfntUnitsPerPx))          // xMaxExtent
+	be.PutUint16(hhea[18:], 1)                                      // caretSlopeRise
+	be.PutUint16(hhea[34:], uint16(m.numGlyphs))                    // numberOfHMetrics
+	return hhea
+}
+
+// Make the hmtx table from the advance and left bearing of each glyph
+func sfntHmtxTable(m sfntMetrics, glyphs []sfntGlyph) []byte {
+	var hmtx []byte
+	hmtx = appendUint16(hmtx, uint16(m.fs.Size/2*sfntUnitsPerPx)) // .notdef
+	hmtx = appendUint16(hmtx, 0)
+	for _, g := range glyphs[1:] {
+		hmtx = appendUint16(hmtx, uint16(g.advance*sfntUnitsPerPx))
+		hmtx = appendUint16(hmtx, uint16(int16(g.bearingX*sfntUnitsPerPx)))
+	}
+	return hmtx
+}
+
+// Make a short format loca table where every glyph has an empty outline. Some
+// desktop font stacks won't load a TrueType flavored font without glyf and loca
+// tables, even when it only has bitmaps, so the font gets an empty glyf table
+// to go with this, the same as fonts from fonttosfnt.
+func sfntLocaTable(m sfntMetrics) []byte {
+	return make([]byte, 2*(m.numGlyphs+1))
+}
+
+// Make a version 1.0 maxp table, as TrueType flavored fonts need
+func sfntMaxpTable(m sfntMetrics) []byte {
+	be := binary.BigEndian
+	maxp := make([]byte, 32)
+	be.PutUint32(maxp[0:], 0x00010000)
+	be.PutUint16(maxp[4:], uint16(m.numGlyphs))
+	be.PutUint16(maxp[14:], 1) // maxZones
+	return maxp
+}
+
+// Make a version 4 OS/2 table
+func sfntOS2Table(m sfntMetrics, glyphs []sfntGlyph, glyphIDs map[rune]int) []byte {
+	be := binary.BigEndian
+	os2 := make([]byte, 96)
+	px := func(n int) uint16 { return uint16(int16(n * sfntUnitsPerPx)) }
+	totalW := 0
+	for _, g := range glyphs[1:] {
+		totalW += g.advance
+	}
+	first, last := rune(0xffff), rune(0)
+	for c := range glyphIDs {
+		if c < first {
+			first = c
+		}
+		if c > last {
+			last = c
+		}
+	}
+	if last > 0xffff {
+		last = 0xffff
+	}
+	winAscent, winDescent := m.fs.Ascent, -m.descent()
+	if m.maxBeforeBL > winAscent {
+		winAscent = m.maxBeforeBL
+	}
+	if -m.minAfterBL > winDescent {
+		winDescent = -m.minAfterBL
+	}
+	be.PutUint16(os2[0:], 4)                          // version
+	be.PutUint16(os2[2:], px(totalW/(len(glyphs)-1))) // xAvgCharWidth
+	be.PutUint16(os2[4:], 400)                        // usWeightClass
+	be.PutUint16(os2[6:], 5)                          // usWidthClass
+	be.PutUint16(os2[10:], px(m.fs.Size/2))           // ySubscriptXSize
+	be.PutUint16(os2[12:], px(m.fs.Size/2))           // ySubscriptYSize
+	be.PutUint16(os2[16:], px(-m.descent()))          // ySubscriptYOffset
+	be.PutUint16(os2[18:], px(m.fs.Size/2))           // ySuperscriptXSize
+	be.PutUint16(os2[20:], px(m.fs.Size/2))           // ySuperscriptYSize
+	be.PutUint16(os2[24:], px(m.fs.Ascent/2))         // ySuperscriptYOffset
+	be.PutUint16(os2[26:], px(1))                     // yStrikeoutSize
+	be.PutUint16(os2[28:], px(m.fs.Ascent/3))         // yStrikeoutPosition
+	copy(os2[58:], "NONE")                            // achVendID
+	be.PutUint16(os2[62:], 0x00C0)                    // fsSelection: REGULAR, USE_TYPO_METRICS
+	be.PutUint16(os2[64:], uint16(first))             // usFirstCharIndex
+	be.PutUint16(os2[66:], uint16(last))              // usLastCharIndex
+	be.PutUint16(os2[68:], px(m.fs.Ascent))           // sTypoAscender
+	be.PutUint16(os2[70:], px(m.descent()))           // sTypoDescender
+	be.PutUint16(os2[74:], px(winAscent))             // usWinAscent
+	be.PutUint16(os2[76:], px(winDescent))            // usWinDescent
+	be.PutUint16(os2[90:], 0)                         // usDefaultChar
+	be.PutUint16(os2[92:], 0x20)                      // usBreakChar
+	be.PutUint16(os2[94:], 1)                         // usMaxContext
+	return os2
+}
+
+// Make a format 0 name table with Windows Unicode names
+func sfntNameTable(fs FontSpec) []byte {
+	var psName []rune
+	for _, r := range "Guilib-" + fs.Name {
+		if r > ' ' && r < 0x7f && !strings.ContainsRune("[](){}<>/%", r) {
+			psName = append(psName, r)
+		}
+	}
+	names := []string{
+		0: strings.TrimSpace(fs.Legal),
+		1: "Guilib " + fs.Name,
+		2: "Regular",
+		3: "Guilib " + fs.Name + " " + fmt.Sprint(fs.Size) + "px",
+		4: "Guilib " + fs.Name,
+		5: "Version 1.0",
+		6: string(psName),
+	}
+	be := binary.BigEndian
+	headerSize := 6 + 12*len(names)
+	table := make([]byte, 6, headerSize)
+	be.PutUint16(table[2:], uint16(len(names)))
+	be.PutUint16(table[4:], uint16(headerSize))
+	var storage []byte
+	for id, s := range names {
+		var utf16be []byte
+		for _, u := range utf16.Encode([]rune(s)) {
+			utf16be = appendUint16(utf16be, u)
+		}
+		table = appendUint16(table, 3)     // platformID: Windows
+		table = appendUint16(table, 1)     // encodingID: Unicode BMP
+		table = appendUint16(table, 0x409) // languageID: English (US)
+		table = appendUint16(table, uint16(id))
+		table = appendUint16(table, uint16(len(utf16be)))
+		table = appendUint16(table, uint16(len(storage)))
+		storage = append(storage, utf16be...)
+	}
+	return append(table, storage...)
+}
+
+// Make a version 3.0 post table, which has no glyph names
+func sfntPostTable(m sfntMetrics) []byte {
+	be := binary.BigEndian
+	post := make([]byte, 32)
+	be.PutUint32(post[0:], 0x00030000)
+	be.PutUint16(post[8:], uint16(int16(m.descent()*sfntUnitsPerPx/2))) // underlinePosition
+	be.PutUint16(post[10:], uint16(sfntUnitsPerPx))                     // underlineThickness
+	return post
+}
+
+// Return the checksum of a table, which is the sum of its big-endian uint32
+// words, with zero padding at the end
+func sfntChecksum(data []byte) uint32 {
+	var sum uint32
+	for i := 0; i < len(data); i += 4 {
+		var word [4]byte
+		copy(word[:], data[i:])
+		sum += binary.BigEndian.Uint32(word[:])
+	}
+	return sum
+}
+
+// Put the tables together with a table directory, and set the checksum
+// adjustment of the head table
+func sfntAssemble(tables map[string][]byte) []byte {
+	var tags []string
+	for tag := range tables {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+	be := binary.BigEndian
+	numTables := len(tags)
+	searchRange, entrySelector := 1, 0
+	for searchRange*2 <= numTables {
+		searchRange *= 2
+		entrySelector++
+	}
+	searchRange *= 16
+	buf := make([]byte, 12, 12+16*numTables)
+	be.PutUint32(buf[0:], sfntVersion)
+	be.PutUint16(buf[4:], uint16(numTables))
+	be.PutUint16(buf[6:], uint16(searchRange))
+	be.PutUint16(buf[8:], uint16(entrySelector))
+	be.PutUint16(buf[10:], uint16(16*numTables-searchRange))
+	offset := 12 + 16*numTables
+	headOffset := 0
+	for _, tag := range tags {
+		data := tables[tag]
+		buf = append(buf, tag...)
+		buf = appendUint32(buf, sfntChecksum(data))
+		buf = appendUint32(buf, uint32(offset))
+		buf = appendUint32(buf, uint32(len(data)))
+		if tag == "head" {
+			headOffset = offset
+		}
+		offset += (len(data) + 3) &^ 3
+	}
+	for _, tag := range tags {
+		buf = append(buf, tables[tag]...)
+		for len(buf)%4 != 0 {
+			buf = append(buf, 0)
+		}
+	}
+	be.PutUint32(buf[headOffset+8:], sfntCheckSumMagic-sfntChecksum(buf))
+	return buf
+}
+
+// Append a big-endian uint16 to a byte slice
+func appendUint16(buf []byte, n uint16) []byte {
+	return append(buf, byte(n>>8), byte(n))
+}
+
+// Append a big-endian uint32 to a byte slice
+func appendUint32(buf []byte, n uint32) []byte {
+	return append(buf, byte(n>>24), byte(n>>16), byte(n>>8), byte(n))
+}
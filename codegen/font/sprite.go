@@ -12,64 +12,151 @@ import (
 type Matrix [][]int
 type MatrixRow []int
 type BlitPattern struct {
-	Bytes []uint32
-	CS    CharSpec
+	Bytes   []uint32
+	CS      CharSpec
+	Metrics GlyphMetrics
+}
+
+// Holds the horizontal metrics of a glyph, in px. The left edge of the blit
+// pattern goes Bearing px right of the pen position (negative is to the left),
+// and the pen moves Advance px to the right after drawing the glyph.
+type GlyphMetrics struct {
+	Advance int
+	Bearing int
+}
+
+// Default spacing of glyphs, the same as src/blit.rs uses: the pattern goes
+// DefaultBearing px right of the pen, and the pen moves past the right edge of
+// the pattern by DefaultSpacing px.
+const (
+	DefaultBearing = 1
+	DefaultSpacing = 2
+)
+
+// Return the default metrics for a glyph with a blit pattern w px wide
+func DefaultMetrics(w int) GlyphMetrics {
+	return GlyphMetrics{DefaultBearing + w + DefaultSpacing, DefaultBearing}
 }
 
 // Holds description of sprite sheet and character map for generating a font
 type FontSpec struct {
-	Name    string // Name of font
-	Sprites string // Which file holds the sprite sheet image with the grid of glyphs?
-	Size    int    // How many pixels on a side is each glyph (precondition: square glyphs)
-	Cols    int    // How many glyphs wide is the grid?
-	Gutter  int    // How many px between glyphs?
-	Border  int    // How many px wide are top and left borders?
-	Legal   string // What credits or license notices need to be included in font file comments?
-	RustOut string // Where should the generated source code go?
+	Name     string       // Name of font
+	Sprites  string       // Which file holds the sprite sheet image with the grid of glyphs?
+	BDF      string       // Which BDF font file holds the glyphs? (instead of Sprites)
+	Hex      string       // Which GNU Unifont .hex file holds the glyphs? (instead of Sprites)
+	Glyphs   string       // Which directory holds one PNG file per glyph? (instead of Sprites)
+	Size     int          // How many pixels tall is each glyph cell? (also the line height)
+	Width    int          // How many pixels wide is each glyph cell?
+	Cols     int          // How many glyphs wide is the grid?
+	Gutter   int          // How many px between glyphs?
+	Border   int          // How many px wide are top and left borders?
+	Legal    string       // What credits or license notices need to be included in font file comments?
+	RustOut  string       // Where should the generated source code go?
+	Trim     string       // Which trim limit rules apply? ("syslatin" or "max")
+	Ascent   int          // How many px from top of line down to the baseline?
+	Verify   bool         // Should generated lookups check codepoints to guard against hash collisions?
+	Regions  []GridRegion // Parts of the sprite sheet with their own grid geometry (optional)
+	Metrics  string       // Which file holds per-glyph metrics overrides? (optional)
+	Kerning  string       // Where do kerning pairs come from? ("none", "file", or "auto")
+	KernFile string       // Which file holds kerning pairs? (optional for "auto")
+	Ink      InkSpec      // Which image pixels are ink? (for sprites and glyph directories)
+	Scale    bool         // Should glyph directory images be scaled to Size px tall?
+	Bits     int          // Bits per pixel of glyph patterns (1, or 2 for grayscale coverage)
+}
+
+// Return how many px the line goes below the baseline
+func (fs FontSpec) Descent() int {
+	return fs.Size - fs.Ascent
+}
+
+// Holds the geometry of a rectangular part of a sprite sheet that has its own
+// grid of glyph cells. Regions let one sheet mix cell sizes, like a grid of
+// narrow numerals above a grid of wide cells. Character map rows count through
+// the regions in order, so the first row of the second region comes right after
+// the last row of the first region.
+type GridRegion struct {
+	Top    int // How many px from the top of the sheet is the first row?
+	Left   int // How many px from the left of the sheet is the first column?
+	Rows   int // How many glyphs tall is the grid?
+	Cols   int // How many glyphs wide is the grid?
+	Width  int // How many px wide is each cell?
+	Height int // How many px tall is each cell? (at most the line height)
+	Gutter int // How many px between cells?
+}
+
+// Return true when the glyphs come from a font file or glyph directory, which
+// key glyphs by codepoint, rather than from a sprite sheet with a character map
+func (fs FontSpec) HasFontFile() bool {
+	return fs.BDF != "" || fs.Hex != "" || fs.Glyphs != ""
 }
 
 // Extract matrix of pixels from an image containing grid of glyphs
 // - img: image.Image from png file containing glyph grid
 // - font: Glyph sheet specs (glyph size, border/gutter, etc)
 // - cs: Character specs (source row and column in glyph grid)
-func ConvertGlyphToBlitPattern(img image.Image, font FontSpec, cs CharSpec, dbg bool) BlitPattern {
+func ConvertGlyphToBlitPattern(img image.Image, font FontSpec, cs CharSpec, dbg bool) (BlitPattern, error) {
 	row := cs.Row
 	col := cs.Col
-	imgRect := img.Bounds()
-	rows := (imgRect.Max.Y - font.Border) / (font.Size + font.Gutter)
-	if row < 0 || row >= rows || col < 0 || col >= font.Cols {
-		panic("row or column out of range")
+	regions := font.gridRegions(img.Bounds())
+	rows := 0
+	for _, r := range regions {
+		rows += r.Rows
 	}
-	// Get pixels for grid cell, converting from RGBA to 1-bit
-	gridSize := font.Size + font.Gutter
-	border := font.Border
-	pxMatrix := Matrix{}
-	for y := border + (row * gridSize); y < (row+1)*gridSize; y++ {
-		var row MatrixRow
-		for x := border + (col * gridSize); x < (col+1)*gridSize; x++ {
-			r, _, _, _ := img.At(x, y).RGBA()
-			//fmt.Println(r, g, b, a)
-			if r == 0 {
-				row = append(row, 1)
-			} else {
-				row = append(row, 0)
+	cell, ok := image.Rectangle{}, false
+	if row >= 0 && col >= 0 {
+		regionRow := row
+		for _, r := range regions {
+			if regionRow < r.Rows {
+				cell, ok = r.cell(regionRow, col)
+				break
 			}
+			regionRow -= r.Rows
 		}
-		pxMatrix = append(pxMatrix, row)
 	}
-	pxMatrix, yOffset := trimMatrix(font, row, col, pxMatrix)
-	debugMatrix(cs, pxMatrix, dbg)
-	patternBytes := convertMatrixToPattern(pxMatrix, yOffset)
-	return BlitPattern{patternBytes, cs}
+	if !ok || !cell.In(img.Bounds()) {
+		ctx := Context{font.Name, font.Sprites, 0}
+		return BlitPattern{}, &GridCellError{ctx, cs.HexCluster, row, col, rows, font.Cols}
+	}
+	// Get pixels for grid cell, converting from RGBA to 1-bit
+	pxMatrix := convertImageToMatrix(img, cell, font.Ink, font.Bits)
+	pxMatrix, yOffset, leftTrim := trimMatrix(font, row, col, pxMatrix)
+	debugMatrix(cs, pxMatrix, font.Bits, dbg)
+	patternBytes := convertMatrixToPattern(pxMatrix, yOffset, font.Bits)
+	return BlitPattern{patternBytes, cs, glyphMetrics(cell.Dx(), leftTrim, pxMatrix)}, nil
+}
+
+// Return the grid regions of a sprite sheet. Without regions, the whole sheet
+// is one grid of Width x Size cells below the top border and right of the left
+// border, with as many rows as fit in the image.
+func (fs FontSpec) gridRegions(bounds image.Rectangle) []GridRegion {
+	if len(fs.Regions) > 0 {
+		return fs.Regions
+	}
+	rows := (bounds.Max.Y - fs.Border) / (fs.Size + fs.Gutter)
+	return []GridRegion{{fs.Border, fs.Border, rows, fs.Cols, fs.Width, fs.Size, fs.Gutter}}
+}
+
+// Return the rectangle of a grid cell within a region, and whether the region
+// has that cell
+func (r GridRegion) cell(row int, col int) (image.Rectangle, bool) {
+	if row < 0 || row >= r.Rows || col < 0 || col >= r.Cols {
+		return image.Rectangle{}, false
+	}
+	x := r.Left + col*(r.Width+r.Gutter)
+	y := r.Top + row*(r.Height+r.Gutter)
+	return image.Rect(x, y, x+r.Width, y+r.Height), true
 }
 
 // Trim pixel matrix to remove whitespace around the glyph. Return the trimmed
-// matrix and the y-offset (pixels of top whitespace that were trimmed).
-func trimMatrix(font FontSpec, row int, col int, pxMatrix Matrix) (Matrix, uint32) {
+// matrix, the y-offset (pixels of top whitespace that were trimmed), and the
+// pixels of left whitespace that were trimmed.
+func trimMatrix(font FontSpec, row int, col int, pxMatrix Matrix) (Matrix, uint32, int) {
 	// Trim left whitespace
 	trblTrimLimit := trimLimits(font, row, col)
 	pxMatrix = matrixTranspose(pxMatrix)
+	preTrimW := len(pxMatrix)
 	pxMatrix = trimLeadingEmptyRows(pxMatrix, trblTrimLimit[3])
+	leftTrim := preTrimW - len(pxMatrix)
 	// Trim right whitespace
 	pxMatrix = reverseRows(pxMatrix)
 	pxMatrix = trimLeadingEmptyRows(pxMatrix, trblTrimLimit[1])
@@ -83,29 +170,55 @@ func trimMatrix(font FontSpec, row int, col int, pxMatrix Matrix) (Matrix, uint3
 	pxMatrix = reverseRows(pxMatrix)
 	pxMatrix = trimLeadingEmptyRows(pxMatrix, trblTrimLimit[2])
 	pxMatrix = reverseRows(pxMatrix)
-	return pxMatrix, uint32(yOffset)
+	return pxMatrix, uint32(yOffset), leftTrim
+}
+
+// Return the metrics for a glyph that was trimmed from a cell that is advance
+// px wide. Blank glyphs have no left edge, so their bearing is 0.
+func glyphMetrics(advance int, bearing int, pxMatrix Matrix) GlyphMetrics {
+	if len(pxMatrix) == 0 || len(pxMatrix[0]) == 0 {
+		bearing = 0
+	}
+	return GlyphMetrics{advance, bearing}
+}
+
+// Return an error when metrics are out of range. Advances are 0 to 255 px, the
+// same as pattern widths, and bearings are at most 255 px either way.
+func (m GlyphMetrics) check() error {
+	if m.Advance < 0 || m.Advance > 0xff {
+		return fmt.Errorf("advance of %d px is not 0 to 255 px", m.Advance)
+	}
+	if m.Bearing < -0xff || m.Bearing > 0xff {
+		return fmt.Errorf("left bearing of %d px is not -255 to 255 px", m.Bearing)
+	}
+	return nil
 }
 
 // Dump an ASCII art approximation of the blit pattern to stdout. This can help
 // with troubleshooting character map setup when adding a new font.
-func debugMatrix(cs CharSpec, matrix Matrix, enable bool) {
+func debugMatrix(cs CharSpec, matrix Matrix, bits int, enable bool) {
 	if enable {
-		cp := cs.FirstCodepoint
+		cp := cs.FirstCodepoint()
 		cluster := cs.GraphemeCluster()
 		fmt.Printf("%X: '%s' = %+q\n", cp, cluster, cluster)
-		fmt.Println(convertMatrixToText(matrix))
+		fmt.Println(convertMatrixToText(matrix, bits))
 	}
 }
 
-// Return glyph as text with one ASCII char per pixel
-func convertMatrixToText(matrix Matrix) string {
+// Return glyph as text with one ASCII char per pixel. Coverage values of 2-bit
+// matrices get shades from "." for clear to "#" for full ink.
+func convertMatrixToText(matrix Matrix, bits int) string {
+	shades := ".#"
+	if bits == 2 {
+		shades = ".:+#"
+	}
 	var ascii string
 	for _, row := range matrix {
 		for _, px := range row {
-			if px == 1 {
-				ascii += "#"
+			if px >= 0 && px < len(shades) {
+				ascii += shades[px : px+1]
 			} else {
-				ascii += "."
+				ascii += "?"
 			}
 		}
 		ascii += "\n"
@@ -141,15 +254,17 @@ func trimLeadingEmptyRows(pxMatrix Matrix, limit int) Matrix {
 	return pxMatrix
 }
 
+// Trim limit that removes all whitespace, since patterns can't be more than
+// 255 px on a side
+const maxTrim = 0xff
+
 // Look up trim limits based on row & column in glyph grid
 func trimLimits(font FontSpec, row int, col int) [4]int {
-	if font.Name == "Bold" || font.Name == "Regular" {
+	if font.Trim == "syslatin" {
 		// Radio strength bars get trimmed to match bounds of three bars
 		if col == 0 && row >= 5 && row <= 9 {
 			return [4]int{7, 5, 6, 4}
 		}
-	}
-	if font.Name == "Bold" || font.Name == "Regular" || font.Name == "Small" {
 		// Space gets 4px width and 2px height
 		if col == 2 && row == 0 {
 			lr := (font.Size / 2) - 2
@@ -158,14 +273,39 @@ func trimLimits(font FontSpec, row int, col int) [4]int {
 		}
 	}
 	// Everything else gets max trim
-	return [4]int{font.Size, font.Size, font.Size, font.Size}
+	return [4]int{maxTrim, maxTrim, maxTrim, maxTrim}
+}
+
+// Pattern formats, from the top byte of the pattern header. Patterns of 1-bit
+// fonts have format 0, so code that only knows about 1-bit patterns can ignore
+// the top byte.
+const (
+	Format1Bit = 0 // 1-bit pixels for XOR blit
+	Format2Bit = 1 // 2-bit coverage values for grayscale blit
+)
+
+// Return the bits per pixel of a pattern from its header
+func PatternBits(header uint32) int {
+	if header>>24 == Format2Bit {
+		return 2
+	}
+	return 1
+}
+
+// Return how many u32 words a pattern takes, including its header
+func PatternWords(header uint32) int {
+	w, h := int((header>>16)&0xff), int((header>>8)&0xff)
+	return 1 + (w*h*PatternBits(header)+31)/32
 }
 
 // Return pixel matrix as pattern packed into a [u32] array.
-// pat[0]: ((width of blit pattern in px for trimmed glyph as u8) << 16)
+// pat[0]: (format as u8) << 24
+//         | ((width of blit pattern in px for trimmed glyph as u8) << 16)
 //         | (height of blit pattern in px for trimmed glyph as u8) << 8)
 //         | (number of blank rows trimmed from top of glyph as u8)
-// pat[1:(1+ceiling(w*h/32))]: 1-bit pixels packed into u32 words
+// pat[1:(1+ceiling(w*h/32))]: 1-bit pixels packed into u32 words (format 0)
+// pat[1:(1+ceiling(w*h/16))]: 2-bit coverage values packed into u32 words
+//                             (format 1)
 //
 // Pixel bit values are intended as a background/foreground mask for use with
 // XOR blit. Color palette is not set. Rather, palette depends on contents of
@@ -180,7 +320,10 @@ func trimLimits(font FontSpec, row int, col int) [4]int {
 // of the first pixel word. Patterns that need padding because their size is
 // not a multiple of 32 bits (width*height % 32 != 0) get padded with zeros in
 // the least significant bits of the last word.
-func convertMatrixToPattern(pxMatrix Matrix, yOffset uint32) []uint32 {
+//
+// With bits = 2, each pixel is a coverage value from 0 (clear) to 3 (full
+// ink), packed the same way with 2 bits per pixel, 16 pixels per word.
+func convertMatrixToPattern(pxMatrix Matrix, yOffset uint32, bits int) []uint32 {
 	// Pack trimmed pattern into a byte array
 	patW := uint32(0)
 	patH := uint32(0)
@@ -188,18 +331,23 @@ func convertMatrixToPattern(pxMatrix Matrix, yOffset uint32) []uint32 {
 		patW = uint32(len(pxMatrix[0]))
 		patH = uint32(len(pxMatrix))
 	}
-	pattern := []uint32{(patW << 16) | (patH << 8) | yOffset}
+	format, pxBits := uint32(Format1Bit), uint32(1)
+	if bits == 2 {
+		format, pxBits = Format2Bit, 2
+	}
+	pxPerWord := 32 / pxBits
+	pattern := []uint32{(format << 24) | (patW << 16) | (patH << 8) | yOffset}
 	bufWord := uint32(0)
 	flushed := false
 	for y := uint32(0); y < patH; y++ {
 		for x := uint32(0); x < patW; x++ {
-			if pxMatrix[y][patW-1-x] > 0 {
-				bufWord = (bufWord << 1) | 1
-			} else {
-				bufWord = (bufWord << 1)
+			px := pxMatrix[y][patW-1-x]
+			if pxBits == 1 && px > 0 {
+				px = 1
 			}
+			bufWord = (bufWord << pxBits) | uint32(px)&(1<<pxBits-1)
 			flushed = false
-			if (y*patW+x)%32 == 31 {
+			if (y*patW+x)%pxPerWord == pxPerWord-1 {
 				pattern = append(pattern, bufWord)
 				bufWord = 0
 				flushed = true
@@ -207,12 +355,36 @@ func convertMatrixToPattern(pxMatrix Matrix, yOffset uint32) []uint32 {
 		}
 	}
 	if !flushed {
-		finalShift := 32 - ((patW * patH) % 32)
+		finalShift := 32 - ((patW * patH * pxBits) % 32)
 		pattern = append(pattern, bufWord<<finalShift)
 	}
 	return pattern
 }
 
+// Unpack a blit pattern into its pixel matrix and y-offset. This is the
+// inverse of convertMatrixToPattern(). Pixels of 2-bit patterns are coverage
+// values from 0 to 3.
+func ConvertPatternToMatrix(pattern []uint32) (Matrix, uint32) {
+	header := pattern[0]
+	patW := (header >> 16) & 0xff
+	patH := (header >> 8) & 0xff
+	yOffset := header & 0xff
+	pxBits := uint32(PatternBits(header))
+	pxPerWord := 32 / pxBits
+	pxMatrix := Matrix{}
+	for y := uint32(0); y < patH; y++ {
+		row := make(MatrixRow, patW)
+		for x := uint32(0); x < patW; x++ {
+			i := y*patW + x
+			word := pattern[1+i/pxPerWord]
+			shift := 32 - pxBits*(i%pxPerWord+1)
+			row[patW-1-x] = int((word >> shift) & (1<<pxBits - 1))
+		}
+		pxMatrix = append(pxMatrix, row)
+	}
+	return pxMatrix, yOffset
+}
+
 // Convert blit pattern to rust source code for part of an array of bytes
 func ConvertPatternToRust(pattern BlitPattern, comment string) string {
 	patternStr := fmt.Sprintf("    // %s\n    ", comment)
@@ -0,0 +1,77 @@
+// Copyright (c) 2020 Sam Blenny
+// SPDX-License-Identifier: Apache-2.0 OR MIT
+//
+package font
+
+import (
+	"image"
+	"image/color"
+	"reflect"
+	"testing"
+)
+
+func TestPatternRoundTrip(t *testing.T) {
+	// 5x7 px, so 1-bit patterns need 2 words after the header and 2-bit
+	// patterns need 3, with padding at the end of the last word
+	m2 := Matrix{
+		{0, 1, 2, 3, 0},
+		{3, 3, 3, 3, 3},
+		{0, 0, 0, 0, 1},
+		{2, 0, 0, 0, 2},
+		{1, 1, 0, 1, 1},
+		{0, 3, 3, 3, 0},
+		{0, 0, 2, 0, 0},
+	}
+	m1 := Matrix{}
+	for _, row := range m2 {
+		r := MatrixRow{}
+		for _, px := range row {
+			if px > 1 {
+				px = 1
+			}
+			r = append(r, px)
+		}
+		m1 = append(m1, r)
+	}
+	for _, c := range []struct {
+		bits   int
+		matrix Matrix
+		header uint32
+		words  int
+	}{
+		{1, m1, 0x00050703, 3},
+		{2, m2, 0x01050703, 4},
+	} {
+		pattern := convertMatrixToPattern(c.matrix, 3, c.bits)
+		if pattern[0] != c.header || len(pattern) != c.words || PatternWords(pattern[0]) != c.words {
+			t.Errorf("%d-bit: pattern is %08X (%d words, %d by header), expected header %08X and %d words",
+				c.bits, pattern, len(pattern), PatternWords(pattern[0]), c.header, c.words)
+		}
+		if PatternBits(pattern[0]) != c.bits {
+			t.Errorf("%d-bit: header %08X has %d bits per pixel", c.bits, pattern[0], PatternBits(pattern[0]))
+		}
+		matrix, yOffset := ConvertPatternToMatrix(pattern)
+		if !reflect.DeepEqual(matrix, c.matrix) || yOffset != 3 {
+			t.Errorf("%d-bit: pattern reads back as %v with y-offset %d", c.bits, matrix, yOffset)
+		}
+	}
+	// Coverage values above 1 are full ink for 1-bit patterns
+	if p1, p2 := convertMatrixToPattern(m1, 3, 1), convertMatrixToPattern(m2, 3, 1); !reflect.DeepEqual(p1, p2) {
+		t.Errorf("1-bit pattern of 2-bit matrix is %08X, expected %08X", p2, p1)
+	}
+}
+
+func TestLumaCoverage(t *testing.T) {
+	// Levels from black to white get coverage values from 3 to 0
+	img := image.NewNRGBA(image.Rect(0, 0, 4, 1))
+	for x, y := range []uint8{0, 80, 170, 255} {
+		img.Set(x, 0, color.NRGBA{y, y, y, 0xff})
+	}
+	ink := InkSpec{Mode: "luma", Threshold: DefaultInkThreshold}
+	if m := convertImageToMatrix(img, img.Bounds(), ink, 2); !reflect.DeepEqual(m, Matrix{{3, 2, 1, 0}}) {
+		t.Errorf("2-bit luma matrix is %v, expected [[3 2 1 0]]", m)
+	}
+	if m := convertImageToMatrix(img, img.Bounds(), ink, 1); !reflect.DeepEqual(m, Matrix{{1, 1, 0, 0}}) {
+		t.Errorf("1-bit luma matrix is %v, expected [[1 1 0 0]]", m)
+	}
+}
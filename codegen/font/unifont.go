@@ -0,0 +1,221 @@
+// Copyright (c) 2020 Sam Blenny
+// SPDX-License-Identifier: Apache-2.0 OR MIT
+//
+package font
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Read the glyphs of a GNU Unifont .hex file and pack them into a list of blit
+// patterns. Each line has the form "XXXX:bitmap", where XXXX is a hex codepoint
+// and bitmap holds fs.Size rows of pixels (16 for Unifont) as hex digits, with
+// the leftmost pixel of each row in the most significant bit. Glyph width comes
+// from the bitmap length, so 8 and 16 px wide glyphs can be mixed. As an
+// extension, the codepoint may be a hex grapheme cluster like "1f1fa-1f1f8".
+//
+// The glyph cell goes at the top of the line, and glyphs get trimmed with the
+// same max trim rules as sprite sheets. Glyphs with no set pixels keep their
+// full cell so that spaces keep their width. Each glyph advances by the width of
+// its cell. Lines with problems are left out, and the returned error lists them.
+func HexPatterns(fs FontSpec, dbg bool) ([]BlitPattern, error) {
+	file, err := os.Open(fs.Hex)
+	if err != nil {
+		return nil, &FileError{Context{fs.Name, fs.Hex, 0}, err}
+	}
+	defer file.Close()
+	return parseHex(fs, file, dbg)
+}
+
+// Read the glyphs of a .hex file from r, with fs.Hex as the file name for errors
+func parseHex(fs FontSpec, r io.Reader, dbg bool) ([]BlitPattern, error) {
+	var errs ErrorList
+	report := func(line int, format string, a ...interface{}) {
+		errs.Add(&SourceError{Context{fs.Name, fs.Hex, line}, fmt.Sprintf(format, a...)})
+	}
+	var patternList []BlitPattern
+	seen := map[string]int{}
+	scanner := bufio.NewScanner(r)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		txt := strings.TrimSpace(scanner.Text())
+		if txt == "" || strings.HasPrefix(txt, "#") {
+			continue
+		}
+		parts := strings.SplitN(txt, ":", 2)
+		if len(parts) != 2 {
+			report(lineNum, "expected `codepoint:bitmap`, found %q", txt)
+			continue
+		}
+		cluster, err := StringFromHexGC(parts[0])
+		if err != nil {
+			report(lineNum, "bad hex grapheme cluster %q", parts[0])
+			continue
+		}
+		// Normalize the cluster, since Unifont pads codepoints with zeros
+		hexGC := normalHexGC(cluster)
+		if prev, dup := seen[hexGC]; dup {
+			report(lineNum, "grapheme cluster %q is the same as on line %d", hexGC, prev)
+			continue
+		}
+		seen[hexGC] = lineNum
+		bitmap := parts[1]
+		if len(bitmap) == 0 || len(bitmap)%(2*fs.Size) != 0 {
+			report(lineNum, "bitmap has %d hex digits, which is not a whole number of bytes for each of %d rows",
+				len(bitmap), fs.Size)
+			continue
+		}
+		w := 4 * len(bitmap) / fs.Size
+		pxMatrix, ink, err := hexBitmapToMatrix(bitmap, w, fs.Size)
+		if err != nil {
+			report(lineNum, "bad hex digit in bitmap %q", bitmap)
+			continue
+		}
+		if w > 0xff {
+			report(lineNum, "glyph is %d px wide (max 255)", w)
+			continue
+		}
+		cs := CharSpec{hexGC, 0, 0}
+		p := hexCellToPattern(fs, cs, pxMatrix, ink)
+		pxMatrix, _ = ConvertPatternToMatrix(p.Bytes)
+		debugMatrix(cs, pxMatrix, 1, dbg)
+		patternList = append(patternList, p)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, &FileError{Context{fs.Name, fs.Hex, 0}, err}
+	}
+	return patternList, errs.Err()
+}
+
+// Return a grapheme cluster as lowercase hex codepoints without leading zeros,
+// joined by "-"
+func normalHexGC(cluster string) string {
+	var hexCPs []string
+	for _, c := range cluster {
+		hexCPs = append(hexCPs, fmt.Sprintf("%x", c))
+	}
+	return strings.Join(hexCPs, "-")
+}
+
+// Convert the pixel matrix of a glyph cell to a blit pattern. Glyphs with set
+// pixels get trimmed, and blank glyphs keep their full cell.
+func hexCellToPattern(fs FontSpec, cs CharSpec, pxMatrix Matrix, ink bool) BlitPattern {
+	w := len(pxMatrix[0])
+	yOffset := uint32(0)
+	leftTrim := 0
+	if ink {
+		trimSpec := fs
+		trimSpec.Trim = "max"
+		pxMatrix, yOffset, leftTrim = trimMatrix(trimSpec, -1, -1, pxMatrix)
+	}
+	metrics := glyphMetrics(w, leftTrim, pxMatrix)
+	return BlitPattern{convertMatrixToPattern(pxMatrix, yOffset, 1), cs, metrics}
+}
+
+// Unpack a hex bitmap of h rows that are w px wide into a pixel matrix. Also
+// return whether any pixels are set.
+func hexBitmapToMatrix(bitmap string, w int, h int) (Matrix, bool, error) {
+	pxMatrix := Matrix{}
+	ink := false
+	for y := 0; y < h; y++ {
+		var row MatrixRow
+		for x := 0; x < w; x++ {
+			i := y*w/4 + x/4
+			nibble, err := strconv.ParseUint(bitmap[i:i+1], 16, 8)
+			if err != nil {
+				return nil, false, err
+			}
+			px := int(nibble>>(3-uint(x%4))) & 1
+			ink = ink || px == 1
+			row = append(row, px)
+		}
+		pxMatrix = append(pxMatrix, row)
+	}
+	return pxMatrix, ink, nil
+}
+
+// Size of the glyph cells of GNU Unifont .hex files: 16 px tall, with the
+// baseline 14 px below the top, and 8 or 16 px wide
+const (
+	UnifontHeight = 16
+	UnifontAscent = 14
+	UnifontWidth  = 8
+)
+
+// Make a GNU Unifont .hex file from a list of blit patterns, for use with
+// Unifont tools like hex2bdf and unihex2png. Each glyph goes in a cell that is
+// UnifontHeight px tall, with the font's baseline at UnifontAscent px, and at
+// its left bearing in a cell that is 8 or 16 px wide, whichever holds its
+// advance. Glyphs that don't fit in a cell are errors. Since .hex cells advance
+// by their width, and HexPatterns trims them, other advances and left bearings
+// do not round trip. Clusters with more than one codepoint are left out, and
+// the second return value lists them.
+func HexFromPatterns(fs FontSpec, pl []BlitPattern) (string, []string, error) {
+	var b strings.Builder
+	var left []string
+	var errs ErrorList
+	report := func(p BlitPattern, format string, a ...interface{}) {
+		msg := fmt.Sprintf("glyph %q ", p.CS.HexCluster) + fmt.Sprintf(format, a...)
+		errs.Add(&SourceError{Context{Font: fs.Name}, msg})
+	}
+	for _, p := range pl {
+		cluster := []rune(p.CS.GraphemeCluster())
+		if len(cluster) != 1 {
+			left = append(left, p.CS.HexCluster)
+			continue
+		}
+		pxMatrix, yOffset := ConvertPatternToMatrix(p.Bytes)
+		w := int((p.Bytes[0] >> 16) & 0xff)
+		h := int((p.Bytes[0] >> 8) & 0xff)
+		x0, y0 := p.Metrics.Bearing, int(yOffset)+UnifontAscent-fs.Ascent
+		cellW := UnifontWidth
+		for p.Metrics.Advance > cellW || x0+w > cellW {
+			cellW += UnifontWidth
+		}
+		switch {
+		case cellW > 2*UnifontWidth:
+			report(p, "needs a cell %d px wide for its advance and pattern (max %d px)", cellW, 2*UnifontWidth)
+			continue
+		case x0 < 0:
+			report(p, "has a left bearing of %d px, so it does not fit in a cell", x0)
+			continue
+		case y0 < 0 || y0+h > UnifontHeight:
+			report(p, "needs rows %d to %d of a cell with the baseline at row %d (max %d rows)",
+				y0, y0+h-1, UnifontAscent, UnifontHeight)
+			continue
+		}
+		cell := make(Matrix, UnifontHeight)
+		for y := range cell {
+			cell[y] = make(MatrixRow, cellW)
+			if y >= y0 && y < y0+h {
+				copy(cell[y][x0:], pxMatrix[y-y0])
+			}
+		}
+		fmt.Fprintf(&b, "%04X:%s\n", cluster[0], matrixToHexBitmap(cell))
+	}
+	return b.String(), left, errs.Err()
+}
+
+// Pack the rows of a pixel matrix into hex digits, with the leftmost pixel of
+// each row in the most significant bit. Rows must be a multiple of 8 px wide.
+func matrixToHexBitmap(pxMatrix Matrix) string {
+	var bitmap []byte
+	for _, row := range pxMatrix {
+		for x := 0; x < len(row); x += 8 {
+			var bits byte
+			for i, px := range row[x : x+8] {
+				if px != 0 {
+					bits |= 0x80 >> uint(i)
+				}
+			}
+			bitmap = append(bitmap, bits)
+		}
+	}
+	return fmt.Sprintf("%X", bitmap)
+}
@@ -0,0 +1,111 @@
+// Copyright (c) 2020 Sam Blenny
+// SPDX-License-Identifier: Apache-2.0 OR MIT
+//
+package font
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// Return a blit pattern for a matrix of "#" and "." rows
+func testPattern(hexGC string, rows []string, yOffset uint32, advance int, bearing int) BlitPattern {
+	pxMatrix := Matrix{}
+	for _, r := range rows {
+		row := MatrixRow{}
+		for _, c := range r {
+			if c == '#' {
+				row = append(row, 1)
+			} else {
+				row = append(row, 0)
+			}
+		}
+		pxMatrix = append(pxMatrix, row)
+	}
+	return BlitPattern{convertMatrixToPattern(pxMatrix, yOffset, 1), CharSpec{hexGC, 0, 0}, GlyphMetrics{advance, bearing}}
+}
+
+// Return true if two blit patterns have the same pattern words and metrics
+func samePattern(a BlitPattern, b BlitPattern) bool {
+	return reflect.DeepEqual(a.Bytes, b.Bytes) && a.Metrics == b.Metrics
+}
+
+func TestHexFromPatterns(t *testing.T) {
+	fs := FontSpec{Name: "Test", Size: 16, Ascent: 12}
+	a := []string{"..##..", ".#..#.", "######", "#....#"}
+	pl := []BlitPattern{
+		testPattern("41", a, 6, 8, 1),
+		// Blank space with a trimmed size
+		testPattern("20", []string{"....", "...."}, 12, 7, 1),
+		// Advances over 8 px need 16 px cells
+		testPattern("4d", []string{"#.........#", "##.......##", "#.#.....#.#"}, 2, 13, 1),
+		// Multi-codepoint clusters can't be in .hex files
+		testPattern("1f1fa-1f1f8", []string{"#########", "#.......#", "#########"}, 2, 12, 1),
+	}
+	hex, left, err := HexFromPatterns(fs, pl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(left, []string{"1f1fa-1f1f8"}) {
+		t.Errorf("left out clusters %v, expected [1f1fa-1f1f8]", left)
+	}
+	// The baseline moves from 12 to 14 px below the top of the line
+	lines := strings.Split(strings.TrimSuffix(hex, "\n"), "\n")
+	want := "0041:" + strings.Repeat("00", 8) + "18247E42" + strings.Repeat("00", 4)
+	if len(lines) != 3 || lines[0] != want {
+		t.Fatalf("got .hex file:\n%s\nexpected 3 lines, starting with:\n%s", hex, want)
+	}
+	for _, line := range lines {
+		if n := len(strings.SplitN(line, ":", 2)[1]); n != 32 && n != 64 {
+			t.Errorf("bitmap of line %q has %d hex digits instead of 32 or 64", line, n)
+		}
+	}
+	// Glyphs read back at the same place, but advance by the width of their cell
+	rt, err := parseHex(FontSpec{Name: "Test", Size: UnifontHeight, Ascent: UnifontAscent}, strings.NewReader(hex), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want41 := testPattern("41", a, 8, 8, 1)
+	wantM := testPattern("4d", []string{"#.........#", "##.......##", "#.#.....#.#"}, 4, 16, 1)
+	if len(rt) != 3 || !samePattern(rt[0], want41) || !samePattern(rt[2], wantM) || rt[1].Metrics.Advance != 8 {
+		t.Errorf("read back patterns %+v", rt)
+	}
+}
+
+func TestHexFromPatternsErrors(t *testing.T) {
+	fs := FontSpec{Name: "Test", Size: 30, Ascent: 24}
+	for _, c := range []struct {
+		name string
+		p    BlitPattern
+		msg  string
+	}{
+		{"too wide", testPattern("57", []string{"#.......#.......#"}, 10, 19, 1), "needs a cell 24 px wide"},
+		{"advance too wide", testPattern("2014", []string{"######"}, 10, 17, 0), "needs a cell 24 px wide"},
+		{"negative bearing", testPattern("6a", []string{".#", "##"}, 10, 4, -1), "left bearing of -1 px"},
+		{"above the cell", testPattern("c1", []string{"#", "#"}, 9, 4, 1), "needs rows -1 to 0"},
+		{"below the cell", testPattern("67", []string{"#", "#", "#"}, 24, 4, 1), "needs rows 14 to 16"},
+	} {
+		_, _, err := HexFromPatterns(fs, []BlitPattern{testPattern("41", []string{"#"}, 20, 3, 1), c.p})
+		list, ok := err.(ErrorList)
+		if !ok || len(list) != 1 || !strings.Contains(list[0].Error(), "Test: glyph \""+c.p.CS.HexCluster+"\" ") ||
+			!strings.Contains(list[0].Error(), c.msg) {
+			t.Errorf("%s: expected one error about %q, got %v", c.name, c.msg, err)
+		}
+	}
+}
+
+func TestHexParseErrors(t *testing.T) {
+	a := "0000000018242442427E424242420000"
+	testSourceErrors(t, "test.hex", "0041:"+a+"\n", []sourceErrorCase{
+		{"no bitmap", ":" + a, "", 1, "expected `codepoint:bitmap`"},
+		{"bad cluster", "0041:", "# comment\nZZ:", 2, "bad hex grapheme cluster"},
+		{"short bitmap", a, a[:30], 1, "not a whole number of bytes"},
+		{"bad digit", a, a[:31] + "G", 1, "bad hex digit"},
+		{"duplicate", "\n", "\n41:" + a + "\n", 2, "same as on line 1"},
+		{"too wide", a, strings.Repeat("00", 16*256/8), 1, "glyph is 256 px wide"},
+	}, func(path string) error {
+		_, err := HexPatterns(FontSpec{Name: "Test", Hex: path, Size: 16}, false)
+		return err
+	})
+}
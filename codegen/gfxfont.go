@@ -0,0 +1,189 @@
+// Copyright (c) 2020 Sam Blenny
+// SPDX-License-Identifier: Apache-2.0 OR MIT
+//
+package main
+
+import (
+	"fmt"
+	"guilib/codegen/font"
+	"strings"
+	"unicode/utf8"
+)
+
+// Highest codepoint an Adafruit GFX font can use, since Adafruit_GFX::write()
+// takes one byte at a time
+const gfxMaxCodepoint = 0xFF
+
+// Holds one entry of a GFXglyph table
+type GFXGlyph struct {
+	BitmapOffset int
+	Width        int
+	Height       int
+	XAdvance     int
+	XOffset      int // Offset from the pen position to the left of the glyph
+	YOffset      int // Offset from the baseline to the top of the glyph
+	Label        string
+}
+
+// Generate an Adafruit GFX font header for a font. GFX fonts index glyphs by
+// a single byte, so only single-codepoint clusters up to U+00FF fit. The other
+// grapheme clusters get listed in a comment of the header and reported on
+// stdout. Glyphs get positioned with the baseline fs.Ascent px below the top
+// of the line.
+func genGFXFontFile(fs font.FontSpec, gfxOut string, rb RustyBlits) (OutputFile, error) {
+	byCodepoint := map[int]font.BlitPattern{}
+	var left []string
+	first, last := -1, -1
+	for _, p := range rb.Patterns {
+		cluster := p.CS.GraphemeCluster()
+		c := int(p.CS.FirstCodepoint())
+		if utf8.RuneCountInString(cluster) != 1 || c > gfxMaxCodepoint {
+			left = append(left, p.CS.HexCluster+" "+labelForCluster(cluster))
+			continue
+		}
+		byCodepoint[c] = p
+		if first < 0 || c < first {
+			first = c
+		}
+		if c > last {
+			last = c
+		}
+	}
+	if first < 0 {
+		return OutputFile{}, &font.SourceError{Context: font.Context{Font: fs.Name}, Msg: "font has no glyphs that fit in an Adafruit GFX font"}
+	}
+	var bitmap []byte
+	var glyphs []GFXGlyph
+	for c := first; c <= last; c++ {
+		p, ok := byCodepoint[c]
+		if !ok {
+			// GFX fonts have one glyph for each codepoint from first to last
+			glyphs = append(glyphs, GFXGlyph{len(bitmap), 0, 0, 0, 0, 0, fmt.Sprintf("%02X (none)", c)})
+			continue
+		}
+		pxMatrix, yOffset := font.ConvertPatternToMatrix(p.Bytes)
+		g := GFXGlyph{
+			BitmapOffset: len(bitmap),
+			Width:        int((p.Bytes[0] >> 16) & 0xff),
+			Height:       len(pxMatrix),
+			XAdvance:     p.Metrics.Advance,
+			XOffset:      p.Metrics.Bearing,
+			YOffset:      int(yOffset) - fs.Ascent,
+			Label:        p.CS.HexCluster + " " + labelForCluster(p.CS.GraphemeCluster()),
+		}
+		if g.XOffset < -128 || g.XOffset > 127 {
+			return OutputFile{}, &font.SourceError{Context: font.Context{Font: fs.Name},
+				Msg: fmt.Sprintf("left bearing of glyph %s is %d px, but GFX x-offsets are 8-bit", p.CS.HexCluster, g.XOffset)}
+		}
+		bitmap = append(bitmap, packGlyphBits(pxMatrix)...)
+		glyphs = append(glyphs, g)
+	}
+	if len(bitmap) > 0xFFFF {
+		return OutputFile{}, &font.SourceError{Context: font.Context{Font: fs.Name},
+			Msg: fmt.Sprintf("Adafruit GFX bitmap needs %d bytes, but glyph offsets are 16-bit", len(bitmap))}
+	}
+	if len(left) > 0 {
+		fmt.Printf("%s: Adafruit GFX font leaves out %d grapheme clusters (see %s)\n", fs.Name, len(left), gfxOut)
+	}
+	prefix := cIdentifier(fs.Name) + "_gfx"
+	context := struct {
+		Font   font.FontSpec
+		Prefix string
+		Macro  string
+		Bitmap string
+		Glyphs []GFXGlyph
+		First  int
+		Last   int
+		Left   []string
+	}{fs, prefix, strings.ToUpper(prefix), cCodeForBytes(bitmap), glyphs, first, last, left}
+	return OutputFile{gfxOut, renderTemplate(gfxFontTemplate, "gfxfont", context), false}, nil
+}
+
+// Pack the pixels of a glyph into bytes without row padding, with the top left
+// pixel in the MSB of the first byte. This is the 1-bit bitmap format for both
+// Adafruit GFX and LVGL fonts.
+func packGlyphBits(pxMatrix font.Matrix) []byte {
+	var packed []byte
+	var acc byte
+	bits := 0
+	for _, row := range pxMatrix {
+		for _, px := range row {
+			acc = acc<<1 | byte(px)
+			bits++
+			if bits == 8 {
+				packed = append(packed, acc)
+				acc, bits = 0, 0
+			}
+		}
+	}
+	if bits > 0 {
+		packed = append(packed, acc<<uint(8-bits))
+	}
+	return packed
+}
+
+// Format the inner elements of a C byte array, 12 bytes per line
+func cCodeForBytes(data []byte) string {
+	var lines []string
+	for i := 0; i < len(data); i += 12 {
+		var line []string
+		for _, b := range data[i:min(i+12, len(data))] {
+			line = append(line, fmt.Sprintf("0x%02X", b))
+		}
+		lines = append(lines, strings.Join(line, ", ")+",")
+	}
+	return strings.Join(lines, "\n    ")
+}
+
+// Return lowest value among two integers
+func min(a int, b int) int {
+	if b > a {
+		return a
+	}
+	return b
+}
+
+// Template with C source code for an Adafruit GFX font header
+const gfxFontTemplate = `// DO NOT MAKE EDITS HERE because this file is automatically generated.
+// To make changes, see guilib/codegen/main.go
+//
+// Copyright (c) 2020 Sam Blenny
+// SPDX-License-Identifier: Apache-2.0 OR MIT
+//
+// NOTE: The copyright notice above applies to the C source code in this file,
+// but not to the bitmap graphics encoded in the bitmap array (see credits).
+//
+// CREDITS:
+{{LineComment .Font.Legal}}
+// {{.Font.Name}} Font for Adafruit_GFX (use with setFont(&{{.Prefix}}))
+{{- if .Left}}
+//
+// These grapheme clusters are not in this font because Adafruit GFX fonts
+// only have glyphs for single codepoints up to U+00FF:
+{{- range $_, $l := .Left}}
+//   {{$l}}
+{{- end}}
+{{- end}}
+#ifndef GUILIB_{{.Macro}}_H
+#define GUILIB_{{.Macro}}_H
+
+#include <Adafruit_GFX.h>
+
+const uint8_t {{.Prefix}}_bitmaps[] PROGMEM = {
+    {{.Bitmap}}
+};
+
+const GFXglyph {{.Prefix}}_glyphs[] PROGMEM = {
+{{- range $_, $g := .Glyphs}}
+    { {{- printf "%5d" $g.BitmapOffset}}, {{printf "%3d" $g.Width}}, {{printf "%3d" $g.Height}}, {{printf "%3d" $g.XAdvance}}, {{printf "%3d" $g.XOffset}}, {{printf "%4d" $g.YOffset -}} }, // {{$g.Label}}
+{{- end}}
+};
+
+const GFXfont {{.Prefix}} PROGMEM = {
+    (uint8_t *){{.Prefix}}_bitmaps,
+    (GFXglyph *){{.Prefix}}_glyphs,
+    0x{{printf "%02X" .First}}, 0x{{printf "%02X" .Last}}, {{.Font.Size}}
+};
+
+#endif
+`
@@ -0,0 +1,291 @@
+// Copyright (c) 2020 Sam Blenny
+// SPDX-License-Identifier: Apache-2.0 OR MIT
+//
+package main
+
+import (
+	"fmt"
+	"go/format"
+	"guilib/codegen/font"
+	"path"
+	"strings"
+)
+
+// Generate a Go package for a font so host-side tools can use the same glyph
+// data, index, and grapheme cluster matching as the rust font file. The
+// package name comes from the last element of the goOut directory.
+func genGoFontFiles(fs font.FontSpec, goOut string, rb RustyBlits) ([]OutputFile, error) {
+	context := struct {
+		Font    font.FontSpec
+		Package string
+		RB      RustyBlits
+		Verify  bool
+	}{fs, cIdentifier(path.Base(goOut)), rb, fs.Verify}
+	code := renderTemplate(goFontTemplate, "gofont", context)
+	// Format the generated code the same way gofmt would
+	formatted, err := format.Source([]byte(code))
+	if err != nil {
+		return nil, fmt.Errorf("%s: generated Go code does not parse: %v", fs.Name, err)
+	}
+	return []OutputFile{{path.Join(goOut, "font.go"), string(formatted), false}}, nil
+}
+
+// Format the inner elements of a []string table of grapheme clusters for one block
+func (coIndex BlockIndex) GoCodeForClusters() string {
+	var goCode []string
+	for _, entry := range coIndex {
+		goCode = append(goCode, fmt.Sprintf("%+q,", entry.Cluster))
+	}
+	return strings.Join(goCode, "\n")
+}
+
+// Template with Go source code for a font package
+const goFontTemplate = `// Code generated by guilib/codegen; DO NOT EDIT.
+// To make changes, see guilib/codegen/main.go
+//
+// Copyright (c) 2020 Sam Blenny
+// SPDX-License-Identifier: Apache-2.0 OR MIT
+//
+// NOTE: The copyright notice above applies to the Go source code in this file,
+// but not to the bitmap graphics encoded in the Data array (see credits).
+//
+// CREDITS:
+{{LineComment .Font.Legal}}
+
+// Package {{.Package}} holds the {{.Font.Name}} font glyph patterns and grapheme
+// cluster index, matching the generated rust font file.
+package {{.Package}}
+
+import (
+	"errors"
+	"math/bits"
+{{- if or .RB.MetricsCount .RB.Kerns}}
+	"sort"
+{{- end}}
+	"unicode/utf8"
+)
+
+// Maximum height of glyph patterns in this bitmap typeface.
+// This will be true: h + yOffset <= MaxHeight
+const MaxHeight = {{.Font.Size}}
+
+// Distance in pixels from the top of the line down to the baseline
+const Ascent = {{.Font.Ascent}}
+
+// Distance in pixels from the baseline down to the bottom of the line.
+// This will be true: Ascent + Descent == MaxHeight
+const Descent = {{.Font.Descent}}
+
+// Seed for Murmur3 hashes in the block hash indexes
+const M3Seed uint32 = {{.RB.Seed}}
+
+// Error for when the font has no glyph to match a grapheme cluster query
+var ErrGlyphNotFound = errors.New("font has no glyph for requested grapheme cluster")
+
+// Index of the grapheme clusters for one Unicode block
+type blockIndex struct {
+	low     rune
+	high    rune
+	gcLens  []int    // Cluster lengths to try, longest first
+	hashes  []uint32 // murmur3(grapheme cluster), sorted
+	offsets []int    // Blit pattern offsets; sort matches hashes
+{{- if .Verify}}
+	clusters []string // Grapheme clusters; sort matches hashes
+{{- end}}
+}
+
+// Unicode blocks included in this font
+var blocks = []blockIndex{
+{{- range $_, $k := .RB.IndexKeys}}
+{{- with $dex := index $.RB.Index $k}}
+	// {{$k.Name}}
+	{
+		low:    0x{{printf "%X" $k.Low}},
+		high:   0x{{printf "%X" $k.High}},
+		gcLens: []int{ {{- range $i, $n := $dex.ClusterLengthList}}{{if $i}}, {{end}}{{$n}}{{end -}} },
+		hashes: []uint32{
+			{{$dex.RustCodeForClusterHashes}}
+		},
+		offsets: []int{
+			{{$dex.RustCodeForOffsets}}
+		},
+{{- if $.Verify}}
+		clusters: []string{
+			{{$dex.GoCodeForClusters}}
+		},
+{{- end}}
+	},
+{{- end}}
+{{- end}}
+}
+
+// Return the offset into Data for the start of the blit pattern for the
+// grapheme cluster at the start of a string, and how many bytes of the string
+// were matched. Longer clusters are matched first, the same as the rust
+// get_blit_pattern_offset().
+func Lookup(cluster string) (offset int, bytesUsed int, err error) {
+	firstChar, size := utf8.DecodeRuneInString(cluster)
+	if size == 0 {
+		return 0, 0, ErrGlyphNotFound
+	}
+	for _, b := range blocks {
+		if firstChar < b.low || firstChar > b.high {
+			continue
+		}
+		for _, limit := range b.gcLens {
+			if offset, bytesUsed, ok := b.find(cluster, limit); ok {
+				return offset, bytesUsed, nil
+			}
+		}
+		break
+	}
+	return 0, 0, ErrGlyphNotFound
+}
+
+// Use binary search on a block's table of grapheme cluster hashes to find the
+// blit pattern for a grapheme cluster of length limit codepoints
+func (b blockIndex) find(cluster string, limit int) (int, int, bool) {
+	key, bytesHashed := Murmur3(cluster, M3Seed, limit)
+	low, high := 0, len(b.hashes)
+	for low < high {
+		mid := low + (high-low)/2
+		switch {
+		case b.hashes[mid] < key:
+			low = mid + 1
+		case b.hashes[mid] > key:
+			high = mid
+		default:
+{{- if .Verify}}
+			if b.clusters[mid] != cluster[:bytesHashed] {
+				return 0, 0, false
+			}
+{{- end}}
+			return b.offsets[mid], bytesHashed, true
+		}
+	}
+	return 0, 0, false
+}
+
+// Compute Murmur3 hash of the first limit codepoints of a string, using each
+// codepoint as a u32 block. Return the hash and how many bytes were hashed.
+func Murmur3(key string, seed uint32, limit int) (uint32, int) {
+	h := seed
+	n := 0
+	bytesHashed := len(key)
+	for i, c := range key {
+		if n >= limit {
+			bytesHashed = i
+			break
+		}
+		k := uint32(c)
+		k *= 0xcc9e2d51
+		k = bits.RotateLeft32(k, 15)
+		k *= 0x1b873593
+		h ^= k
+		h = bits.RotateLeft32(h, 13)
+		h *= 5
+		h += 0xe6546b64
+		n++
+	}
+	h ^= uint32(bytesHashed)
+	// Finalize with avalanche
+	h ^= h >> 16
+	h *= 0x85ebca6b
+	h ^= h >> 13
+	h *= 0xc2b2ae35
+	return h ^ (h >> 16), bytesHashed
+}
+
+// Unpack a glyph pattern header of format: (w:u8)<<16 | (h:u8)<<8 | yOffset:u8
+func Header(offset int) (w int, h int, yOffset int) {
+	header := Data[offset]
+	return int((header >> 16) & 0xff), int((header >> 8) & 0xff), int(header & 0xff)
+}
+
+// Return the metrics of the glyph with its blit pattern at Data[offset], in
+// pixels. The left edge of the pattern goes bearing pixels right of the pen
+// position (negative is to the left), and the pen moves advance pixels to the
+// right after drawing the glyph. Glyphs without a metrics record get the
+// spacing of blit.rs: a bearing of 1, and an advance of the pattern width plus
+// 3. The result is false if offset is past the end of Data.
+func Metrics(offset int) (advance int, bearing int, ok bool) {
+{{- if .RB.MetricsCount}}
+	i := sort.SearchInts(metricsOffsets[:], offset)
+	if i < len(metricsOffsets) && metricsOffsets[i] == offset {
+		m := metrics[i]
+		return int(m >> 16), int(int16(uint16(m))), true
+	}
+{{- end}}
+	if offset < 0 || offset >= len(Data) {
+		return 0, 0, false
+	}
+	return int((Data[offset]>>16)&0xff) + 3, 1, true
+}
+{{- if .RB.MetricsCount}}
+
+// Lookup table of blit pattern offsets in Data order, for glyphs that do not
+// have the default metrics; sort matches metrics
+var metricsOffsets = [{{.RB.MetricsCount}}]int{
+	{{.RB.RustCodeForMetricsOffsets}}
+}
+
+// Glyph metrics; sort matches metricsOffsets.
+// Record format: ((advance as u16) << 16) | (left bearing as i16 as u16)
+var metrics = [{{.RB.MetricsCount}}]uint32{
+	{{.RB.RustCodeForMetrics}}
+}
+{{- end}}
+
+// Return the kerning adjustment in pixels to add to the advance of the glyph
+// with its blit pattern at Data[left] when the glyph at Data[right] follows it.
+// Negative adjustments move the glyphs closer together.
+{{- if .RB.Kerns}}
+func Kerning(left int, right int) int {
+	key := {{template "kernKeyType" .}}(left)<<{{.RB.KernKeyShift}} | {{template "kernKeyType" .}}(right)
+	i := sort.Search(len(kernKeys), func(i int) bool { return kernKeys[i] >= key })
+	if i == len(kernKeys) || kernKeys[i] != key {
+		return 0
+	}
+	return int(kernAdjust[i])
+}
+
+// Kerning pairs as ((left Data offset) << {{.RB.KernKeyShift}}) | (right Data offset), sorted
+var kernKeys = [{{len .RB.Kerns}}]{{template "kernKeyType" .}}{
+	{{.RB.RustCodeForKernKeys}}
+}
+
+// Kerning adjustments in pixels; sort matches kernKeys
+var kernAdjust = [{{len .RB.Kerns}}]int8{
+	{{.RB.RustCodeForKernAdjustments}}
+}
+{{- else}}
+// This font has no kerning pairs.
+func Kerning(left int, right int) int {
+	return 0
+}
+{{- end}}
+
+// Packed glyph pattern data.
+// Record format:
+{{- if eq .Font.Bits 2}}
+//  [offset+0]: (1 << 24) | ((w as u8) << 16) | ((h as u8) << 8) | (yOffset as u8)
+//  [offset+1..=ceil(w*h/16)]: packed 2-bit coverage values; 0=clear,
+//     1=1/3 ink, 2=2/3 ink, 3=full ink
+// Pixels are packed in top to bottom, left to right order with the 2 MSBs of
+// the first pixel word containing the top left pixel. The top byte of the
+// header is the pattern format, which is 1 for 2-bit coverage values, or 0
+// (like in fonts with 1-bit patterns) for 1-bit pixels.
+{{- else}}
+//  [offset+0]: ((w as u8) << 16) | ((h as u8) << 8) | (yOffset as u8)
+//  [offset+1..=ceil(w*h/32)]: packed 1-bit pixels; 0=clear, 1=set
+// Pixels are packed in top to bottom, left to right order with MSB of first
+// pixel word containing the top left pixel.
+{{- end}}
+//  w: Width of pattern in pixels
+//  h: Height of pattern in pixels
+//  yOffset: Vertical offset (pixels downward from top of line) to position
+//     glyph pattern properly relative to text baseline
+var Data = [{{.RB.DataLen}}]uint32{
+{{.RB.Code}}}
+{{- define "kernKeyType"}}{{if eq .RB.KernKeyShift 16}}uint32{{else}}uint64{{end}}{{end}}
+`
@@ -0,0 +1,283 @@
+// Copyright (c) 2020 Sam Blenny
+// SPDX-License-Identifier: Apache-2.0 OR MIT
+//
+package main
+
+import (
+	"fmt"
+	"guilib/codegen/font"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// Holds one entry of an LVGL glyph descriptor table
+type LVGLGlyph struct {
+	BitmapIndex int
+	AdvW        int // Advance width in 1/16 px units
+	BoxW        int
+	BoxH        int
+	OfsX        int // Offset from the pen position to the left of the glyph
+	OfsY        int // Offset from the baseline to the bottom of the glyph
+	Label       string
+}
+
+// Holds one LVGL cmap subtable, which maps the codepoints of one Unicode block
+// to glyph IDs
+type LVGLCmap struct {
+	Block        font.UBlock
+	RangeStart   uint32
+	RangeLength  uint32
+	GlyphIDStart int
+	Type         string // LV_FONT_FMT_TXT_CMAP_... format
+	UnicodeList  []int  // Codepoint offsets from RangeStart, for SPARSE types
+	GlyphIDOfs   []int  // Glyph ID offsets from GlyphIDStart, for FULL types
+}
+
+// Format the inner elements of the uint16_t unicode_list array for a cmap
+func (cm LVGLCmap) UnicodeListCode() string {
+	return cCodeForInts(cm.UnicodeList)
+}
+
+// Format the inner elements of the uint16_t glyph_id_ofs_list array for a cmap
+func (cm LVGLCmap) GlyphIDOfsCode() string {
+	return cCodeForInts(cm.GlyphIDOfs)
+}
+
+// Format the inner elements of a C integer array, 16 numbers per line
+func cCodeForInts(list []int) string {
+	var lines []string
+	for i := 0; i < len(list); i += 16 {
+		var line []string
+		for _, n := range list[i:min(i+16, len(list))] {
+			line = append(line, fmt.Sprintf("%d", n))
+		}
+		lines = append(lines, strings.Join(line, ", ")+",")
+	}
+	return strings.Join(lines, "\n    ")
+}
+
+// Generate an LVGL font (lv_font_fmt_txt format, 1 bpp) for a font. There is
+// one cmap subtable for each Unicode block in the index, and the glyphs for
+// single-codepoint clusters and aliases are in the cmaps. LVGL looks up glyphs
+// by single codepoints, so multi-codepoint clusters without a single-codepoint
+// alias are left out, and get listed in a comment and reported on stdout.
+func genLVGLFontFile(fs font.FontSpec, lvglOut string, rb RustyBlits) (OutputFile, error) {
+	patterns := map[int]font.BlitPattern{}
+	offset := 0
+	for _, p := range rb.Patterns {
+		patterns[offset] = p
+		offset += len(p.Bytes)
+	}
+	// Glyph ID 0 is reserved, so real glyphs start at 1
+	glyphs := []LVGLGlyph{{}}
+	var bitmap []byte
+	glyphIDs := map[int]int{}
+	var cmaps []LVGLCmap
+	for _, k := range rb.IndexKeys() {
+		// Find the single-codepoint clusters of this block, in codepoint order
+		var entries []ClusterOffsetEntry
+		for _, entry := range rb.Index[k] {
+			if utf8.RuneCountInString(entry.Cluster) == 1 {
+				entries = append(entries, entry)
+			}
+		}
+		if len(entries) == 0 {
+			continue
+		}
+		sort.Slice(entries, func(i, j int) bool { return []rune(entries[i].Cluster)[0] < []rune(entries[j].Cluster)[0] })
+		// Assign glyph IDs in codepoint order, so the IDs of a block are
+		// sequential unless aliases share glyphs
+		type cpGlyph struct {
+			cp uint32
+			id int
+		}
+		var cpList []cpGlyph
+		for _, entry := range entries {
+			id, ok := glyphIDs[entry.DataOffset]
+			if !ok {
+				p := patterns[entry.DataOffset]
+				pxMatrix, yOffset := font.ConvertPatternToMatrix(p.Bytes)
+				w := int((p.Bytes[0] >> 16) & 0xff)
+				if p.Metrics.Bearing < -128 || p.Metrics.Bearing > 127 {
+					return OutputFile{}, &font.SourceError{Context: font.Context{Font: fs.Name},
+						Msg: fmt.Sprintf("left bearing of glyph %s is %d px, but LVGL x-offsets are 8-bit", p.CS.HexCluster, p.Metrics.Bearing)}
+				}
+				id = len(glyphs)
+				glyphIDs[entry.DataOffset] = id
+				glyphs = append(glyphs, LVGLGlyph{
+					BitmapIndex: len(bitmap),
+					AdvW:        16 * p.Metrics.Advance,
+					BoxW:        w,
+					BoxH:        len(pxMatrix),
+					OfsX:        p.Metrics.Bearing,
+					OfsY:        fs.Ascent - int(yOffset) - len(pxMatrix),
+					Label:       p.CS.HexCluster + " " + labelForCluster(p.CS.GraphemeCluster()),
+				})
+				bitmap = append(bitmap, packGlyphBits(pxMatrix)...)
+			}
+			cpList = append(cpList, cpGlyph{uint32([]rune(entry.Cluster)[0]), id})
+		}
+		// Pick the smallest cmap format that can describe this block
+		first, last := cpList[0], cpList[len(cpList)-1]
+		cm := LVGLCmap{Block: k, RangeStart: first.cp, RangeLength: last.cp - first.cp + 1, GlyphIDStart: first.id}
+		contiguous := int(cm.RangeLength) == len(cpList)
+		sequential := true
+		for i, g := range cpList {
+			if g.id != first.id+i {
+				sequential = false
+			}
+			if g.id < cm.GlyphIDStart {
+				cm.GlyphIDStart = g.id
+			}
+		}
+		// FORMAT0_FULL glyph ID offsets are only 8 bits, so blocks that need
+		// glyph ID offsets use the sparse format with 16 bit offsets
+		switch {
+		case contiguous && sequential:
+			cm.Type = "LV_FONT_FMT_TXT_CMAP_FORMAT0_TINY"
+		case sequential:
+			cm.Type = "LV_FONT_FMT_TXT_CMAP_SPARSE_TINY"
+		default:
+			cm.Type = "LV_FONT_FMT_TXT_CMAP_SPARSE_FULL"
+		}
+		for _, g := range cpList {
+			if cm.Type != "LV_FONT_FMT_TXT_CMAP_FORMAT0_TINY" {
+				cm.UnicodeList = append(cm.UnicodeList, int(g.cp-cm.RangeStart))
+			}
+			if !sequential {
+				cm.GlyphIDOfs = append(cm.GlyphIDOfs, g.id-cm.GlyphIDStart)
+			}
+		}
+		cmaps = append(cmaps, cm)
+	}
+	if len(bitmap) >= 1<<20 {
+		return OutputFile{}, &font.SourceError{Context: font.Context{Font: fs.Name},
+			Msg: fmt.Sprintf("LVGL bitmap needs %d bytes, but bitmap indexes are 20-bit", len(bitmap))}
+	}
+	// List the glyphs that no single codepoint can reach
+	var left []string
+	offset = 0
+	for _, p := range rb.Patterns {
+		if _, ok := glyphIDs[offset]; !ok {
+			left = append(left, p.CS.HexCluster+" "+labelForCluster(p.CS.GraphemeCluster()))
+		}
+		offset += len(p.Bytes)
+	}
+	if len(left) > 0 {
+		fmt.Printf("%s: LVGL font leaves out %d grapheme clusters (see %s)\n", fs.Name, len(left), lvglOut)
+	}
+	prefix := cIdentifier(fs.Name) + "_lvgl"
+	context := struct {
+		Font     font.FontSpec
+		Prefix   string
+		Bitmap   string
+		Glyphs   []LVGLGlyph
+		Cmaps    []LVGLCmap
+		BaseLine int
+		Left     []string
+		Kerns    int
+	}{fs, prefix, cCodeForBytes(bitmap), glyphs, cmaps, fs.Size - fs.Ascent, left, len(rb.Kerns)}
+	return OutputFile{lvglOut, renderTemplate(lvglFontTemplate, "lvglfont", context), false}, nil
+}
+
+// Template with C source code for an LVGL font
+const lvglFontTemplate = `// DO NOT MAKE EDITS HERE because this file is automatically generated.
+// To make changes, see guilib/codegen/main.go
+//
+// Copyright (c) 2020 Sam Blenny
+// SPDX-License-Identifier: Apache-2.0 OR MIT
+//
+// NOTE: The copyright notice above applies to the C source code in this file,
+// but not to the bitmap graphics encoded in the bitmap array (see credits).
+//
+// CREDITS:
+{{LineComment .Font.Legal}}
+// {{.Font.Name}} Font for LVGL (declare with LV_FONT_DECLARE({{.Prefix}}))
+{{- if .Kerns}}
+//
+// This font has {{.Kerns}} kerning pairs, but they are not in this file, so
+// kern_dsc is NULL and LVGL draws the glyphs without kerning.
+{{- end}}
+{{- if .Left}}
+//
+// These grapheme clusters are not in this font because LVGL fonts only map
+// single codepoints to glyphs:
+{{- range $_, $l := .Left}}
+//   {{$l}}
+{{- end}}
+{{- end}}
+#ifdef LV_LVGL_H_INCLUDE_SIMPLE
+#include "lvgl.h"
+#else
+#include "lvgl/lvgl.h"
+#endif
+
+// Glyph bitmaps (1 bpp, rows packed without padding, MSB first)
+static LV_ATTRIBUTE_LARGE_CONST const uint8_t glyph_bitmap[] = {
+    {{.Bitmap}}
+};
+
+// Glyph descriptors (glyph ID 0 is reserved)
+static const lv_font_fmt_txt_glyph_dsc_t glyph_dsc[] = {
+    {.bitmap_index = 0, .adv_w = 0, .box_w = 0, .box_h = 0, .ofs_x = 0, .ofs_y = 0},
+{{- range $i, $g := .Glyphs}}{{if $i}}
+    {.bitmap_index = {{$g.BitmapIndex}}, .adv_w = {{$g.AdvW}}, .box_w = {{$g.BoxW}}, .box_h = {{$g.BoxH}}, .ofs_x = {{$g.OfsX}}, .ofs_y = {{$g.OfsY}}}, // {{$g.Label}}
+{{- end}}{{end}}
+};
+{{range $i, $c := .Cmaps}}
+{{- if $c.UnicodeList}}
+// {{$c.Block.Name}} codepoint offsets from 0x{{printf "%X" $c.RangeStart}}
+static const uint16_t unicode_list_{{$i}}[] = {
+    {{$c.UnicodeListCode}}
+};
+{{end}}
+{{- if $c.GlyphIDOfs}}
+// {{$c.Block.Name}} glyph ID offsets from {{$c.GlyphIDStart}}
+static const uint16_t glyph_id_ofs_list_{{$i}}[] = {
+    {{$c.GlyphIDOfsCode}}
+};
+{{end}}
+{{- end}}
+// Character maps, one for each Unicode block
+static const lv_font_fmt_txt_cmap_t cmaps[] = {
+{{- range $i, $c := .Cmaps}}
+    // {{$c.Block.Name}}
+    {
+        .range_start = 0x{{printf "%X" $c.RangeStart}}, .range_length = {{$c.RangeLength}}, .glyph_id_start = {{$c.GlyphIDStart}},
+        .unicode_list = {{if $c.UnicodeList}}unicode_list_{{$i}}{{else}}NULL{{end}}, .glyph_id_ofs_list = {{if $c.GlyphIDOfs}}glyph_id_ofs_list_{{$i}}{{else}}NULL{{end}},
+        .list_length = {{len $c.UnicodeList}}, .type = {{$c.Type}}
+    },
+{{- end}}
+};
+
+#if LVGL_VERSION_MAJOR == 8
+static lv_font_fmt_txt_glyph_cache_t cache;
+#endif
+
+static const lv_font_fmt_txt_dsc_t font_dsc = {
+    .glyph_bitmap = glyph_bitmap,
+    .glyph_dsc = glyph_dsc,
+    .cmaps = cmaps,
+    .kern_dsc = NULL,{{if .Kerns}} // Kerning pairs are left out (see above){{end}}
+    .kern_scale = 0,
+    .cmap_num = {{len .Cmaps}},
+    .bpp = 1,
+    .kern_classes = 0,
+    .bitmap_format = 0,
+#if LVGL_VERSION_MAJOR == 8
+    .cache = &cache,
+#endif
+};
+
+const lv_font_t {{.Prefix}} = {
+    .get_glyph_dsc = lv_font_get_glyph_dsc_fmt_txt,
+    .get_glyph_bitmap = lv_font_get_bitmap_fmt_txt,
+    .line_height = {{.Font.Size}},
+    .base_line = {{.BaseLine}},
+    .subpx = LV_FONT_SUBPX_NONE,
+    .underline_position = -1,
+    .underline_thickness = 1,
+    .dsc = &font_dsc,
+};
+`
@@ -21,10 +21,25 @@ import (
 // Command line switch to confirm intent of writing output files
 const confirm = "--replace-font-files"
 
+// Command line switch to compare generated code against existing files
+const check = "--check"
+
+// Command line switch to render the packed glyph data of each font as a PNG atlas
+const atlas = "--atlas"
+
+// Command line switch to pack a directory of per-glyph PNG files into a sprite sheet
+const pack = "--pack"
+
 // Main: check for confirmation switch before writing files
 func main() {
 	if len(os.Args) == 2 && os.Args[1] == confirm {
 		codegen()
+	} else if len(os.Args) == 2 && os.Args[1] == check {
+		checkFontFiles()
+	} else if len(os.Args) == 3 && os.Args[1] == atlas {
+		writeAtlases(os.Args[2])
+	} else if len(os.Args) >= 2 && os.Args[1] == pack {
+		packCommand(os.Args[2:])
 	} else {
 		usage()
 	}
@@ -36,75 +51,287 @@ const enableDebug = false
 // Path for output files with generated font code
 const outPath = "../src/fonts"
 
-// Index and alias files for grapheme clusters that go with img/emoji48x48_o3x3.png
-const emojiIndex = "img/emoji_13_0_index.txt"
-const emojiAliases = "img/emoji_13_0_aliases.txt"
+// Manifest describing the fonts to generate
+const manifestFile = "fonts.toml"
 
-// Seed for Murmur3 hashes; in the event of hash collisions, change this
+// First seed to try for Murmur3 hashes. When the index for a font has hash
+// collisions, codegen tries the following seeds until it finds one without.
 const Murmur3Seed uint32 = 0
 
-// Spec for how to generate font source code files from glyph grid sprite sheets
-func fonts() []font.FontSpec {
-	return []font.FontSpec{
-		font.FontSpec{"Emoji", "img/emoji_13_0_32x32_o3x3.png", 32, 16, 0, 0, twemoji, "emoji.rs"},
-		font.FontSpec{"Bold", "img/bold.png", 30, 16, 2, 2, chicago, "bold.rs"},
-		font.FontSpec{"Regular", "img/regular.png", 30, 16, 2, 2, geneva, "regular.rs"},
+// How many Murmur3 seeds to try before giving up on finding one without collisions
+const maxSeedTries = 1 << 16
+
+// Generate source code files for fonts
+func codegen() {
+	fonts, err := readManifest(manifestFile)
+	if err != nil {
+		reportErrors(err)
+		os.Exit(1)
+	}
+	// Generate all the fonts before writing anything so that a problem with
+	// one font does not leave the output directory partially updated
+	var errs font.ErrorList
+	var fileLists [][]OutputFile
+	for _, f := range fonts {
+		files, err := genFontFiles(f)
+		errs.Add(err)
+		fileLists = append(fileLists, files)
+	}
+	if errs.Err() != nil {
+		reportErrors(errs)
+		os.Exit(1)
+	}
+	for i, f := range fonts {
+		for _, of := range fileLists[i] {
+			// Write source code to a file
+			fmt.Println("Writing to", of.Path)
+			if err := ioutil.WriteFile(of.Path, []byte(of.Code), 0644); err != nil {
+				errs.Add(&font.FileError{Context: font.Context{Font: f.Spec.Name, File: of.Path}, Err: err})
+			}
+		}
+	}
+	if errs.Err() != nil {
+		reportErrors(errs)
+		os.Exit(1)
 	}
 }
 
-// Generate rust source code files for fonts
-func codegen() {
-	for _, f := range fonts() {
-		var data string
-		switch f.Name {
-		case "Emoji":
-			data = genRustyFontData(f, font.EmojiMap(f, emojiIndex), font.EmojiAliases(emojiAliases))
-		case "Bold":
-			data = genRustyFontData(f, font.SysLatinMap(), font.SysLatinAliases())
-		case "Regular":
-			data = genRustyFontData(f, font.SysLatinMap(), font.SysLatinAliases())
-		default:
-			panic("unexpected FontSpec.Name")
+// Holds generated source code and the path of the file it belongs in
+type OutputFile struct {
+	Path   string
+	Code   string
+	Binary bool // Code holds binary data rather than source code
+}
+
+// Generate all the source code files for a font
+func genFontFiles(f FontEntry) ([]OutputFile, error) {
+	// Keep going after problems with the character map or aliases so that
+	// problems with the sprite sheet get reported too
+	var errs font.ErrorList
+	csList, err := f.CharSpecs()
+	errs.Add(err)
+	aliasList, err := f.GCAliases()
+	errs.Add(err)
+	rb, err := rustyBlitsForFont(f.Spec, csList, aliasList)
+	errs.Add(err)
+	if errs.Err() != nil {
+		return nil, errs
+	}
+	// Keep going after problems with one output format so that problems with
+	// the others get reported too
+	files := []OutputFile{{path.Join(outPath, f.Spec.RustOut), genRustyFontFile(f.Spec, rb), false}}
+	add := func(file OutputFile, err error) {
+		if err != nil {
+			errs.Add(err)
+			return
 		}
-		context := struct {
-			Font    font.FontSpec
-			OutPath string
-			Data    string
-		}{f, outPath, data}
-		// Generate rust source code and write it to a file
-		code := renderTemplate(fontFileTemplate, "font", context)
-		op := path.Join(outPath, f.RustOut)
-		fmt.Println("Writing to", op)
-		ioutil.WriteFile(op, []byte(code), 0644)
+		files = append(files, file)
+	}
+	if f.COut != "" {
+		files = append(files, genCFontFiles(f.Spec, f.COut, rb)...)
+	}
+	if f.GoOut != "" {
+		goFiles, err := genGoFontFiles(f.Spec, f.GoOut, rb)
+		errs.Add(err)
+		files = append(files, goFiles...)
+	}
+	if f.BDFOut != "" {
+		files = append(files, OutputFile{f.BDFOut, font.BDFFromPatterns(f.Spec, rb.Patterns), false})
+	}
+	if f.HexOut != "" {
+		hex, left, err := font.HexFromPatterns(f.Spec, rb.Patterns)
+		if err == nil && len(left) > 0 {
+			fmt.Printf("%s: .hex file leaves out %d grapheme clusters with more than one codepoint\n", f.Spec.Name, len(left))
+		}
+		add(OutputFile{f.HexOut, hex, false}, font.WithContext(err, font.Context{File: f.HexOut}))
+	}
+	if f.GFXOut != "" {
+		add(genGFXFontFile(f.Spec, f.GFXOut, rb))
+	}
+	if f.LVGLOut != "" {
+		add(genLVGLFontFile(f.Spec, f.LVGLOut, rb))
+	}
+	if f.OTFOut != "" {
+		otf, left, err := font.SFNTFromPatterns(f.Spec, rb.Patterns, aliasList)
+		if err == nil && len(left) > 0 {
+			fmt.Printf("%s: OpenType font leaves out %d grapheme clusters that no single codepoint maps to\n", f.Spec.Name, len(left))
+		}
+		add(OutputFile{f.OTFOut, string(otf), true}, err)
+	}
+	if f.HTMLOut != "" {
+		add(genSpecimenFile(f.Spec, f.HTMLOut, rb))
+	}
+	if f.PSFOut != "" {
+		psf, err := font.PSF2FromPatterns(f.Spec, rb.Patterns, aliasList)
+		add(OutputFile{f.PSFOut, string(psf), true}, err)
+	}
+	if f.BlobOut != "" {
+		add(genBlobFile(f.Spec, f.BlobOut, f.BlobOrder, rb))
+	}
+	if errs.Err() != nil {
+		return nil, errs
+	}
+	return files, nil
+}
+
+// Generate rust source code for a complete font file
+func genRustyFontFile(fs font.FontSpec, rb RustyBlits) string {
+	context := struct {
+		Font    font.FontSpec
+		OutPath string
+		Data    string
+	}{fs, outPath, genRustyFontData(fs, rb)}
+	return renderTemplate(fontFileTemplate, "font", context)
+}
+
+// Print an error (or each error of an error list) to stderr
+func reportErrors(err error) {
+	if err == nil {
+		return
+	}
+	fmt.Fprintln(os.Stderr, err)
+	if list, ok := err.(font.ErrorList); ok && len(list) > 1 {
+		fmt.Fprintf(os.Stderr, "%d problems found\n", len(list))
 	}
 }
 
 // Generate rust code for glyph blit pattern data and related grapheme cluster index
-func genRustyFontData(fs font.FontSpec, csList []font.CharSpec, aliasList []font.GCAlias) string {
-	if len(csList) == 0 {
+func genRustyFontData(fs font.FontSpec, rb RustyBlits) string {
+	if rb.DataLen == 0 {
 		return fmt.Sprintf("/* TODO: %s data */", fs.Name)
 	}
-	// Find all the glyphs and pack them into a list of blit pattern objects
-	pl := patternListFromSpriteSheet(fs, csList)
-	// Make rust code for the blit pattern DATA array, plus an index list
-	rb := rustyBlitsFromPatternList(pl)
-	rb.AddAliasesToIndex(aliasList)
 	return renderTemplate(dataTemplate, "data", struct {
 		RB     RustyBlits
 		M3Seed uint32
-	}{rb, 0})
+		Verify bool
+		Bits   int
+	}{rb, rb.Seed, fs.Verify, fs.Bits})
+}
+
+// Find the glyphs for a font, pack them into blit patterns, and build the
+// grapheme cluster index using a Murmur3 seed that avoids hash collisions
+func rustyBlitsForFont(fs font.FontSpec, csList []font.CharSpec, aliasList []font.GCAlias) (RustyBlits, error) {
+	if len(csList) == 0 && !fs.HasFontFile() {
+		return RustyBlits{"", 0, FontIndex{}, Murmur3Seed, nil, nil, nil}, nil
+	}
+	ctx := font.Context{Font: fs.Name}
+	if err := font.CheckCharSpecs(csList); err != nil {
+		return RustyBlits{}, font.WithContext(err, ctx)
+	}
+	// Find all the glyphs and pack them into a list of blit pattern objects.
+	// Glyphs with problems are left out so the aliases still get checked.
+	var errs font.ErrorList
+	var pl []font.BlitPattern
+	var err error
+	if fs.HasFontFile() {
+		pl, err = patternListFromFontFile(fs)
+	} else {
+		pl, err = patternListFromSpriteSheet(fs, csList)
+	}
+	errs.Add(err)
+	if fs.Metrics != "" {
+		overrides, err := font.ReadMetricsFile(fs)
+		errs.Add(err)
+		errs.Add(font.ApplyMetricsOverrides(fs, pl, overrides))
+	}
+	// Make rust code for the blit pattern DATA array, plus an index list
+	rb, err := rustyBlitsFromPatternList(pl)
+	errs.Add(font.WithContext(err, ctx))
+	errs.Add(font.WithContext(rb.AddAliasesToIndex(aliasList), ctx))
+	if errs.Err() != nil {
+		return RustyBlits{}, errs
+	}
+	// Add kerning pairs now that the aliases are in the index, so that pairs
+	// can name aliases
+	pairs, err := kernPairsForFont(fs, pl)
+	errs.Add(err)
+	errs.Add(rb.AddKerning(fs, pairs))
+	if errs.Err() != nil {
+		return RustyBlits{}, errs
+	}
+	// Make sure the binary search in each block can't confuse two clusters
+	tries, err := rb.FindCollisionFreeSeed()
+	if err != nil {
+		return RustyBlits{}, font.WithContext(err, ctx)
+	}
+	fmt.Printf("%s: murmur3 seed %d has no hash collisions (seeds tried: %d)\n", fs.Name, rb.Seed, tries)
+	reportClusterTableSize(fs, rb)
+	return rb, nil
+}
+
+// Print how many bytes the cluster verification tables take (or would take)
+func reportClusterTableSize(fs font.FontSpec, rb RustyBlits) {
+	size := 0
+	for _, dex := range rb.Index {
+		size += dex.ClusterTableSize()
+	}
+	if fs.Verify {
+		fmt.Printf("%s: cluster verification tables use %d bytes\n", fs.Name, size)
+	} else {
+		fmt.Printf("%s: cluster verification is off (would use %d bytes)\n", fs.Name, size)
+	}
+}
+
+// Return the kerning pairs for a font. Pairs from the kerning file come after
+// derived pairs, so they take the place of derived pairs for the same glyphs.
+func kernPairsForFont(fs font.FontSpec, pl []font.BlitPattern) ([]font.KernPair, error) {
+	var pairs []font.KernPair
+	if fs.Kerning == "auto" {
+		pairs = font.AutoKerning(fs, pl)
+	}
+	if fs.KernFile == "" {
+		return pairs, nil
+	}
+	filePairs, err := font.ReadKerningFile(fs)
+	return append(pairs, filePairs...), err
 }
 
 // Extract glyph sprites from a PNG grid and pack them into a list of blit pattern objects
-func patternListFromSpriteSheet(fs font.FontSpec, csList []font.CharSpec) []font.BlitPattern {
+func patternListFromSpriteSheet(fs font.FontSpec, csList []font.CharSpec) ([]font.BlitPattern, error) {
 	// Read glyphs from png file
-	img := readPNGFile(fs.Sprites)
+	img, err := readPNGFile(fs.Sprites)
+	if err != nil {
+		return nil, font.WithContext(err, font.Context{Font: fs.Name})
+	}
+	var errs font.ErrorList
 	var patternList []font.BlitPattern
 	for _, cs := range csList {
-		blitPattern := font.ConvertGlyphToBlitPattern(img, fs, cs, enableDebug)
+		blitPattern, err := font.ConvertGlyphToBlitPattern(img, fs, cs, enableDebug)
+		if err != nil {
+			errs.Add(err)
+			continue
+		}
 		patternList = append(patternList, blitPattern)
 	}
-	return patternList
+	return patternList, errs.Err()
+}
+
+// Read glyphs from a BDF or Unifont .hex font file, or a directory of per-glyph
+// PNG files, and pack them into a list of blit pattern objects. Glyphs outside of the known Unicode blocks get left out.
+func patternListFromFontFile(fs font.FontSpec) ([]font.BlitPattern, error) {
+	var pl []font.BlitPattern
+	var err error
+	switch {
+	case fs.BDF != "":
+		pl, err = font.BDFPatterns(fs, enableDebug)
+	case fs.Hex != "":
+		pl, err = font.HexPatterns(fs, enableDebug)
+	default:
+		pl, err = font.GlyphDirPatterns(fs, enableDebug)
+	}
+	var patternList []font.BlitPattern
+	skipped := 0
+	for _, p := range pl {
+		if _, err := font.Block(p.CS.FirstCodepoint()); err != nil {
+			skipped++
+			continue
+		}
+		patternList = append(patternList, p)
+	}
+	if skipped > 0 {
+		fmt.Printf("%s: skipped %d glyphs outside of the known Unicode blocks\n", fs.Name, skipped)
+	}
+	return patternList, err
 }
 
 // Make rust source code and an index list from a list of glyph blit patterns.
@@ -112,38 +339,45 @@ func patternListFromSpriteSheet(fs font.FontSpec, csList []font.CharSpec) []font
 // of concatenated blit patterns is in the return values's .Code. The length (n)
 // of the `DATA: [u32; n]...` blit pattern array is in .DataLen, and the
 // ClusterOffsetEntry{...} index entries are in .Index.
-func rustyBlitsFromPatternList(pl []font.BlitPattern) RustyBlits {
-	rb := RustyBlits{"", 0, FontIndex{}}
+func rustyBlitsFromPatternList(pl []font.BlitPattern) (RustyBlits, error) {
+	rb := RustyBlits{"", 0, FontIndex{}, Murmur3Seed, nil, nil, nil}
+	var errs font.ErrorList
 	for _, p := range pl {
+		block, err := font.Block(p.CS.FirstCodepoint())
+		if err != nil {
+			errs.Add(err)
+			continue
+		}
 		label := labelForCluster(p.CS.GraphemeCluster())
 		comment := fmt.Sprintf("[%d]: %s %s", rb.DataLen, p.CS.HexCluster, label)
 		rb.Code += font.ConvertPatternToRust(p, comment)
 		// Update the block index with the correct offset (DATA[n]) for pattern header
 		indexEntry := ClusterOffsetEntry{
-			murmur3(p.CS.GraphemeCluster(), Murmur3Seed),
+			murmur3(p.CS.GraphemeCluster(), rb.Seed),
 			p.CS.GraphemeCluster(),
 			rb.DataLen,
 		}
-		block := font.Block(p.CS.FirstCodepoint())
 		rb.Index[block] = append(rb.Index[block], indexEntry)
+		rb.Data = append(rb.Data, p.Bytes...)
+		rb.Patterns = append(rb.Patterns, p)
 		rb.DataLen += len(p.Bytes)
 	}
 	rb.SortIndex()
-	return rb
+	return rb, errs.Err()
 }
 
 // Read the specified PNG file and convert its data into an image object
-func readPNGFile(name string) image.Image {
+func readPNGFile(name string) (image.Image, error) {
 	pngFile, err := os.Open(name)
 	if err != nil {
-		panic("unable to open png file")
+		return nil, &font.FileError{Context: font.Context{File: name}, Err: err}
 	}
+	defer pngFile.Close()
 	img, err := png.Decode(pngFile)
 	if err != nil {
-		panic("unable to decode png file")
+		return nil, &font.FileError{Context: font.Context{File: name}, Err: err}
 	}
-	pngFile.Close()
-	return img
+	return img, nil
 }
 
 // Make label for grapheme cluster with special handling for UI sprites in PUA block
@@ -222,9 +456,21 @@ func murmur3(key string, seed uint32) uint32 {
 
 // Holds an index list and rust source code for a font's worth of blit patterns
 type RustyBlits struct {
-	Code    string
-	DataLen int
-	Index   FontIndex
+	Code     string
+	DataLen  int
+	Index    FontIndex
+	Seed     uint32             // Murmur3 seed for the M3Hash values in Index
+	Data     []uint32           // Blit pattern words, the same as in Code
+	Patterns []font.BlitPattern // Glyphs in the same order as in Data
+	Kerns    []KernEntry        // Kerning pairs, sorted by Left then Right
+}
+
+// A kerning table entry for a pair of blit patterns
+type KernEntry struct {
+	Left   int // DATA offset of the left glyph
+	Right  int // DATA offset of the right glyph
+	Adjust int // Px to add to the advance of the left glyph
+	Label  string
 }
 
 // Index for all the Unicode blocks in a font
@@ -241,21 +487,31 @@ type ClusterOffsetEntry struct {
 }
 
 // Add a list of grapheme cluster aliases to a RustyBlits.Index FontIndex
-func (rb RustyBlits) AddAliasesToIndex(aliasList []font.GCAlias) {
+func (rb RustyBlits) AddAliasesToIndex(aliasList []font.GCAlias) error {
+	var errs font.ErrorList
 	for _, gcAlias := range aliasList {
+		ctx := font.Context{File: gcAlias.File, Line: gcAlias.Line}
 		// Find the glyph pattern data offset for the cannonical grapheme cluster
-		canonUtf8Cluster := font.StringFromHexGC(gcAlias.CanonHex)
-		firstCodepoint := uint32([]rune(canonUtf8Cluster)[0])
-		block := font.Block(firstCodepoint)
-		glyphDataOffset := rb.FindDataOffset(block, canonUtf8Cluster)
+		canonUtf8Cluster, block, err := clusterAndBlock(gcAlias.CanonHex)
+		if err != nil {
+			errs.Add(font.WithContext(err, ctx))
+			continue
+		}
+		glyphDataOffset, err := rb.FindDataOffset(block, canonUtf8Cluster)
+		if err != nil {
+			errs.Add(font.WithContext(&font.MissingCanonError{CanonHex: gcAlias.CanonHex, AliasHex: gcAlias.AliasHex}, ctx))
+			continue
+		}
 		// Add entry for alias grapheme cluster using same data offset.
 		// Important note: the Unicode block for the first codepoint of
 		// a Form C vs. Form D normalization may be *different*!
-		aliasUtf8Cluster := font.StringFromHexGC(gcAlias.AliasHex)
-		firstCodepoint = uint32([]rune(aliasUtf8Cluster)[0])
-		block = font.Block(firstCodepoint)
+		aliasUtf8Cluster, block, err := clusterAndBlock(gcAlias.AliasHex)
+		if err != nil {
+			errs.Add(font.WithContext(err, ctx))
+			continue
+		}
 		aliasEntry := ClusterOffsetEntry{
-			murmur3(aliasUtf8Cluster, Murmur3Seed),
+			murmur3(aliasUtf8Cluster, rb.Seed),
 			aliasUtf8Cluster,
 			glyphDataOffset,
 		}
@@ -265,20 +521,168 @@ func (rb RustyBlits) AddAliasesToIndex(aliasList []font.GCAlias) {
 		rb.Index[block] = append(rb.Index[block], aliasEntry)
 		rb.SortIndex()
 	}
+	return errs.Err()
+}
+
+// Add kerning pairs to the kerning table of a RustyBlits. Clusters are looked
+// up in the index, so pairs can name aliases. A later pair for the same glyphs
+// takes the place of an earlier one, and pairs that end up with no adjustment
+// are left out.
+func (rb *RustyBlits) AddKerning(fs font.FontSpec, pairs []font.KernPair) error {
+	var errs font.ErrorList
+	adjust := map[[2]int]int{}
+	labels := map[[2]int]string{}
+	for _, pair := range pairs {
+		var key [2]int
+		var names []string
+		for n, hexGC := range []string{pair.LeftHex, pair.RightHex} {
+			cluster, block, err := clusterAndBlock(hexGC)
+			if err == nil {
+				key[n], err = rb.FindDataOffset(block, cluster)
+			}
+			if err != nil {
+				errs.Add(font.WithContext(err, font.Context{Font: fs.Name, File: fs.KernFile, Line: pair.Line}))
+				continue
+			}
+			names = append(names, labelForCluster(cluster))
+		}
+		if len(names) == 2 {
+			adjust[key] = pair.Adjust
+			labels[key] = strings.Join(names, " ")
+		}
+	}
+	rb.Kerns = nil
+	for key, a := range adjust {
+		if a != 0 {
+			rb.Kerns = append(rb.Kerns, KernEntry{key[0], key[1], a, labels[key]})
+		}
+	}
+	sort.Slice(rb.Kerns, func(i, j int) bool {
+		if rb.Kerns[i].Left != rb.Kerns[j].Left {
+			return rb.Kerns[i].Left < rb.Kerns[j].Left
+		}
+		return rb.Kerns[i].Right < rb.Kerns[j].Right
+	})
+	return errs.Err()
+}
+
+// Return how many bits to shift the left offset of a kerning table key, which
+// packs both offsets into one integer. Fonts with more than 64K words of DATA
+// need 64-bit keys.
+func (rb RustyBlits) KernKeyShift() int {
+	if rb.DataLen <= 0x10000 {
+		return 16
+	}
+	return 32
+}
+
+// Return the rust integer type of kerning table keys
+func (rb RustyBlits) KernKeyType() string {
+	if rb.KernKeyShift() == 16 {
+		return "u32"
+	}
+	return "u64"
+}
+
+// Format the inner elements of the table of kerning keys
+func (rb RustyBlits) RustCodeForKernKeys() string {
+	var rustCode []string
+	for _, k := range rb.Kerns {
+		key := uint64(k.Left)<<uint(rb.KernKeyShift()) | uint64(k.Right)
+		digits := rb.KernKeyShift() / 2
+		rustCode = append(rustCode, fmt.Sprintf("0x%0*X,  // %s", digits, key, k.Label))
+	}
+	return strings.Join(rustCode, "\n    ")
+}
+
+// Format the inner elements of the table of kerning adjustments
+func (rb RustyBlits) RustCodeForKernAdjustments() string {
+	var rustCode []string
+	for _, k := range rb.Kerns {
+		rustCode = append(rustCode, fmt.Sprintf("%-5s // %s", fmt.Sprintf("%d,", k.Adjust), k.Label))
+	}
+	return strings.Join(rustCode, "\n    ")
 }
 
-// Find data offset for the grapheme cluster in a RustyBlits.index, or panic
-func (rb RustyBlits) FindDataOffset(block font.UBlock, utf8Cluster string) int {
+// Parse a hex grapheme cluster and find the Unicode block of its first codepoint
+func clusterAndBlock(hexGC string) (string, font.UBlock, error) {
+	utf8Cluster, err := font.StringFromHexGC(hexGC)
+	if err != nil {
+		return "", font.UBlock{}, err
+	}
+	block, err := font.Block(uint32([]rune(utf8Cluster)[0]))
+	return utf8Cluster, block, err
+}
+
+// Find data offset for the grapheme cluster in a RustyBlits.index
+func (rb RustyBlits) FindDataOffset(block font.UBlock, utf8Cluster string) (int, error) {
 	dex := rb.Index[block]
-	hash := murmur3(utf8Cluster, Murmur3Seed)
+	hash := murmur3(utf8Cluster, rb.Seed)
 	n := sort.Search(len(dex), func(i int) bool { return dex[i].M3Hash >= hash })
-	if n == len(dex) || dex[n].M3Hash != hash {
-		fmt.Printf("\nblock: %X..%X %s\n", block.Low, block.High, block.Name)
-		fmt.Printf("rb.Index[block]:\n%+q\n", rb.Index[block])
-		fmt.Printf("n := Search(key):\n  index[n]: %v,  key: %q\n", dex[n].M3Hash, utf8Cluster)
-		panic(fmt.Errorf("Grapheme cluster %q was not in rb.Index[block]", utf8Cluster))
+	// Check every entry with a matching hash in case of collisions
+	for ; n < len(dex) && dex[n].M3Hash == hash; n++ {
+		if dex[n].Cluster == utf8Cluster {
+			return dex[n].DataOffset, nil
+		}
+	}
+	return 0, &font.MissingGlyphError{Cluster: utf8Cluster, Block: block.Name}
+}
+
+// Return a list of hash collisions between distinct grapheme clusters that
+// are in the same Unicode block, or nil if there are none.
+// Precondition: index is sorted (see SortIndex)
+func (rb RustyBlits) HashCollisions() []*font.HashCollisionError {
+	var collisions []*font.HashCollisionError
+	for _, block := range rb.IndexKeys() {
+		dex := rb.Index[block]
+		for i := 1; i < len(dex); i++ {
+			if dex[i].M3Hash == dex[i-1].M3Hash && dex[i].Cluster != dex[i-1].Cluster {
+				collisions = append(collisions, &font.HashCollisionError{
+					Block:    block.Name,
+					Seed:     rb.Seed,
+					Hash:     dex[i].M3Hash,
+					Clusters: [2]string{dex[i-1].Cluster, dex[i].Cluster},
+				})
+			}
+		}
+	}
+	return collisions
+}
+
+// Recompute the hashes in the index using a different Murmur3 seed
+func (rb *RustyBlits) Rehash(seed uint32) {
+	rb.Seed = seed
+	for _, dex := range rb.Index {
+		for i := range dex {
+			dex[i].M3Hash = murmur3(dex[i].Cluster, seed)
+		}
+	}
+	rb.SortIndex()
+}
+
+// Starting with the current seed, search for a Murmur3 seed that gives an
+// index without hash collisions in any block. Return how many seeds were tried.
+// If no seed works, the index keeps its first seed and the returned
+// SeedSearchError has the range of seeds and the collisions for the first seed.
+func (rb *RustyBlits) FindCollisionFreeSeed() (int, error) {
+	firstSeed := rb.Seed
+	firstCollisions := rb.HashCollisions()
+	if firstCollisions == nil {
+		return 1, nil
+	}
+	for tries := 2; tries <= maxSeedTries; tries++ {
+		rb.Rehash(firstSeed + uint32(tries-1))
+		if rb.HashCollisions() == nil {
+			return tries, nil
+		}
+	}
+	// Give up and report the collisions for the first seed
+	rb.Rehash(firstSeed)
+	return maxSeedTries, &font.SeedSearchError{
+		FirstSeed:  firstSeed,
+		LastSeed:   firstSeed + uint32(maxSeedTries-1),
+		Collisions: firstCollisions,
 	}
-	return dex[n].DataOffset
 }
 
 // Sort the index for each Unicode block of a RustyBlits.Index FontIndex
@@ -319,6 +723,110 @@ func (coIndex BlockIndex) RustCodeForOffsets() string {
 	return strings.Join(rustCode, "\n    ")
 }
 
+// Format the inner elements of a [u32; n] table of cluster codepoints for one block
+func (coIndex BlockIndex) RustCodeForClusters() string {
+	var rustCode []string
+	for _, entry := range coIndex {
+		var codepoints []string
+		for _, r := range entry.Cluster {
+			codepoints = append(codepoints, fmt.Sprintf("0x%X,", uint32(r)))
+		}
+		label := labelForCluster(entry.Cluster)
+		rustCode = append(rustCode, fmt.Sprintf("%-8s // %s", strings.Join(codepoints, " "), label))
+	}
+	return strings.Join(rustCode, "\n    ")
+}
+
+// Format the inner elements of the table of cluster start positions for one block.
+// The table has one more element than the index to mark the end of the last cluster.
+func (coIndex BlockIndex) RustCodeForClusterStarts() string {
+	var rustCode []string
+	start := 0
+	for _, entry := range coIndex {
+		label := labelForCluster(entry.Cluster)
+		rustCode = append(rustCode, fmt.Sprintf("%-6s // %s", fmt.Sprintf("%d,", start), label))
+		start += len([]rune(entry.Cluster))
+	}
+	rustCode = append(rustCode, fmt.Sprintf("%d,", start))
+	return strings.Join(rustCode, "\n    ")
+}
+
+// Return true if a glyph has metrics other than the default ones for the width
+// of its blit pattern, so it needs a record in the table of glyph metrics
+func hasMetricsRecord(p font.BlitPattern) bool {
+	w := int((p.Bytes[0] >> 16) & 0xff)
+	return p.Metrics != font.DefaultMetrics(w)
+}
+
+// Return the number of records in the table of glyph metrics. Glyphs with the
+// default metrics for their pattern width get no record.
+func (rb RustyBlits) MetricsCount() int {
+	n := 0
+	for _, p := range rb.Patterns {
+		if hasMetricsRecord(p) {
+			n++
+		}
+	}
+	return n
+}
+
+// Format the inner elements of the table of blit pattern offsets for glyph
+// metrics, in DATA order
+func (rb RustyBlits) RustCodeForMetricsOffsets() string {
+	var rustCode []string
+	offset := 0
+	for _, p := range rb.Patterns {
+		if hasMetricsRecord(p) {
+			label := labelForCluster(p.CS.GraphemeCluster())
+			rustCode = append(rustCode, fmt.Sprintf("%-5s // %s", fmt.Sprintf("%d,", offset), label))
+		}
+		offset += len(p.Bytes)
+	}
+	return strings.Join(rustCode, "\n    ")
+}
+
+// Format the inner elements of the [u32; n] table of glyph metrics, in DATA
+// order. Words are packed as ((advance as u16) << 16) | (bearing as i16 as u16).
+func (rb RustyBlits) RustCodeForMetrics() string {
+	var rustCode []string
+	for _, p := range rb.Patterns {
+		if !hasMetricsRecord(p) {
+			continue
+		}
+		m := p.Metrics
+		word := uint32(m.Advance)<<16 | uint32(uint16(int16(m.Bearing)))
+		label := labelForCluster(p.CS.GraphemeCluster())
+		rustCode = append(rustCode, fmt.Sprintf("0x%08X,  // %s", word, label))
+	}
+	return strings.Join(rustCode, "\n    ")
+}
+
+// Return the total number of codepoints in all the clusters of a block
+func (coIndex BlockIndex) ClusterCodepoints() int {
+	n := 0
+	for _, entry := range coIndex {
+		n += len([]rune(entry.Cluster))
+	}
+	return n
+}
+
+// Return the smallest rust integer type that can hold cluster start positions
+func (coIndex BlockIndex) ClusterStartType() string {
+	if coIndex.ClusterCodepoints() <= 0xFFFF {
+		return "u16"
+	}
+	return "u32"
+}
+
+// Return the size in bytes of the cluster verification tables for one block
+func (coIndex BlockIndex) ClusterTableSize() int {
+	startSize := 2
+	if coIndex.ClusterStartType() == "u32" {
+		startSize = 4
+	}
+	return coIndex.ClusterCodepoints()*4 + (len(coIndex)+1)*startSize
+}
+
 // Make a grapheme cluster length list for a BlockIndex. The point of this is to
 // facilitate efficient greedy matching. For example, when the index for a block
 // has grapheme clusters of length 1 or 5 codepoints long, the grapheme cluster
@@ -341,18 +849,24 @@ func (bDex BlockIndex) ClusterLengthList() []int {
 
 // Print usage message
 func usage() {
+	fonts, err := readManifest(manifestFile)
 	context := struct {
-		Confirm string
-		OutPath string
-		Fonts   []font.FontSpec
-	}{confirm, outPath, fonts()}
+		Confirm  string
+		Check    string
+		Atlas    string
+		Pack     string
+		OutPath  string
+		Manifest string
+		Fonts    []FontEntry
+	}{confirm, check, atlas, pack, outPath, manifestFile, fonts}
 	s := renderTemplate(usageTemplate, "usage", context)
 	fmt.Println(s)
+	reportErrors(err)
 }
 
 // Return a string from rendering the given template and context data
 func renderTemplate(templateString string, name string, context interface{}) string {
-	fmap := template.FuncMap{"ToLower": strings.ToLower}
+	fmap := template.FuncMap{"ToLower": strings.ToLower, "LineComment": lineComment}
 	t := template.Must(template.New(name).Funcs(fmap).Parse(templateString))
 	var buf bytes.Buffer
 	err := t.Execute(&buf, context)
@@ -362,16 +876,33 @@ func renderTemplate(templateString string, name string, context interface{}) str
 	return buf.String()
 }
 
+// Format a block of text as `//` line comments, ending with an empty comment line
+func lineComment(text string) string {
+	var lines []string
+	for _, line := range strings.Split(text, "\n") {
+		lines = append(lines, strings.TrimRight("// "+line, " "))
+	}
+	return strings.Join(append(lines, "//"), "\n")
+}
+
 // Template with usage instructions for this command line tool
 const usageTemplate = `
 This tool generates fonts in the form of rust source code.
 To confirm that you want to write the files, use the {{.Confirm}} switch.
+To check that the files match what would be generated, use the {{.Check}} switch.
+To render the packed glyph data of each font into a PNG atlas, use the
+{{.Atlas}} switch with an output directory.
+To pack a directory of per-glyph PNG files (named by hex grapheme cluster) into
+a sprite sheet and a matching index file, use the {{.Pack}} switch.
 
-Font files that will be generated:{{range $f := .Fonts}}
-  {{$.OutPath}}/{{$f.RustOut}}{{end}}
+Font files that will be generated (see {{.Manifest}}):{{range $f := .Fonts}}
+  {{$.OutPath}}/{{$f.Spec.RustOut}}{{end}}
 
 Usage:
-    go run main.go {{.Confirm}}
+    go run . {{.Confirm}}
+    go run . {{.Check}}
+    go run . {{.Atlas}} <dir>
+    go run . {{.Pack}} -size n [-width n] [-cols n] [-gutter n] [-border n] <glyph_dir> <sprites.png> <index.txt>
 `
 
 // Template with rust source code for a outer structure of a font file
@@ -385,7 +916,7 @@ const fontFileTemplate = `// DO NOT MAKE EDITS HERE because this file is automat
 // file, but not to the bitmap graphics encoded in the DATA array (see credits).
 //
 // CREDITS:
-{{.Font.Legal}}
+{{LineComment .Font.Legal}}
 //! {{.Font.Name}} Font
 #![forbid(unsafe_code)]
 #![allow(dead_code)]
@@ -394,6 +925,13 @@ const fontFileTemplate = `// DO NOT MAKE EDITS HERE because this file is automat
 /// This will be true: h + y_offset <= MAX_HEIGHT
 pub const MAX_HEIGHT: u8 = {{.Font.Size}};
 
+/// Distance in pixels from the top of the line down to the baseline
+pub const ASCENT: u8 = {{.Font.Ascent}};
+
+/// Distance in pixels from the baseline down to the bottom of the line.
+/// This will be true: ASCENT + DESCENT == MAX_HEIGHT
+pub const DESCENT: u8 = {{.Font.Descent}};
+
 {{.Data}}
 `
 
@@ -435,6 +973,24 @@ pub fn get_blit_pattern_offset(cluster: &str) -> Result<(usize, usize), super::G
 {{- with $dex := index $.RB.Index $k -}}
 /// Use binary search on table of grapheme cluster hashes to find blit pattern for grapheme cluster.
 /// Only attempt to match grapheme clusters of length limit codepoints.
+{{- if $.Verify}}
+/// Matches are confirmed against CLUSTER_{{$k.Name}} so a cluster with a colliding hash is not found.
+fn find_{{ToLower $k.Name}}(cluster: &str, limit: u32) -> Option<(usize, usize)> {
+    let (key, bytes_hashed) = super::murmur3(cluster, M3_SEED, limit);
+    match HASH_{{$k.Name}}.binary_search(&key) {
+        Ok(index) => {
+            let start = CLUSTER_START_{{$k.Name}}[index] as usize;
+            let end = CLUSTER_START_{{$k.Name}}[index + 1] as usize;
+            if super::cluster_matches(&cluster[..bytes_hashed], &CLUSTER_{{$k.Name}}[start..end]) {
+                Some((OFFSET_{{$k.Name}}[index], bytes_hashed))
+            } else {
+                None
+            }
+        }
+        _ => None,
+    }
+}
+{{- else}}
 fn find_{{ToLower $k.Name}}(cluster: &str, limit: u32) -> Option<(usize, usize)> {
     let (key, bytes_hashed) = super::murmur3(cluster, M3_SEED, limit);
     match HASH_{{$k.Name}}.binary_search(&key) {
@@ -442,6 +998,7 @@ fn find_{{ToLower $k.Name}}(cluster: &str, limit: u32) -> Option<(usize, usize)>
         _ => None,
     }
 }
+{{- end}}
 
 /// Index of murmur3(grapheme cluster); sort matches OFFSET_{{$k.Name}}
 const HASH_{{$k.Name}}: [u32; {{len $dex}}] = [
@@ -452,47 +1009,100 @@ const HASH_{{$k.Name}}: [u32; {{len $dex}}] = [
 const OFFSET_{{$k.Name}}: [usize; {{len $dex}}] = [
     {{$dex.RustCodeForOffsets}}
 ];
+{{- if $.Verify}}
+
+/// Codepoints of each grapheme cluster, packed end to end; sort matches HASH_{{$k.Name}}
+const CLUSTER_{{$k.Name}}: [u32; {{$dex.ClusterCodepoints}}] = [
+    {{$dex.RustCodeForClusters}}
+];
+
+/// Start of each cluster in CLUSTER_{{$k.Name}}, plus end of the last one; sort matches HASH_{{$k.Name}}
+const CLUSTER_START_{{$k.Name}}: [{{$dex.ClusterStartType}}; {{len $dex}} + 1] = [
+    {{$dex.RustCodeForClusterStarts}}
+];
+{{- end}}
 
 {{ end -}}
 {{- end -}}
 
+/// Return Some((advance, left bearing)) in pixels for the glyph with its blit
+/// pattern at DATA[offset]. The left edge of the pattern goes left bearing
+/// pixels right of the pen position (negative is to the left), and the pen
+/// moves advance pixels to the right after drawing the glyph. Glyphs without a
+/// record in METRICS get the spacing of blit.rs: a left bearing of 1, and an
+/// advance of the pattern width plus 3. Return None if offset is past the end
+/// of DATA.
+pub fn get_glyph_metrics(offset: usize) -> Option<(usize, isize)> {
+{{- if .RB.MetricsCount}}
+    if let Ok(index) = METRICS_OFFSET.binary_search(&offset) {
+        let m = METRICS[index];
+        return Some(((m >> 16) as usize, m as u16 as i16 as isize));
+    }
+{{- end}}
+    DATA.get(offset).map(|header| (((header >> 16) & 0xff) as usize + 3, 1))
+}
+{{- if .RB.MetricsCount}}
+
+/// Lookup table of blit pattern offsets in DATA order, for glyphs that do not
+/// have the default metrics; sort matches METRICS
+const METRICS_OFFSET: [usize; {{.RB.MetricsCount}}] = [
+    {{.RB.RustCodeForMetricsOffsets}}
+];
+
+/// Glyph metrics; sort matches METRICS_OFFSET.
+/// Record format: ((advance as u16) << 16) | (left bearing as i16 as u16)
+const METRICS: [u32; {{.RB.MetricsCount}}] = [
+    {{.RB.RustCodeForMetrics}}
+];
+{{- end}}
+
+/// Return the kerning adjustment in pixels to add to the advance of the glyph
+/// with its blit pattern at DATA[left] when the glyph at DATA[right] follows it.
+/// Negative adjustments move the glyphs closer together.
+{{- if .RB.Kerns}}
+pub fn get_kerning(left: usize, right: usize) -> isize {
+    let key = ((left as {{.RB.KernKeyType}}) << {{.RB.KernKeyShift}}) | right as {{.RB.KernKeyType}};
+    match KERN_KEY.binary_search(&key) {
+        Ok(index) => KERN_ADJUST[index] as isize,
+        _ => 0,
+    }
+}
+
+/// Kerning pairs as ((left DATA offset) << {{.RB.KernKeyShift}}) | (right DATA offset), sorted
+const KERN_KEY: [{{.RB.KernKeyType}}; {{len .RB.Kerns}}] = [
+    {{.RB.RustCodeForKernKeys}}
+];
+
+/// Kerning adjustments in pixels; sort matches KERN_KEY
+const KERN_ADJUST: [i8; {{len .RB.Kerns}}] = [
+    {{.RB.RustCodeForKernAdjustments}}
+];
+{{- else}}
+/// This font has no kerning pairs.
+pub fn get_kerning(_left: usize, _right: usize) -> isize {
+    0
+}
+{{- end}}
+
 /// Packed glyph pattern data.
 /// Record format:
+{{- if eq .Bits 2}}
+///  [offset+0]: (1 << 24) | ((w as u8) << 16) | ((h as u8) << 8) | (yOffset as u8)
+///  [offset+1..=ceil(w*h/16)]: packed 2-bit coverage values; 0=clear,
+///     1=1/3 ink, 2=2/3 ink, 3=full ink
+/// Pixels are packed in top to bottom, left to right order with the 2 MSBs of
+/// the first pixel word containing the top left pixel. The top byte of the
+/// header is the pattern format, which is 1 for 2-bit coverage values, or 0
+/// (like in fonts with 1-bit patterns) for 1-bit pixels.
+{{- else}}
 ///  [offset+0]: ((w as u8) << 16) | ((h as u8) << 8) | (yOffset as u8)
 ///  [offset+1..=ceil(w*h/32)]: packed 1-bit pixels; 0=clear, 1=set
 /// Pixels are packed in top to bottom, left to right order with MSB of first
 /// pixel word containing the top left pixel.
+{{- end}}
 ///  w: Width of pattern in pixels
 ///  h: Height of pattern in pixels
 ///  yOffset: Vertical offset (pixels downward from top of line) to position
 ///     glyph pattern properly relative to text baseline
 pub const DATA: [u32; {{.RB.DataLen}}] = [
 {{.RB.Code}}];`
-
-// Emoji graphics legal notice
-const twemoji = `// This code includes encoded bitmaps with modified versions of graphics from
-// the twemoji project. The modified emoji PNG files were converted from color
-// PNG format to monochrome PNG with dithered grayscale shading.
-//
-// - Twemoji License Notice
-//   > Copyright 2019 Twitter, Inc and other contributors
-//   >
-//   > Code licensed under the MIT License: http://opensource.org/licenses/MIT
-//   >
-//   > Graphics licensed under CC-BY 4.0: https://creativecommons.org/licenses/by/4.0/
-//
-// - Twemoji Source Code Link:
-//   https://github.com/twitter/twemoji
-//`
-
-// Bold font legal notice
-const chicago = `// This code includes encoded bitmaps of glyphs from the Chicago typeface which
-// was designed by Susan Kare and released by Apple in 1984. Chicago is a
-// registered trademark of Apple Inc.
-//`
-
-// Regular font legal notice
-const geneva = `// This code includes encoded bitmaps of glyphs from the Geneva typeface which
-// was designed by Susan Kare and released by Apple in 1984. Geneva is a
-// registered trademark of Apple Inc.
-//`
@@ -0,0 +1,40 @@
+// Copyright (c) 2020 Sam Blenny
+// SPDX-License-Identifier: Apache-2.0 OR MIT
+//
+package main
+
+import (
+	"testing"
+
+	"guilib/codegen/font"
+)
+
+func TestAddKerningErrors(t *testing.T) {
+	pl := []font.BlitPattern{
+		{Bytes: []uint32{0x00010100, 0x80000000}, CS: font.CharSpec{HexCluster: "41"}},
+		{Bytes: []uint32{0x00010100, 0x80000000}, CS: font.CharSpec{HexCluster: "56"}},
+	}
+	rb, err := rustyBlitsFromPatternList(pl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if offset, err := rb.FindDataOffset(font.KnownBlocks()[0], "V"); err != nil || offset != 2 {
+		t.Errorf("FindDataOffset(\"V\") = %d, %v, expected 2, nil", offset, err)
+	}
+	fs := font.FontSpec{Name: "Test", KernFile: "kerning.txt"}
+	err = rb.AddKerning(fs, []font.KernPair{
+		{LeftHex: "41", RightHex: "56", Adjust: -1, Line: 1},
+		{LeftHex: "41", RightHex: "57", Adjust: -1, Line: 2},
+	})
+	want := "kerning.txt:2: Test: font has no glyph for grapheme cluster \"W\" in block BASIC_LATIN"
+	list, ok := err.(font.ErrorList)
+	if !ok || len(list) != 1 || list[0].Error() != want {
+		t.Fatalf("got error:\n%v\nexpected:\n%s", err, want)
+	}
+	if _, ok := list[0].(*font.MissingGlyphError); !ok {
+		t.Errorf("error is a %T, expected a *font.MissingGlyphError", list[0])
+	}
+	if len(rb.Kerns) != 1 || rb.Kerns[0] != (KernEntry{0, 2, -1, "\"A\" \"V\""}) {
+		t.Errorf("got kerning pairs %+v", rb.Kerns)
+	}
+}
@@ -0,0 +1,745 @@
+// Copyright (c) 2020 Sam Blenny
+// SPDX-License-Identifier: Apache-2.0 OR MIT
+//
+package main
+
+import (
+	"fmt"
+	"guilib/codegen/font"
+	"io/ioutil"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Holds a font from the manifest along with the sources for its character map,
+// aliases, and legal notice
+type FontEntry struct {
+	Spec        font.FontSpec
+	CharMap     string         // "syslatin" or "index"
+	CharMapFile string         // Index file for CharMap == "index"
+	Aliases     string         // "syslatin", "file", or "none"
+	AliasesFile string         // Alias file for Aliases == "file"
+	LegalFile   string         // Text file with credits or license notices
+	COut        string         // Path for C output files, without the .h/.c extension
+	GoOut       string         // Directory for generated Go package
+	BlobOut     string         // Path for binary font blob
+	BDFOut      string         // Path for BDF font file
+	HexOut      string         // Path for GNU Unifont .hex file
+	PSFOut      string         // Path for PSF2 Linux console font file
+	GFXOut      string         // Path for Adafruit GFX font header
+	LVGLOut     string         // Path for LVGL font C source file
+	OTFOut      string         // Path for bitmap-only OpenType font file
+	HTMLOut     string         // Path for HTML specimen sheet
+	BlobOrder   string         // Byte order of binary font blob: "little" or "big"
+	ColorKeys   string         // Hex RGB colors of sprite pixels that are never ink
+	Regions     []RegionEntry  // [[font.region]] tables of the font
+	Line        int            // Line number of the [[font]] header
+	KeyLines    map[string]int // Line number of each key in the font table
+}
+
+// Holds a [[font.region]] table, which describes part of a sprite sheet with
+// its own grid geometry
+type RegionEntry struct {
+	Values   map[string]int // Value of each key in the region table
+	Line     int            // Line number of the [[font.region]] header
+	KeyLines map[string]int // Line number of each key in the region table
+}
+
+// Holds a problem found in the manifest
+type ManifestError struct {
+	File string
+	Line int
+	Msg  string
+}
+
+func (e ManifestError) Error() string {
+	return fmt.Sprintf("%s:%d: %s", e.File, e.Line, e.Msg)
+}
+
+// Types of manifest values
+type valueKind int
+
+const (
+	stringValue valueKind = iota
+	intValue
+	boolValue
+	floatValue
+)
+
+// Keys allowed in a [[font]] table, and the type of value each one takes
+var manifestKeys = map[string]valueKind{
+	"name":         stringValue,
+	"sprites":      stringValue,
+	"bdf":          stringValue,
+	"hex":          stringValue,
+	"glyph_dir":    stringValue,
+	"size":         intValue,
+	"width":        intValue,
+	"cols":         intValue,
+	"gutter":       intValue,
+	"border":       intValue,
+	"charmap":      stringValue,
+	"charmap_file": stringValue,
+	"aliases":      stringValue,
+	"aliases_file": stringValue,
+	"trim":         stringValue,
+	"legal":        stringValue,
+	"rust_out":     stringValue,
+	"verify":       boolValue,
+	"c_out":        stringValue,
+	"go_out":       stringValue,
+	"blob_out":     stringValue,
+	"blob_order":   stringValue,
+	"bdf_out":      stringValue,
+	"hex_out":      stringValue,
+	"psf_out":      stringValue,
+	"gfx_out":      stringValue,
+	"lvgl_out":     stringValue,
+	"otf_out":      stringValue,
+	"html_out":     stringValue,
+	"ascent":       intValue,
+	"metrics_file": stringValue,
+	"kerning":      stringValue,
+	"kerning_file": stringValue,
+	"ink":          stringValue,
+	"threshold":    intValue,
+	"invert":       boolValue,
+	"color_keys":   stringValue,
+	"dither":       stringValue,
+	"gamma":        floatValue,
+	"contrast":     floatValue,
+	"scale_glyphs": boolValue,
+	"bpp":          intValue,
+}
+
+// Keys that every [[font]] table must have
+var requiredKeys = []string{"name", "size", "ascent", "legal", "rust_out"}
+
+// Keys that a [[font]] table must have when its glyphs come from a sprite sheet
+var spriteKeys = []string{"sprites", "cols", "gutter", "border", "charmap"}
+
+// Keys that only apply to sprite sheets, so a [[font]] table with a font file
+// glyph source must not have them
+var spriteOnlyKeys = []string{"sprites", "width", "cols", "gutter", "border", "charmap", "charmap_file", "trim"}
+
+// Keys allowed in a [[font.region]] table. They all take integers, and the ones
+// other than rows default to the grid geometry of the font.
+var regionKeys = []string{"top", "left", "rows", "cols", "width", "height", "gutter"}
+
+// Output keys for formats that only have 1-bit glyphs, so they can't be used
+// with 2-bit patterns
+var oneBitOutputKeys = []string{"bdf_out", "hex_out", "psf_out", "gfx_out", "lvgl_out", "otf_out"}
+
+// Keys for glyph sources that can be used instead of a sprite sheet
+var fontFileKeys = []string{"bdf", "hex", "glyph_dir"}
+
+// Read and validate the font manifest. The manifest uses a small subset of
+// TOML: comments, [[font]] and [[font.region]] table headers, and `key = value`
+// lines where value is a quoted string, a decimal integer, or a boolean. When the manifest has
+// problems, the returned font.ErrorList has one entry for each of them.
+func readManifest(name string) ([]FontEntry, error) {
+	text, err := ioutil.ReadFile(name)
+	if err != nil {
+		return nil, &font.FileError{Context: font.Context{File: name}, Err: err}
+	}
+	dir := path.Dir(name)
+	var errs font.ErrorList
+	report := func(line int, format string, a ...interface{}) {
+		errs = append(errs, ManifestError{name, line, fmt.Sprintf(format, a...)})
+	}
+	// Parse the tables
+	entries := []FontEntry{}
+	badValue := map[int]bool{}
+	var cur *FontEntry
+	var curRegion *RegionEntry
+	for i, line := range strings.Split(string(text), "\n") {
+		lineNum := i + 1
+		txt := strings.TrimSpace(line)
+		if len(txt) == 0 || strings.HasPrefix(txt, "#") {
+			continue
+		}
+		if strings.HasPrefix(txt, "[") {
+			curRegion = nil
+			if stripComment(txt) == "[[font.region]]" {
+				if cur == nil {
+					report(lineNum, "[[font.region]] table is outside of a [[font]] table")
+					continue
+				}
+				cur.Regions = append(cur.Regions, RegionEntry{Values: map[string]int{}, Line: lineNum, KeyLines: map[string]int{}})
+				curRegion = &cur.Regions[len(cur.Regions)-1]
+				continue
+			}
+			if stripComment(txt) != "[[font]]" {
+				report(lineNum, "unknown table %q (expected [[font]] or [[font.region]])", txt)
+				cur = nil
+				continue
+			}
+			entries = append(entries, FontEntry{
+				Spec: font.FontSpec{Trim: "max", Kerning: "none", Bits: 1,
+					Ink: font.InkSpec{Mode: "red", Threshold: font.DefaultInkThreshold, Dither: "threshold", Gamma: 1, Contrast: 1}},
+				Aliases:   "none",
+				BlobOrder: "little",
+				Line:      lineNum,
+				KeyLines:  map[string]int{},
+			})
+			cur = &entries[len(entries)-1]
+			continue
+		}
+		kv := strings.SplitN(txt, "=", 2)
+		if len(kv) != 2 {
+			report(lineNum, "expected `key = value`, found %q", txt)
+			continue
+		}
+		key := strings.TrimSpace(kv[0])
+		if curRegion != nil {
+			curRegion.parseKey(key, strings.TrimSpace(kv[1]), lineNum, badValue, report)
+			continue
+		}
+		kind, known := manifestKeys[key]
+		if cur == nil {
+			report(lineNum, "key %q is outside of a [[font]] table", key)
+			continue
+		}
+		if !known {
+			report(lineNum, "unknown key %q", key)
+			continue
+		}
+		if prev, dup := cur.KeyLines[key]; dup {
+			report(lineNum, "duplicate key %q (first set on line %d)", key, prev)
+			continue
+		}
+		cur.KeyLines[key] = lineNum
+		s, n, err := parseValue(strings.TrimSpace(kv[1]), kind)
+		if err != nil {
+			report(lineNum, "bad value for %q: %v", key, err)
+			badValue[lineNum] = true
+			continue
+		}
+		cur.set(key, s, n, dir)
+	}
+	// Check each table for missing keys and bad values
+	names := map[string]int{}
+	outputs := map[string]int{}
+	for _, e := range entries {
+		for _, k := range requiredKeys {
+			if _, ok := e.KeyLines[k]; !ok {
+				report(e.Line, "font table is missing required key %q", k)
+			}
+		}
+		source := ""
+		for _, k := range fontFileKeys {
+			if n, ok := e.KeyLines[k]; ok {
+				if source != "" {
+					report(n, "%s and %s glyph sources can't be used together", source, k)
+				}
+				source = k
+			}
+		}
+		if source != "" {
+			for _, k := range spriteOnlyKeys {
+				if n, ok := e.KeyLines[k]; ok {
+					report(n, "%s is only used with sprites, not %s", k, source)
+				}
+			}
+		} else {
+			for _, k := range spriteKeys {
+				if _, ok := e.KeyLines[k]; !ok {
+					report(e.Line, "font table is missing required key %q (or a bdf, hex, or glyph_dir glyph source)", k)
+				}
+			}
+		}
+		keyLine := func(k string) int {
+			if n, ok := e.KeyLines[k]; ok {
+				return n
+			}
+			return e.Line
+		}
+		fs := e.Spec
+		if prev, dup := names[fs.Name]; dup && fs.Name != "" {
+			report(keyLine("name"), "duplicate font name %q (first used on line %d)", fs.Name, prev)
+		}
+		names[fs.Name] = keyLine("name")
+		if prev, dup := outputs[fs.RustOut]; dup && fs.RustOut != "" {
+			report(keyLine("rust_out"), "duplicate rust_out %q (first used on line %d)", fs.RustOut, prev)
+		}
+		outputs[fs.RustOut] = keyLine("rust_out")
+		if fs.RustOut != "" && (path.Base(fs.RustOut) != fs.RustOut || path.Ext(fs.RustOut) != ".rs") {
+			report(keyLine("rust_out"), "rust_out %q should be a .rs file name without a directory", fs.RustOut)
+		}
+		if n, ok := e.KeyLines["size"]; ok && !badValue[n] && (fs.Size < 1 || fs.Size > 0xff) {
+			report(n, "size must be between 1 and 255")
+		}
+		if n, ok := e.KeyLines["width"]; ok && !badValue[n] && (fs.Width < 1 || fs.Width > 0xff) {
+			report(n, "width must be between 1 and 255")
+		}
+		if len(e.Regions) > 0 && source != "" {
+			report(e.Regions[0].Line, "[[font.region]] tables are only used with sprites, not %s", source)
+		}
+		for _, r := range e.Regions {
+			if _, ok := r.KeyLines["rows"]; !ok {
+				report(r.Line, "region table is missing required key \"rows\"")
+			}
+			for _, k := range regionKeys {
+				n, ok := r.KeyLines[k]
+				if !ok || badValue[n] {
+					continue
+				}
+				v := r.Values[k]
+				switch {
+				case (k == "top" || k == "left" || k == "gutter") && v < 0:
+					report(n, "%s must not be negative", k)
+				case (k == "rows" || k == "cols" || k == "height") && v < 1:
+					report(n, "%s must be at least 1", k)
+				case k == "width" && (v < 1 || v > 0xff):
+					report(n, "width must be between 1 and 255")
+				case k == "height" && v > fs.Size:
+					report(n, "height must not be more than size (%d)", fs.Size)
+				}
+			}
+		}
+		if n, ok := e.KeyLines["cols"]; ok && !badValue[n] && fs.Cols < 1 {
+			report(keyLine("cols"), "cols must be at least 1")
+		}
+		if fs.Gutter < 0 {
+			report(keyLine("gutter"), "gutter must not be negative")
+		}
+		if fs.Border < 0 {
+			report(keyLine("border"), "border must not be negative")
+		}
+		switch e.CharMap {
+		case "syslatin", "":
+			if e.CharMapFile != "" {
+				report(keyLine("charmap_file"), "charmap_file is only used with charmap = \"index\"")
+			}
+		case "index":
+			if e.CharMapFile == "" {
+				report(keyLine("charmap"), "charmap = \"index\" needs a charmap_file")
+			}
+		default:
+			report(keyLine("charmap"), "unknown charmap %q (expected \"syslatin\" or \"index\")", e.CharMap)
+		}
+		switch e.Aliases {
+		case "syslatin", "none":
+			if e.AliasesFile != "" {
+				report(keyLine("aliases_file"), "aliases_file is only used with aliases = \"file\"")
+			}
+		case "file":
+			if e.AliasesFile == "" {
+				report(keyLine("aliases"), "aliases = \"file\" needs an aliases_file")
+			}
+		default:
+			report(keyLine("aliases"), "unknown aliases %q (expected \"syslatin\", \"file\", or \"none\")", e.Aliases)
+		}
+		switch fs.Kerning {
+		case "none":
+			if fs.KernFile != "" {
+				report(keyLine("kerning_file"), "kerning_file is only used with kerning = \"file\" or \"auto\"")
+			}
+		case "file":
+			if fs.KernFile == "" {
+				report(keyLine("kerning"), "kerning = \"file\" needs a kerning_file")
+			}
+		case "auto":
+		default:
+			report(keyLine("kerning"), "unknown kerning %q (expected \"none\", \"file\", or \"auto\")", fs.Kerning)
+		}
+		switch fs.Ink.Mode {
+		case "red":
+			if _, ok := e.KeyLines["threshold"]; ok {
+				report(keyLine("threshold"), "threshold is only used with ink = \"luma\" or \"alpha\"")
+			}
+		case "luma", "alpha":
+			if n, ok := e.KeyLines["threshold"]; ok && !badValue[n] && (fs.Ink.Threshold < 0 || fs.Ink.Threshold > 0xff) {
+				report(n, "threshold must be between 0 and 255")
+			}
+		default:
+			report(keyLine("ink"), "unknown ink %q (expected \"red\", \"luma\", or \"alpha\")", fs.Ink.Mode)
+		}
+		if fs.Ink.Mode != "luma" {
+			for _, k := range []string{"dither", "gamma", "contrast"} {
+				if _, ok := e.KeyLines[k]; ok {
+					report(keyLine(k), "%s is only used with ink = \"luma\"", k)
+				}
+			}
+		}
+		knownDither := false
+		for _, d := range font.DitherNames() {
+			knownDither = knownDither || d == fs.Ink.Dither
+		}
+		if !knownDither {
+			report(keyLine("dither"), "unknown dither %q (expected one of %s)", fs.Ink.Dither, strings.Join(font.DitherNames(), ", "))
+		}
+		if n, ok := e.KeyLines["gamma"]; ok && !badValue[n] && !(fs.Ink.Gamma > 0) {
+			report(n, "gamma must be more than 0")
+		}
+		if n, ok := e.KeyLines["contrast"]; ok && !badValue[n] && !(fs.Ink.Contrast > 0) {
+			report(n, "contrast must be more than 0")
+		}
+		if n, ok := e.KeyLines["bpp"]; ok && !badValue[n] {
+			switch {
+			case fs.Bits != 1 && fs.Bits != 2:
+				report(n, "bpp must be 1 or 2")
+			case fs.Bits == 2 && (source == "bdf" || source == "hex"):
+				report(n, "bpp = 2 needs sprites or glyph_dir, not %s", source)
+			case fs.Bits == 2:
+				for _, k := range oneBitOutputKeys {
+					if _, ok := e.KeyLines[k]; ok {
+						report(keyLine(k), "%s only has 1-bit glyphs, so it can't be used with bpp = 2", k)
+					}
+				}
+			}
+		}
+		if n, ok := e.KeyLines["scale_glyphs"]; ok && source != "glyph_dir" {
+			report(n, "scale_glyphs is only used with glyph_dir")
+		}
+		if _, err := font.ParseColorKeys(e.ColorKeys); err != nil {
+			report(keyLine("color_keys"), "%v", err)
+		}
+		if source == "bdf" || source == "hex" {
+			for _, k := range []string{"ink", "threshold", "invert", "color_keys"} {
+				if n, ok := e.KeyLines[k]; ok {
+					report(n, "%s is only used with sprites or glyph_dir, not %s", k, source)
+				}
+			}
+		}
+		switch fs.Trim {
+		case "syslatin", "max":
+		default:
+			report(keyLine("trim"), "unknown trim %q (expected \"syslatin\" or \"max\")", fs.Trim)
+		}
+		switch e.BlobOrder {
+		case "little", "big":
+		default:
+			report(keyLine("blob_order"), "unknown blob_order %q (expected \"little\" or \"big\")", e.BlobOrder)
+		}
+		if n, ok := e.KeyLines["ascent"]; ok && !badValue[n] && (fs.Ascent < 0 || fs.Ascent > fs.Size) {
+			report(n, "ascent must be between 0 and size (%d)", fs.Size)
+		}
+		for _, k := range []string{"c_out", "go_out", "blob_out", "bdf_out", "hex_out", "psf_out", "gfx_out", "lvgl_out", "otf_out", "html_out"} {
+			if f := e.file(k); f != "" {
+				dir := path.Dir(f)
+				if k == "go_out" {
+					dir = f
+				}
+				if info, err := os.Stat(dir); err != nil || !info.IsDir() {
+					report(keyLine(k), "%s directory %q does not exist", k, dir)
+				}
+			}
+		}
+		for _, k := range []string{"sprites", "bdf", "hex", "glyph_dir", "charmap_file", "aliases_file", "legal", "metrics_file", "kerning_file"} {
+			if _, ok := e.KeyLines[k]; !ok {
+				continue
+			}
+			if f := e.file(k); f != "" {
+				if _, err := os.Stat(f); err != nil {
+					report(keyLine(k), "%s file %q is not readable: %v", k, f, err)
+				}
+			}
+		}
+	}
+	if len(entries) == 0 && len(errs) == 0 {
+		report(1, "manifest has no [[font]] tables")
+	}
+	if len(errs) > 0 {
+		sort.SliceStable(errs, func(i, j int) bool {
+			return errs[i].(ManifestError).Line < errs[j].(ManifestError).Line
+		})
+		return nil, errs
+	}
+	// Load the legal notices, make cells square for fonts that don't set a
+	// width, fill in the grid geometry of regions, and parse color keys
+	for i := range entries {
+		if _, ok := entries[i].KeyLines["width"]; !ok {
+			entries[i].Spec.Width = entries[i].Spec.Size
+		}
+		entries[i].Spec.Regions = entries[i].gridRegions()
+		entries[i].Spec.Ink.ColorKeys, _ = font.ParseColorKeys(entries[i].ColorKeys)
+		legal, err := ioutil.ReadFile(entries[i].LegalFile)
+		if err != nil {
+			return nil, &font.FileError{Context: font.Context{File: entries[i].LegalFile}, Err: err}
+		}
+		entries[i].Spec.Legal = strings.TrimRight(string(legal), "\n")
+	}
+	return entries, nil
+}
+
+// Parse a `key = value` line of a [[font.region]] table
+func (r *RegionEntry) parseKey(key string, raw string, lineNum int, badValue map[int]bool,
+	report func(line int, format string, a ...interface{})) {
+	known := false
+	for _, k := range regionKeys {
+		known = known || k == key
+	}
+	if !known {
+		report(lineNum, "unknown region key %q (expected one of %s)", key, strings.Join(regionKeys, ", "))
+		return
+	}
+	if prev, dup := r.KeyLines[key]; dup {
+		report(lineNum, "duplicate key %q (first set on line %d)", key, prev)
+		return
+	}
+	r.KeyLines[key] = lineNum
+	_, n, err := parseValue(raw, intValue)
+	if err != nil {
+		report(lineNum, "bad value for %q: %v", key, err)
+		badValue[lineNum] = true
+		return
+	}
+	r.Values[key] = n
+}
+
+// Return the grid regions of a font entry, with the keys that a region table
+// leaves out filled in from the grid geometry of the font. Each region starts
+// below the previous one unless it sets top.
+func (e FontEntry) gridRegions() []font.GridRegion {
+	var regions []font.GridRegion
+	top := e.Spec.Border
+	for _, r := range e.Regions {
+		value := func(key string, defaultValue int) int {
+			if n, ok := r.Values[key]; ok {
+				return n
+			}
+			return defaultValue
+		}
+		g := font.GridRegion{
+			Top:    value("top", top),
+			Left:   value("left", e.Spec.Border),
+			Rows:   value("rows", 0),
+			Cols:   value("cols", e.Spec.Cols),
+			Width:  value("width", e.Spec.Width),
+			Height: value("height", e.Spec.Size),
+			Gutter: value("gutter", e.Spec.Gutter),
+		}
+		regions = append(regions, g)
+		top = g.Top + g.Rows*(g.Height+g.Gutter)
+	}
+	return regions
+}
+
+// Set a field of a font entry from a parsed manifest value
+func (e *FontEntry) set(key string, s string, n int, dir string) {
+	switch key {
+	case "name":
+		e.Spec.Name = s
+	case "sprites":
+		e.Spec.Sprites = resolvePath(dir, s)
+	case "bdf":
+		e.Spec.BDF = resolvePath(dir, s)
+	case "hex":
+		e.Spec.Hex = resolvePath(dir, s)
+	case "glyph_dir":
+		e.Spec.Glyphs = resolvePath(dir, s)
+	case "size":
+		e.Spec.Size = n
+	case "width":
+		e.Spec.Width = n
+	case "cols":
+		e.Spec.Cols = n
+	case "gutter":
+		e.Spec.Gutter = n
+	case "border":
+		e.Spec.Border = n
+	case "charmap":
+		e.CharMap = s
+	case "charmap_file":
+		e.CharMapFile = resolvePath(dir, s)
+	case "aliases":
+		e.Aliases = s
+	case "aliases_file":
+		e.AliasesFile = resolvePath(dir, s)
+	case "trim":
+		e.Spec.Trim = s
+	case "legal":
+		e.LegalFile = resolvePath(dir, s)
+	case "rust_out":
+		e.Spec.RustOut = s
+	case "verify":
+		e.Spec.Verify = n != 0
+	case "c_out":
+		e.COut = resolvePath(dir, s)
+	case "go_out":
+		e.GoOut = resolvePath(dir, s)
+	case "blob_out":
+		e.BlobOut = resolvePath(dir, s)
+	case "blob_order":
+		e.BlobOrder = s
+	case "bdf_out":
+		e.BDFOut = resolvePath(dir, s)
+	case "hex_out":
+		e.HexOut = resolvePath(dir, s)
+	case "psf_out":
+		e.PSFOut = resolvePath(dir, s)
+	case "gfx_out":
+		e.GFXOut = resolvePath(dir, s)
+	case "lvgl_out":
+		e.LVGLOut = resolvePath(dir, s)
+	case "otf_out":
+		e.OTFOut = resolvePath(dir, s)
+	case "html_out":
+		e.HTMLOut = resolvePath(dir, s)
+	case "ascent":
+		e.Spec.Ascent = n
+	case "metrics_file":
+		e.Spec.Metrics = resolvePath(dir, s)
+	case "kerning":
+		e.Spec.Kerning = s
+	case "kerning_file":
+		e.Spec.KernFile = resolvePath(dir, s)
+	case "ink":
+		e.Spec.Ink.Mode = s
+	case "threshold":
+		e.Spec.Ink.Threshold = n
+	case "invert":
+		e.Spec.Ink.Invert = n != 0
+	case "color_keys":
+		e.ColorKeys = s
+	case "dither":
+		e.Spec.Ink.Dither = s
+	case "gamma":
+		e.Spec.Ink.Gamma, _ = strconv.ParseFloat(s, 64)
+	case "contrast":
+		e.Spec.Ink.Contrast, _ = strconv.ParseFloat(s, 64)
+	case "scale_glyphs":
+		e.Spec.Scale = n != 0
+	case "bpp":
+		e.Spec.Bits = n
+	}
+}
+
+// Resolve a path from the manifest relative to the manifest's directory
+func resolvePath(dir string, p string) string {
+	if path.IsAbs(p) {
+		return p
+	}
+	return path.Join(dir, p)
+}
+
+// Return the file path for a file-valued manifest key
+func (e FontEntry) file(key string) string {
+	switch key {
+	case "sprites":
+		return e.Spec.Sprites
+	case "bdf":
+		return e.Spec.BDF
+	case "hex":
+		return e.Spec.Hex
+	case "glyph_dir":
+		return e.Spec.Glyphs
+	case "charmap_file":
+		return e.CharMapFile
+	case "aliases_file":
+		return e.AliasesFile
+	case "legal":
+		return e.LegalFile
+	case "metrics_file":
+		return e.Spec.Metrics
+	case "kerning_file":
+		return e.Spec.KernFile
+	case "c_out":
+		return e.COut
+	case "go_out":
+		return e.GoOut
+	case "blob_out":
+		return e.BlobOut
+	case "bdf_out":
+		return e.BDFOut
+	case "hex_out":
+		return e.HexOut
+	case "psf_out":
+		return e.PSFOut
+	case "gfx_out":
+		return e.GFXOut
+	case "lvgl_out":
+		return e.LVGLOut
+	case "otf_out":
+		return e.OTFOut
+	case "html_out":
+		return e.HTMLOut
+	}
+	return ""
+}
+
+// Return the character map for a font entry. Fonts with a BDF, .hex, or glyph
+// directory source have no character map because their glyphs are keyed by
+// codepoint.
+func (e FontEntry) CharSpecs() ([]font.CharSpec, error) {
+	if e.Spec.HasFontFile() {
+		return nil, nil
+	}
+	if e.CharMap == "index" {
+		return font.EmojiMap(e.Spec, e.CharMapFile)
+	}
+	return font.SysLatinMap(), nil
+}
+
+// Return the grapheme cluster aliases for a font entry
+func (e FontEntry) GCAliases() ([]font.GCAlias, error) {
+	switch e.Aliases {
+	case "syslatin":
+		return font.SysLatinAliases(), nil
+	case "file":
+		aliasList, err := font.EmojiAliases(e.AliasesFile)
+		return aliasList, font.WithContext(err, font.Context{Font: e.Spec.Name})
+	}
+	return []font.GCAlias{}, nil
+}
+
+// Parse a manifest value, possibly followed by a comment. Strings are returned
+// as s, while integers and booleans (as 0 or 1) are returned as n. Floats are
+// checked, then returned as s for the caller to convert.
+func parseValue(raw string, kind valueKind) (string, int, error) {
+	switch kind {
+	case intValue:
+		n, err := strconv.Atoi(stripComment(raw))
+		if err != nil {
+			return "", 0, fmt.Errorf("expected an integer, found %q", raw)
+		}
+		return "", n, nil
+	case boolValue:
+		switch stripComment(raw) {
+		case "true":
+			return "", 1, nil
+		case "false":
+			return "", 0, nil
+		}
+		return "", 0, fmt.Errorf("expected true or false, found %q", raw)
+	case floatValue:
+		s := stripComment(raw)
+		if _, err := strconv.ParseFloat(s, 64); err != nil {
+			return "", 0, fmt.Errorf("expected a number, found %q", raw)
+		}
+		return s, 0, nil
+	}
+	if !strings.HasPrefix(raw, "\"") {
+		return "", 0, fmt.Errorf("expected a quoted string, found %q", raw)
+	}
+	// Find the closing quote, skipping over escaped characters
+	end := -1
+	for i := 1; i < len(raw); i++ {
+		if raw[i] == '\\' {
+			i++
+		} else if raw[i] == '"' {
+			end = i
+			break
+		}
+	}
+	if end < 0 {
+		return "", 0, fmt.Errorf("unterminated string %s", raw)
+	}
+	if rest := stripComment(raw[end+1:]); rest != "" {
+		return "", 0, fmt.Errorf("unexpected text after string: %q", rest)
+	}
+	s, err := strconv.Unquote(raw[:end+1])
+	if err != nil {
+		return "", 0, fmt.Errorf("bad string %s", raw[:end+1])
+	}
+	return s, 0, nil
+}
+
+// Remove a trailing "#" comment and surrounding whitespace
+func stripComment(s string) string {
+	return strings.TrimSpace(strings.SplitN(s, "#", 2)[0])
+}
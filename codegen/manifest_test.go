@@ -0,0 +1,95 @@
+// Copyright (c) 2020 Sam Blenny
+// SPDX-License-Identifier: Apache-2.0 OR MIT
+//
+package main
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"guilib/codegen/font"
+)
+
+// A manifest with one font that reads its glyphs from a .hex file
+const testManifest = `# Test fonts
+[[font]]
+name = "Test"
+hex = "test.hex"
+size = 16
+ascent = 12
+legal = "legal.txt"
+rust_out = "test.rs"
+`
+
+// Write a manifest, with the files it refers to, to a temporary directory and
+// read it back
+func readTestManifest(t *testing.T, text string) ([]FontEntry, error) {
+	t.Helper()
+	dir := t.TempDir()
+	files := map[string]string{
+		"fonts.toml": text,
+		"test.hex":   "0041:0000000018242442427E424242420000\n",
+		"legal.txt":  "Test font\n",
+	}
+	for name, s := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(s), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return readManifest(filepath.Join(dir, "fonts.toml"))
+}
+
+func TestManifest(t *testing.T) {
+	entries, err := readTestManifest(t, testManifest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 {
+		t.Fatalf("found %d fonts instead of 1", len(entries))
+	}
+	fs := entries[0].Spec
+	if fs.Name != "Test" || fs.Size != 16 || fs.Ascent != 12 || fs.Width != 16 || fs.Legal != "Test font" {
+		t.Errorf("font spec does not match the manifest: %+v", fs)
+	}
+}
+
+func TestManifestErrors(t *testing.T) {
+	for _, c := range []struct {
+		name string
+		old  string
+		new  string
+		line int
+		msg  string
+	}{
+		{"unknown key", "size = 16\n", "size = 16\ncolour = \"red\"\n", 6, "unknown key \"colour\""},
+		{"duplicate key", "legal = ", "size = 12\nlegal = ", 7, "duplicate key \"size\" (first set on line 5)"},
+		{"missing key", "rust_out = \"test.rs\"\n", "", 2, "missing required key \"rust_out\""},
+		{"missing glyph source", "hex = \"test.hex\"\n", "", 2, "missing required key \"sprites\""},
+		{"key outside of table", "[[font]]\n", "size = 16\n[[font]]\n", 2, "outside of a [[font]] table"},
+		{"unknown table", "[[font]]\n", "[font]\n", 2, "unknown table"},
+		{"bad value", "size = 16", "size = \"16\"", 5, "bad value for \"size\""},
+		{"size too big", "size = 16", "size = 256", 5, "size must be between 1 and 255"},
+		{"missing ascent", "ascent = 12\n", "", 2, "missing required key \"ascent\""},
+		{"ascent below line", "ascent = 12", "ascent = 17", 6, "ascent must be between 0 and size"},
+		{"missing file", "hex = \"test.hex\"", "hex = \"missing.hex\"", 4, "is not readable"},
+		{"no fonts", testManifest, "# Nothing here\n", 1, "no [[font]] tables"},
+	} {
+		_, err := readTestManifest(t, strings.Replace(testManifest, c.old, c.new, 1))
+		list, ok := err.(font.ErrorList)
+		if !ok {
+			t.Errorf("%s: expected an ErrorList, got %v", c.name, err)
+			continue
+		}
+		found := false
+		for _, e := range list {
+			if me, ok := e.(ManifestError); ok && me.Line == c.line && strings.Contains(me.Msg, c.msg) {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("%s: expected an error on line %d about %q, got:\n%v", c.name, c.line, c.msg, err)
+		}
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2020 Sam Blenny
+// SPDX-License-Identifier: Apache-2.0 OR MIT
+//
+package main
+
+import (
+	"flag"
+	"fmt"
+	"guilib/codegen/font"
+	"image"
+	"image/color"
+	"image/draw"
+	"io/ioutil"
+	"os"
+	"strings"
+)
+
+// Pack the PNG files of a glyph directory into a sprite sheet and an index file
+// for charmap = "index", so the glyphs can be used as a sprite sheet font. The
+// arguments are the flags and file names after the --pack switch.
+func packCommand(args []string) {
+	flags := flag.NewFlagSet(pack, flag.ExitOnError)
+	size := flags.Int("size", 0, "How many pixels tall is each glyph cell? (required)")
+	width := flags.Int("width", 0, "How many pixels wide is each glyph cell? (default size)")
+	cols := flags.Int("cols", 16, "How many glyphs wide is the grid?")
+	gutter := flags.Int("gutter", 0, "How many px between glyphs?")
+	border := flags.Int("border", 0, "How many px wide are top and left borders?")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: go run . %s -size n [flags] <glyph_dir> <sprites.png> <index.txt>\n", pack)
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+	if flags.NArg() != 3 {
+		flags.Usage()
+		os.Exit(2)
+	}
+	if *width == 0 {
+		*width = *size
+	}
+	fs := font.FontSpec{
+		Name:    "pack",
+		Glyphs:  flags.Arg(0),
+		Sprites: flags.Arg(1),
+		Size:    *size,
+		Width:   *width,
+		Cols:    *cols,
+		Gutter:  *gutter,
+		Border:  *border,
+	}
+	indexFile := flags.Arg(2)
+	glyphs, err := font.ReadGlyphDir(fs)
+	if err != nil {
+		reportErrors(err)
+		os.Exit(1)
+	}
+	img, index, err := packSpriteSheet(fs, glyphs)
+	if err != nil {
+		reportErrors(err)
+		os.Exit(1)
+	}
+	fmt.Println("Writing to", fs.Sprites)
+	if err := writePNGFile(fs.Sprites, img); err != nil {
+		reportErrors(&font.FileError{Context: font.Context{File: fs.Sprites}, Err: err})
+		os.Exit(1)
+	}
+	fmt.Println("Writing to", indexFile)
+	if err := ioutil.WriteFile(indexFile, []byte(index), 0644); err != nil {
+		reportErrors(&font.FileError{Context: font.Context{File: indexFile}, Err: err})
+		os.Exit(1)
+	}
+	fmt.Printf("Packed %d glyphs (size = %d, width = %d, cols = %d, gutter = %d, border = %d)\n",
+		len(glyphs), fs.Size, fs.Width, fs.Cols, fs.Gutter, fs.Border)
+}
+
+// Draw glyph images into the grid of a sprite sheet in row-major order, and
+// make the matching index file. Each glyph goes at the top left of its grid
+// cell, on a white background, so that reading the sheet with the index gives
+// the same blit patterns as reading the glyph directory.
+func packSpriteSheet(fs font.FontSpec, glyphs []font.GlyphImage) (image.Image, string, error) {
+	switch {
+	case fs.Size < 1 || fs.Width < 1:
+		return nil, "", fmt.Errorf("size and width must be at least 1")
+	case fs.Cols < 1:
+		return nil, "", fmt.Errorf("cols must be at least 1")
+	case fs.Gutter < 0 || fs.Border < 0:
+		return nil, "", fmt.Errorf("gutter and border must not be negative")
+	case len(glyphs) == 0:
+		return nil, "", fmt.Errorf("%s: glyph directory has no PNG files", fs.Glyphs)
+	}
+	var errs font.ErrorList
+	for _, g := range glyphs {
+		if b := g.Image.Bounds(); b.Dy() != fs.Size || b.Dx() > fs.Width {
+			errs.Add(&font.SourceError{Context: font.Context{File: g.File}, Msg: fmt.Sprintf(
+				"image is %dx%d px, but glyphs for a sprite sheet must be %d px tall and at most %d px wide",
+				b.Dx(), b.Dy(), fs.Size, fs.Width)})
+		}
+	}
+	if errs.Err() != nil {
+		return nil, "", errs
+	}
+	gridW := fs.Width + fs.Gutter
+	gridH := fs.Size + fs.Gutter
+	rows := (len(glyphs) + fs.Cols - 1) / fs.Cols
+	sheet := image.NewNRGBA(image.Rect(0, 0, fs.Border+fs.Cols*gridW, fs.Border+rows*gridH))
+	draw.Draw(sheet, sheet.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+	var index strings.Builder
+	fmt.Fprintf(&index, "# Packed from %s (size = %d, width = %d, cols = %d, gutter = %d, border = %d)\n",
+		fs.Glyphs, fs.Size, fs.Width, fs.Cols, fs.Gutter, fs.Border)
+	fmt.Fprintf(&index, "# Grapheme clusters in row-major order of the sprite sheet grid\n")
+	for i, g := range glyphs {
+		row, col := i/fs.Cols, i%fs.Cols
+		b := g.Image.Bounds()
+		at := image.Pt(fs.Border+col*gridW, fs.Border+row*gridH)
+		draw.Draw(sheet, image.Rectangle{at, at.Add(b.Size())}, g.Image, b.Min, draw.Src)
+		fmt.Fprintf(&index, "%s\n", g.HexCluster)
+	}
+	return sheet, index.String(), nil
+}
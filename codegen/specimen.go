@@ -0,0 +1,196 @@
+// Copyright (c) 2020 Sam Blenny
+// SPDX-License-Identifier: Apache-2.0 OR MIT
+//
+package main
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"guilib/codegen/font"
+	"html/template"
+	"image"
+	"image/png"
+	"strings"
+	"unicode/utf8"
+)
+
+// Holds one row of a specimen sheet
+type SpecimenGlyph struct {
+	Image      template.URL // PNG data URI of the glyph in its line box
+	ImageW     int          // Width of the image, scaled up for display
+	ImageH     int
+	HexCluster string
+	Label      string
+	Block      string
+	DataOffset int
+	Words      int // Size of the blit pattern in uint32 words
+	Advance    int
+	Bearing    int
+	Aliases    []string
+}
+
+// How much to scale up glyph images on a specimen sheet
+const specimenScale = 2
+
+// Generate a self-contained HTML specimen sheet for a font, with a row for
+// each glyph in DATA order. Glyph images are PNG data URIs drawn in the same
+// way as an atlas cell, so the line box, pattern box and baseline show up.
+func genSpecimenFile(fs font.FontSpec, htmlOut string, rb RustyBlits) (OutputFile, error) {
+	// Find the block of each pattern and the aliases that share its offset
+	offset := 0
+	canonOffsets := map[string]int{}
+	for _, p := range rb.Patterns {
+		canonOffsets[p.CS.GraphemeCluster()] = offset
+		offset += len(p.Bytes)
+	}
+	blocks := map[int]string{}
+	aliases := map[int][]string{}
+	for _, k := range rb.IndexKeys() {
+		for _, entry := range rb.Index[k] {
+			if off, ok := canonOffsets[entry.Cluster]; ok && off == entry.DataOffset {
+				blocks[entry.DataOffset] = k.Name
+				continue
+			}
+			// Labels of multi-codepoint clusters already include their hex form
+			label := labelForCluster(entry.Cluster)
+			if utf8.RuneCountInString(entry.Cluster) == 1 {
+				label = hexForCluster(entry.Cluster) + " " + label
+			}
+			aliases[entry.DataOffset] = append(aliases[entry.DataOffset], label)
+		}
+	}
+	var glyphs []SpecimenGlyph
+	offset = 0
+	for _, p := range rb.Patterns {
+		pxMatrix, yOffset := font.ConvertPatternToMatrix(rb.Data[offset : offset+len(p.Bytes)])
+		w := int((p.Bytes[0] >> 16) & 0xff)
+		img := renderGlyphImage(fs, pxMatrix, w, int(yOffset), font.PatternBits(p.Bytes[0]))
+		var buf bytes.Buffer
+		if err := png.Encode(&buf, img); err != nil {
+			return OutputFile{}, &font.SourceError{Context: font.Context{Font: fs.Name},
+				Msg: fmt.Sprintf("specimen image for %s: %v", p.CS.HexCluster, err)}
+		}
+		bounds := img.Bounds()
+		glyphs = append(glyphs, SpecimenGlyph{
+			Image:      template.URL("data:image/png;base64," + base64.StdEncoding.EncodeToString(buf.Bytes())),
+			ImageW:     specimenScale * bounds.Dx(),
+			ImageH:     specimenScale * bounds.Dy(),
+			HexCluster: p.CS.HexCluster,
+			Label:      labelForCluster(p.CS.GraphemeCluster()),
+			Block:      blocks[offset],
+			DataOffset: offset,
+			Words:      len(p.Bytes),
+			Advance:    p.Metrics.Advance,
+			Bearing:    p.Metrics.Bearing,
+			Aliases:    aliases[offset],
+		})
+		offset += len(p.Bytes)
+	}
+	context := struct {
+		Font   font.FontSpec
+		Legal  []string
+		Glyphs []SpecimenGlyph
+		Words  int
+	}{fs, strings.Split(strings.TrimSpace(fs.Legal), "\n"), glyphs, rb.DataLen}
+	// This uses html/template rather than renderTemplate so that labels and
+	// legal text get escaped
+	t, err := template.New("specimen").Parse(specimenTemplate)
+	if err != nil {
+		return OutputFile{}, err
+	}
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, context); err != nil {
+		return OutputFile{}, &font.SourceError{Context: font.Context{Font: fs.Name},
+			Msg: fmt.Sprintf("specimen template: %v", err)}
+	}
+	return OutputFile{htmlOut, buf.String(), false}, nil
+}
+
+// Draw a glyph in a line box that is as wide as the glyph (at least 1 px) and
+// as tall as the font, with the same colors as an atlas cell
+func renderGlyphImage(fs font.FontSpec, pxMatrix font.Matrix, w int, yOffset int, bits int) *image.Paletted {
+	lineBoxH := fs.Size
+	if yOffset+len(pxMatrix) > lineBoxH {
+		lineBoxH = yOffset + len(pxMatrix)
+	}
+	boxW := w
+	if boxW < 1 {
+		boxW = 1
+	}
+	img := image.NewPaletted(image.Rect(0, 0, boxW, lineBoxH), atlasPalette)
+	if fs.Ascent < lineBoxH {
+		fillRect(img, 0, fs.Ascent, boxW, 1, atlasBaseline)
+	}
+	fillRect(img, 0, yOffset, w, len(pxMatrix), atlasBox)
+	for y, row := range pxMatrix {
+		for x, px := range row {
+			if px > 0 {
+				img.SetColorIndex(x, yOffset+y, atlasInkIndex(px, bits))
+			}
+		}
+	}
+	return img
+}
+
+// Return the hex codepoints of a grapheme cluster, like "1f1fa-1f1f8"
+func hexForCluster(c string) string {
+	var hexCPs []string
+	for _, r := range c {
+		hexCPs = append(hexCPs, fmt.Sprintf("%x", r))
+	}
+	return strings.Join(hexCPs, "-")
+}
+
+// Template with a self-contained HTML page for a font specimen sheet
+const specimenTemplate = `<!DOCTYPE html>
+<!-- DO NOT MAKE EDITS HERE because this file is automatically generated.
+     To make changes, see guilib/codegen/main.go -->
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>{{.Font.Name}} Font Specimen</title>
+<style>
+body { font-family: sans-serif; margin: 2em; }
+table { border-collapse: collapse; }
+th, td { border: 1px solid #ccc; padding: 4px 8px; text-align: left; vertical-align: middle; }
+th { background: #eee; position: sticky; top: 0; }
+td.num { text-align: right; font-family: monospace; }
+td.hex { font-family: monospace; }
+img { image-rendering: pixelated; image-rendering: crisp-edges; }
+ul { margin: 0; padding-left: 1.2em; }
+</style>
+</head>
+<body>
+<h1>{{.Font.Name}} Font</h1>
+<p>{{len .Glyphs}} glyphs, {{.Words}} words of DATA, {{.Font.Size}} px line height
+({{.Font.Ascent}} px ascent, {{.Font.Descent}} px descent).
+The gray box is the blit pattern (w &times; h at yOffset), and the red line is the baseline.</p>
+<details>
+<summary>Credits</summary>
+<pre>{{range .Legal}}{{.}}
+{{end}}</pre>
+</details>
+<table>
+<thead>
+<tr><th>Glyph</th><th>Hex Cluster</th><th>Label</th><th>Unicode Block</th><th>DATA Offset</th><th>Words</th><th>Advance</th><th>Left Bearing</th><th>Aliases</th></tr>
+</thead>
+<tbody>
+{{- range .Glyphs}}
+<tr>
+<td><img src="{{.Image}}" width="{{.ImageW}}" height="{{.ImageH}}" alt="{{.HexCluster}}"></td>
+<td class="hex">{{.HexCluster}}</td>
+<td>{{.Label}}</td>
+<td>{{.Block}}</td>
+<td class="num">{{.DataOffset}}</td>
+<td class="num">{{.Words}}</td>
+<td class="num">{{.Advance}}</td>
+<td class="num">{{.Bearing}}</td>
+<td>{{if .Aliases}}<ul>{{range .Aliases}}<li class="hex">{{.}}</li>{{end}}</ul>{{end}}</td>
+</tr>
+{{- end}}
+</tbody>
+</table>
+</body>
+</html>
+`
@@ -106,6 +106,22 @@ pub fn regular_data(index: usize) -> u32 {
     regular::DATA[index]
 }
 
+/// Return true when the chars of a grapheme cluster key exactly match the
+/// codepoints from a font's cluster verification table. Fonts generated with
+/// cluster verification use this to reject keys that only match by hash.
+/// Fonts generated without cluster verification don't call it.
+#[allow(dead_code)]
+pub fn cluster_matches(key: &str, codepoints: &[u32]) -> bool {
+    let mut n = 0;
+    for c in key.chars() {
+        if n >= codepoints.len() || c as u32 != codepoints[n] {
+            return false;
+        }
+        n += 1;
+    }
+    n == codepoints.len()
+}
+
 /// Compute Murmur3 hash function of the first limit codepoints of a string,
 /// using each char as a u32 block.
 /// Returns: (murmur3 hash, how many bytes of key were hashed (e.g. key[..n]))
@@ -20,6 +20,13 @@
 /// This will be true: h + y_offset <= MAX_HEIGHT
 pub const MAX_HEIGHT: u8 = 30;
 
+/// Distance in pixels from the top of the line down to the baseline
+pub const ASCENT: u8 = 24;
+
+/// Distance in pixels from the baseline down to the bottom of the line.
+/// This will be true: ASCENT + DESCENT == MAX_HEIGHT
+pub const DESCENT: u8 = 6;
+
 /// Seed for Murmur3 hashes in the HASH_* index arrays
 pub const M3_SEED: u32 = 0;
 
@@ -762,6 +769,544 @@ const OFFSET_SPECIALS: [usize; 1] = [
     1639, // "�"
 ];
 
+/// Return Some((advance, left bearing)) in pixels for the glyph with its blit
+/// pattern at DATA[offset]. The left edge of the pattern goes left bearing
+/// pixels right of the pen position (negative is to the left), and the pen
+/// moves advance pixels to the right after drawing the glyph. Glyphs without a
+/// record in METRICS get the spacing of blit.rs: a left bearing of 1, and an
+/// advance of the pattern width plus 3. Return None if offset is past the end
+/// of DATA.
+pub fn get_glyph_metrics(offset: usize) -> Option<(usize, isize)> {
+    if let Ok(index) = METRICS_OFFSET.binary_search(&offset) {
+        let m = METRICS[index];
+        return Some(((m >> 16) as usize, m as u16 as i16 as isize));
+    }
+    DATA.get(offset).map(|header| (((header >> 16) & 0xff) as usize + 3, 1))
+}
+
+/// Lookup table of blit pattern offsets in DATA order, for glyphs that do not
+/// have the default metrics; sort matches METRICS
+const METRICS_OFFSET: [usize; 219] = [
+    0,    // " "
+    2,    // "!"
+    6,    // "\""
+    9,    // "#"
+    18,   // "$"
+    26,   // "%"
+    39,   // "&"
+    49,   // "'"
+    51,   // "("
+    57,   // ")"
+    63,   // "*"
+    68,   // "+"
+    73,   // ","
+    75,   // "-"
+    77,   // "."
+    79,   // "/"
+    87,   // "0"
+    95,   // "1"
+    101,  // "2"
+    109,  // "3"
+    117,  // "4"
+    126,  // "5"
+    134,  // "6"
+    142,  // "7"
+    150,  // "8"
+    158,  // "9"
+    166,  // ":"
+    169,  // ";"
+    173,  // "<"
+    179,  // "="
+    183,  // ">"
+    189,  // "?"
+    197,  // "@"
+    207,  // "A"
+    215,  // "B"
+    223,  // "C"
+    231,  // "D"
+    239,  // "E"
+    246,  // "F"
+    253,  // "G"
+    261,  // "H"
+    269,  // "I"
+    275,  // "J"
+    283,  // "K"
+    292,  // "L"
+    299,  // "M"
+    312,  // "N"
+    321,  // "O"
+    329,  // "P"
+    337,  // "Q"
+    347,  // "R"
+    355,  // "S"
+    362,  // "T"
+    370,  // "U"
+    378,  // "V"
+    386,  // "W"
+    399,  // "X"
+    407,  // "Y"
+    415,  // "Z"
+    423,  // "["
+    429,  // "\\"
+    437,  // "]"
+    443,  // "^"
+    446,  // "_"
+    448,  // "`"
+    451,  // "a"
+    458,  // "b"
+    466,  // "c"
+    472,  // "d"
+    480,  // "e"
+    487,  // "f"
+    494,  // "g"
+    503,  // "h"
+    511,  // "i"
+    515,  // "j"
+    524,  // "k"
+    532,  // "l"
+    536,  // "m"
+    546,  // "n"
+    553,  // "o"
+    560,  // "p"
+    568,  // "q"
+    576,  // "r"
+    582,  // "s"
+    588,  // "t"
+    594,  // "u"
+    601,  // "v"
+    608,  // "w"
+    618,  // "x"
+    625,  // "y"
+    634,  // "z"
+    641,  // "{"
+    647,  // "|"
+    650,  // "}"
+    656,  // "~"
+    659,  // "\u00A0" No-Break Space
+    661,  // "¡"
+    665,  // "¢"
+    671,  // "£"
+    680,  // "¤"
+    688,  // "¥"
+    698,  // "¦"
+    701,  // "§"
+    710,  // "¨"
+    712,  // "©"
+    722,  // "ª"
+    728,  // "«"
+    736,  // "¬"
+    739,  // "\u00AD" Soft Hyphen
+    741,  // "®"
+    751,  // "¯"
+    753,  // "°"
+    756,  // "±"
+    762,  // "²"
+    765,  // "³"
+    768,  // "´"
+    771,  // "µ"
+    781,  // "¶"
+    790,  // "·"
+    792,  // "¸"
+    794,  // "¹"
+    797,  // "º"
+    803,  // "»"
+    811,  // "¼"
+    824,  // "½"
+    837,  // "¾"
+    850,  // "¿"
+    858,  // "À"
+    868,  // "Á"
+    878,  // "Â"
+    888,  // "Ã"
+    898,  // "Ä"
+    908,  // "Å"
+    918,  // "Æ"
+    930,  // "Ç"
+    940,  // "È"
+    949,  // "É"
+    958,  // "Ê"
+    967,  // "Ë"
+    975,  // "Ì"
+    979,  // "Í"
+    983,  // "Î"
+    990,  // "Ï"
+    997,  // "Ð"
+    1006, // "Ñ"
+    1018, // "Ò"
+    1028, // "Ó"
+    1038, // "Ô"
+    1048, // "Õ"
+    1058, // "Ö"
+    1068, // "×"
+    1073, // "Ø"
+    1085, // "Ù"
+    1095, // "Ú"
+    1105, // "Û"
+    1115, // "Ü"
+    1125, // "Ý"
+    1135, // "Þ"
+    1144, // "ß"
+    1153, // "à"
+    1162, // "á"
+    1171, // "â"
+    1180, // "ã"
+    1189, // "ä"
+    1197, // "å"
+    1207, // "æ"
+    1217, // "ç"
+    1226, // "è"
+    1235, // "é"
+    1244, // "ê"
+    1253, // "ë"
+    1261, // "ì"
+    1265, // "í"
+    1269, // "î"
+    1275, // "ï"
+    1281, // "ð"
+    1289, // "ñ"
+    1298, // "ò"
+    1307, // "ó"
+    1316, // "ô"
+    1325, // "õ"
+    1334, // "ö"
+    1342, // "÷"
+    1347, // "ø"
+    1355, // "ù"
+    1364, // "ú"
+    1373, // "û"
+    1382, // "ü"
+    1390, // "ý"
+    1401, // "þ"
+    1411, // "ÿ"
+    1421, // "Œ"
+    1433, // "œ"
+    1443, // "‘"
+    1445, // "’"
+    1447, // "‚"
+    1449, // "‛"
+    1451, // "“"
+    1455, // "”"
+    1459, // "„"
+    1463, // "‟"
+    1467, // "†"
+    1470, // "‡"
+    1474, // "•"
+    1479, // "€"
+    1488, // "\uE700" Battery_05
+    1498, // "\uE701" Battery_25
+    1508, // "\uE702" Battery_50
+    1518, // "\uE703" Battery_75
+    1528, // "\uE704" Battery_99
+    1538, // "\uE705" Radio_3
+    1551, // "\uE706" Radio_2
+    1564, // "\uE707" Radio_1
+    1577, // "\uE708" Radio_0
+    1590, // "\uE709" Radio_Off
+    1603, // "\uE70A" Shift_Arrow
+    1611, // "\uE70B" Backspace_Symbol
+    1627, // "\uE70C" Enter_Symbol
+    1639, // "�"
+];
+
+/// Glyph metrics; sort matches METRICS_OFFSET.
+/// Record format: ((advance as u16) << 16) | (left bearing as i16 as u16)
+const METRICS: [u32; 219] = [
+    0x00060000,  // " "
+    0x00060000,  // "!"
+    0x00080000,  // "\""
+    0x00120000,  // "#"
+    0x000C0000,  // "$"
+    0x00140000,  // "%"
+    0x00120000,  // "&"
+    0x00040000,  // "'"
+    0x00080000,  // "("
+    0x00080000,  // ")"
+    0x000C0000,  // "*"
+    0x000C0000,  // "+"
+    0x00060000,  // ","
+    0x000C0000,  // "-"
+    0x00060000,  // "."
+    0x000C0000,  // "/"
+    0x000E0000,  // "0"
+    0x000E0000,  // "1"
+    0x000E0000,  // "2"
+    0x000E0000,  // "3"
+    0x00100000,  // "4"
+    0x000E0000,  // "5"
+    0x000E0000,  // "6"
+    0x000E0000,  // "7"
+    0x000E0000,  // "8"
+    0x000E0000,  // "9"
+    0x00060000,  // ":"
+    0x00060000,  // ";"
+    0x000E0000,  // "<"
+    0x000E0000,  // "="
+    0x000E0000,  // ">"
+    0x000E0000,  // "?"
+    0x00140000,  // "@"
+    0x000E0000,  // "A"
+    0x000E0000,  // "B"
+    0x000E0000,  // "C"
+    0x000E0000,  // "D"
+    0x000C0000,  // "E"
+    0x000C0000,  // "F"
+    0x000E0000,  // "G"
+    0x000E0000,  // "H"
+    0x000A0000,  // "I"
+    0x000E0000,  // "J"
+    0x00100000,  // "K"
+    0x000C0000,  // "L"
+    0x00160000,  // "M"
+    0x00100000,  // "N"
+    0x000E0000,  // "O"
+    0x000E0000,  // "P"
+    0x000E0000,  // "Q"
+    0x000E0000,  // "R"
+    0x000C0000,  // "S"
+    0x000E0000,  // "T"
+    0x000E0000,  // "U"
+    0x000E0000,  // "V"
+    0x00160000,  // "W"
+    0x000E0000,  // "X"
+    0x000E0000,  // "Y"
+    0x000E0000,  // "Z"
+    0x00080000,  // "["
+    0x000C0000,  // "\\"
+    0x00080000,  // "]"
+    0x000C0000,  // "^"
+    0x00120000,  // "_"
+    0x000A0000,  // "`"
+    0x000E0000,  // "a"
+    0x000E0000,  // "b"
+    0x000C0000,  // "c"
+    0x000E0000,  // "d"
+    0x000E0000,  // "e"
+    0x000C0000,  // "f"
+    0x000E0000,  // "g"
+    0x000E0000,  // "h"
+    0x00060000,  // "i"
+    0x000E0000,  // "j"
+    0x000E0000,  // "k"
+    0x00060000,  // "l"
+    0x00160000,  // "m"
+    0x000E0000,  // "n"
+    0x000E0000,  // "o"
+    0x000E0000,  // "p"
+    0x000E0000,  // "q"
+    0x000C0000,  // "r"
+    0x000C0000,  // "s"
+    0x000A0000,  // "t"
+    0x000E0000,  // "u"
+    0x000E0000,  // "v"
+    0x00160000,  // "w"
+    0x000E0000,  // "x"
+    0x000E0000,  // "y"
+    0x000E0000,  // "z"
+    0x00080000,  // "{"
+    0x00040000,  // "|"
+    0x00080000,  // "}"
+    0x000E0000,  // "~"
+    0x00060000,  // "\u00A0" No-Break Space
+    0x00060000,  // "¡"
+    0x000C0000,  // "¢"
+    0x00100000,  // "£"
+    0x00100000,  // "¤"
+    0x00120000,  // "¥"
+    0x00040000,  // "¦"
+    0x000C0000,  // "§"
+    0x000A0000,  // "¨"
+    0x00120000,  // "©"
+    0x000C0000,  // "ª"
+    0x00100000,  // "«"
+    0x000C0000,  // "¬"
+    0x000C0000,  // "\u00AD" Soft Hyphen
+    0x00120000,  // "®"
+    0x000A0000,  // "¯"
+    0x000A0000,  // "°"
+    0x000C0000,  // "±"
+    0x00080000,  // "²"
+    0x00080000,  // "³"
+    0x00080000,  // "´"
+    0x00120000,  // "µ"
+    0x00100000,  // "¶"
+    0x00060000,  // "·"
+    0x00060000,  // "¸"
+    0x00080000,  // "¹"
+    0x000C0000,  // "º"
+    0x00100000,  // "»"
+    0x00140000,  // "¼"
+    0x00140000,  // "½"
+    0x00140000,  // "¾"
+    0x000E0000,  // "¿"
+    0x000E0000,  // "À"
+    0x000E0000,  // "Á"
+    0x000E0000,  // "Â"
+    0x000E0000,  // "Ã"
+    0x000E0000,  // "Ä"
+    0x000E0000,  // "Å"
+    0x00140000,  // "Æ"
+    0x000E0000,  // "Ç"
+    0x000C0000,  // "È"
+    0x000C0000,  // "É"
+    0x000C0000,  // "Ê"
+    0x000C0000,  // "Ë"
+    0x00060000,  // "Ì"
+    0x00060000,  // "Í"
+    0x000A0000,  // "Î"
+    0x000A0000,  // "Ï"
+    0x00120000,  // "Ð"
+    0x00100000,  // "Ñ"
+    0x000E0000,  // "Ò"
+    0x000E0000,  // "Ó"
+    0x000E0000,  // "Ô"
+    0x000E0000,  // "Õ"
+    0x000E0000,  // "Ö"
+    0x000C0000,  // "×"
+    0x00140000,  // "Ø"
+    0x000E0000,  // "Ù"
+    0x000E0000,  // "Ú"
+    0x000E0000,  // "Û"
+    0x000E0000,  // "Ü"
+    0x000E0000,  // "Ý"
+    0x00100000,  // "Þ"
+    0x00100000,  // "ß"
+    0x000E0000,  // "à"
+    0x000E0000,  // "á"
+    0x000E0000,  // "â"
+    0x000E0000,  // "ã"
+    0x000E0000,  // "ä"
+    0x000E0000,  // "å"
+    0x00160000,  // "æ"
+    0x000E0000,  // "ç"
+    0x000E0000,  // "è"
+    0x000E0000,  // "é"
+    0x000E0000,  // "ê"
+    0x000E0000,  // "ë"
+    0x00060000,  // "ì"
+    0x00060000,  // "í"
+    0x000A0000,  // "î"
+    0x000A0000,  // "ï"
+    0x000E0000,  // "ð"
+    0x000E0000,  // "ñ"
+    0x000E0000,  // "ò"
+    0x000E0000,  // "ó"
+    0x000E0000,  // "ô"
+    0x000E0000,  // "õ"
+    0x000E0000,  // "ö"
+    0x000C0000,  // "÷"
+    0x00120000,  // "ø"
+    0x000E0000,  // "ù"
+    0x000E0000,  // "ú"
+    0x000E0000,  // "û"
+    0x000E0000,  // "ü"
+    0x000E0000,  // "ý"
+    0x000E0000,  // "þ"
+    0x000E0000,  // "ÿ"
+    0x00140000,  // "Œ"
+    0x00160000,  // "œ"
+    0x00060000,  // "‘"
+    0x00060000,  // "’"
+    0x00060000,  // "‚"
+    0x00060000,  // "‛"
+    0x000C0000,  // "“"
+    0x000C0000,  // "”"
+    0x000C0000,  // "„"
+    0x000C0000,  // "‟"
+    0x00080000,  // "†"
+    0x00080000,  // "‡"
+    0x000C0000,  // "•"
+    0x00120000,  // "€"
+    0x001A0000,  // "\uE700" Battery_05
+    0x001A0000,  // "\uE701" Battery_25
+    0x001A0000,  // "\uE702" Battery_50
+    0x001A0000,  // "\uE703" Battery_75
+    0x001A0000,  // "\uE704" Battery_99
+    0x00170000,  // "\uE705" Radio_3
+    0x00170000,  // "\uE706" Radio_2
+    0x00170000,  // "\uE707" Radio_1
+    0x00170000,  // "\uE708" Radio_0
+    0x00170000,  // "\uE709" Radio_Off
+    0x000C0000,  // "\uE70A" Shift_Arrow
+    0x001C0000,  // "\uE70B" Backspace_Symbol
+    0x001A0000,  // "\uE70C" Enter_Symbol
+    0x00160000,  // "�"
+];
+
+/// Return the kerning adjustment in pixels to add to the advance of the glyph
+/// with its blit pattern at DATA[left] when the glyph at DATA[right] follows it.
+/// Negative adjustments move the glyphs closer together.
+pub fn get_kerning(left: usize, right: usize) -> isize {
+    let key = ((left as u32) << 16) | right as u32;
+    match KERN_KEY.binary_search(&key) {
+        Ok(index) => KERN_ADJUST[index] as isize,
+        _ => 0,
+    }
+}
+
+/// Kerning pairs as ((left DATA offset) << 16) | (right DATA offset), sorted
+const KERN_KEY: [u32; 28] = [
+    0x00F60049,  // "F" ","
+    0x00F6004D,  // "F" "."
+    0x01240006,  // "L" "\""
+    0x01240031,  // "L" "'"
+    0x0124016A,  // "L" "T"
+    0x01240197,  // "L" "Y"
+    0x01490049,  // "P" ","
+    0x0149004D,  // "P" "."
+    0x016A0049,  // "T" ","
+    0x016A004B,  // "T" "-"
+    0x016A004D,  // "T" "."
+    0x016A00A6,  // "T" ":"
+    0x016A00A9,  // "T" ";"
+    0x016A01C3,  // "T" "a"
+    0x016A01D2,  // "T" "c"
+    0x016A01E0,  // "T" "e"
+    0x016A0229,  // "T" "o"
+    0x016A0240,  // "T" "r"
+    0x016A0246,  // "T" "s"
+    0x016A0252,  // "T" "u"
+    0x016A0260,  // "T" "w"
+    0x016A0271,  // "T" "y"
+    0x01970049,  // "Y" ","
+    0x0197004D,  // "Y" "."
+    0x01E70049,  // "f" ","
+    0x01E7004D,  // "f" "."
+    0x02400049,  // "r" ","
+    0x0240004D,  // "r" "."
+];
+
+/// Kerning adjustments in pixels; sort matches KERN_KEY
+const KERN_ADJUST: [i8; 28] = [
+    -2,   // "F" ","
+    -2,   // "F" "."
+    -2,   // "L" "\""
+    -2,   // "L" "'"
+    -2,   // "L" "T"
+    -2,   // "L" "Y"
+    -4,   // "P" ","
+    -4,   // "P" "."
+    -2,   // "T" ","
+    -2,   // "T" "-"
+    -2,   // "T" "."
+    -2,   // "T" ":"
+    -2,   // "T" ";"
+    -2,   // "T" "a"
+    -2,   // "T" "c"
+    -2,   // "T" "e"
+    -2,   // "T" "o"
+    -2,   // "T" "r"
+    -2,   // "T" "s"
+    -2,   // "T" "u"
+    -2,   // "T" "w"
+    -2,   // "T" "y"
+    -2,   // "Y" ","
+    -2,   // "Y" "."
+    -2,   // "f" ","
+    -2,   // "f" "."
+    -2,   // "r" ","
+    -2,   // "r" "."
+];
+
 /// Packed glyph pattern data.
 /// Record format:
 ///  [offset+0]: ((w as u8) << 16) | ((h as u8) << 8) | (yOffset as u8)
@@ -30,6 +30,13 @@
 /// This will be true: h + y_offset <= MAX_HEIGHT
 pub const MAX_HEIGHT: u8 = 32;
 
+/// Distance in pixels from the top of the line down to the baseline
+pub const ASCENT: u8 = 26;
+
+/// Distance in pixels from the baseline down to the bottom of the line.
+/// This will be true: ASCENT + DESCENT == MAX_HEIGHT
+pub const DESCENT: u8 = 6;
+
 /// Seed for Murmur3 hashes in the HASH_* index arrays
 pub const M3_SEED: u32 = 0;
 
@@ -5892,7 +5899,7 @@ const HASH_TRANSPORT_AND_MAP_SYMBOLS: [u32; 202] = [
     0x54299EB4,  // "🚮"
     0x5590BBDE,  // "🛍️" 1F6CD-FE0F
     0x572FA28E,  // "🚶🏻" 1F6B6-1F3FB
-    0x57F7D479,  // "\U0001f6d7"
+    0x57F7D479,  // "🛗"
     0x5874FC29,  // "🚣\u200d♀️" 1F6A3-200D-2640-FE0F
     0x58E883E7,  // "🚴🏻\u200d♀️" 1F6B4-1F3FB-200D-2640-FE0F
     0x5B1BD1BA,  // "🛩"
@@ -5938,7 +5945,7 @@ const HASH_TRANSPORT_AND_MAP_SYMBOLS: [u32; 202] = [
     0x9840A988,  // "🛏"
     0x9B7C206E,  // "🚹"
     0x9CA78196,  // "🛅"
-    0xA3DF547A,  // "\U0001f6fb"
+    0xA3DF547A,  // "🛻"
     0xA594E73B,  // "🛣️" 1F6E3-FE0F
     0xA5B1A73C,  // "🚭️" 1F6AD-FE0F
     0xA6423163,  // "🚑"
@@ -5992,7 +5999,7 @@ const HASH_TRANSPORT_AND_MAP_SYMBOLS: [u32; 202] = [
     0xE150BED1,  // "🛋️" 1F6CB-FE0F
     0xE4A19C97,  // "🚶🏽\u200d♀️" 1F6B6-1F3FD-200D-2640-FE0F
     0xE51D4A54,  // "🚀"
-    0xE5DD8C6B,  // "\U0001f6fc"
+    0xE5DD8C6B,  // "🛼"
     0xEA779037,  // "🚣🏼\u200d♂️" 1F6A3-1F3FC-200D-2642-FE0F
     0xEAFABF90,  // "🚵🏼" 1F6B5-1F3FC
     0xEB74528C,  // "🚷"
@@ -6004,7 +6011,7 @@ const HASH_TRANSPORT_AND_MAP_SYMBOLS: [u32; 202] = [
     0xF22B9A22,  // "🚣🏼\u200d♀️" 1F6A3-1F3FC-200D-2640-FE0F
     0xF42AFA2D,  // "🚣🏾\u200d♀️" 1F6A3-1F3FE-200D-2640-FE0F
     0xF4480EB4,  // "🚶🏻\u200d♂️" 1F6B6-1F3FB-200D-2642-FE0F
-    0xF544AB29,  // "\U0001f6d6"
+    0xF544AB29,  // "🛖"
     0xF802D37D,  // "🚴🏻\u200d♂️" 1F6B4-1F3FB-200D-2642-FE0F
     0xF80EF49C,  // "🛒"
     0xF8C121C6,  // "🚉"
@@ -6098,7 +6105,7 @@ const OFFSET_TRANSPORT_AND_MAP_SYMBOLS: [usize; 202] = [
     56405, // "🚮"
     58784, // "🛍️" 1F6CD-FE0F
     57757, // "🚶🏻" 1F6B6-1F3FB
-    59003, // "\U0001f6d7"
+    59003, // "🛗"
     56074, // "🚣\u200d♀️" 1F6A3-200D-2640-FE0F
     56591, // "🚴🏻\u200d♀️" 1F6B4-1F3FB-200D-2640-FE0F
     59199, // "🛩"
@@ -6144,7 +6151,7 @@ const OFFSET_TRANSPORT_AND_MAP_SYMBOLS: [usize; 202] = [
     58839, // "🛏"
     58072, // "🚹"
     58595, // "🛅"
-    59533, // "\U0001f6fb"
+    59533, // "🛻"
     59115, // "🛣️" 1F6E3-FE0F
     56372, // "🚭️" 1F6AD-FE0F
     55183, // "🚑"
@@ -6198,7 +6205,7 @@ const OFFSET_TRANSPORT_AND_MAP_SYMBOLS: [usize; 202] = [
     58628, // "🛋️" 1F6CB-FE0F
     57820, // "🚶🏽\u200d♀️" 1F6B6-1F3FD-200D-2640-FE0F
     54710, // "🚀"
-    59554, // "\U0001f6fc"
+    59554, // "🛼"
     55775, // "🚣🏼\u200d♂️" 1F6A3-1F3FC-200D-2642-FE0F
     57317, // "🚵🏼" 1F6B5-1F3FC
     58010, // "🚷"
@@ -6210,7 +6217,7 @@ const OFFSET_TRANSPORT_AND_MAP_SYMBOLS: [usize; 202] = [
     55748, // "🚣🏼\u200d♀️" 1F6A3-1F3FC-200D-2640-FE0F
     55910, // "🚣🏾\u200d♀️" 1F6A3-1F3FE-200D-2640-FE0F
     57742, // "🚶🏻\u200d♂️" 1F6B6-1F3FB-200D-2642-FE0F
-    58975, // "\U0001f6d6"
+    58975, // "🛖"
     56623, // "🚴🏻\u200d♂️" 1F6B4-1F3FB-200D-2642-FE0F
     58920, // "🛒"
     54963, // "🚉"
@@ -6292,7 +6299,7 @@ const HASH_SUPPLEMENTAL_SYMBOLS_AND_PICTOGRAPHS: [u32; 882] = [
     0x04A58EEF,  // "🦹🏻\u200d♂️" 1F9B9-1F3FB-200D-2642-FE0F
     0x04FA0639,  // "🤶🏼" 1F936-1F3FC
     0x05433731,  // "🤷🏿" 1F937-1F3FF
-    0x0556BFDD,  // "\U0001f90c🏻" 1F90C-1F3FB
+    0x0556BFDD,  // "🤌🏻" 1F90C-1F3FB
     0x05C4F0FF,  // "🧏🏽\u200d♀️" 1F9CF-1F3FD-200D-2640-FE0F
     0x05DE3A27,  // "🤵🏾" 1F935-1F3FE
     0x0602A2DA,  // "🧑🏻\u200d🤝\u200d🧑🏼" 1F9D1-1F3FB-200D-1F91D-200D-1F9D1-1F3FC
@@ -6305,7 +6312,7 @@ const HASH_SUPPLEMENTAL_SYMBOLS_AND_PICTOGRAPHS: [u32; 882] = [
     0x07AA8164,  // "🤘🏽" 1F918-1F3FD
     0x07D46D88,  // "🧝🏻\u200d♀️" 1F9DD-1F3FB-200D-2640-FE0F
     0x07DB1208,  // "🧑🏿\u200d🔬" 1F9D1-1F3FF-200D-1F52C
-    0x0875BACF,  // "\U0001f90c🏽" 1F90C-1F3FD
+    0x0875BACF,  // "🤌🏽" 1F90C-1F3FD
     0x08FB2094,  // "🧏🏾\u200d♀️" 1F9CF-1F3FE-200D-2640-FE0F
     0x094E5EB3,  // "🦹"
     0x09C209F7,  // "🦵🏽" 1F9B5-1F3FD
@@ -6393,7 +6400,7 @@ const HASH_SUPPLEMENTAL_SYMBOLS_AND_PICTOGRAPHS: [u32; 882] = [
     0x26414A9C,  // "🧑🏿\u200d🦽" 1F9D1-1F3FF-200D-1F9BD
     0x26D6D659,  // "🧑🏼\u200d🎓" 1F9D1-1F3FC-200D-1F393
     0x26DB42C5,  // "🦔"
-    0x26DE7419,  // "\U0001f9ac"
+    0x26DE7419,  // "🦬"
     0x26E2F466,  // "🤏"
     0x2748D4CA,  // "🧑🏿\u200d💼" 1F9D1-1F3FF-200D-1F4BC
     0x27A5FB53,  // "🤲"
@@ -6432,7 +6439,7 @@ const HASH_SUPPLEMENTAL_SYMBOLS_AND_PICTOGRAPHS: [u32; 882] = [
     0x2F0DAF50,  // "🦸🏼\u200d♂️" 1F9B8-1F3FC-200D-2642-FE0F
     0x2F36665C,  // "🧏🏾" 1F9CF-1F3FE
     0x2F6A4E2F,  // "🧛🏿\u200d♂️" 1F9DB-1F3FF-200D-2642-FE0F
-    0x300B2E98,  // "\U0001f972"
+    0x300B2E98,  // "🥲"
     0x307C8809,  // "🥽"
     0x30DB65A3,  // "🧛🏻" 1F9DB-1F3FB
     0x314322EB,  // "🧟"
@@ -6554,7 +6561,7 @@ const HASH_SUPPLEMENTAL_SYMBOLS_AND_PICTOGRAPHS: [u32; 882] = [
     0x5173D4A4,  // "🤦\u200d♀️" 1F926-200D-2640-FE0F
     0x517D12F3,  // "🤛🏽" 1F91B-1F3FD
     0x51AA81AE,  // "🧍🏼" 1F9CD-1F3FC
-    0x51DB3F96,  // "\U0001f90c🏿" 1F90C-1F3FF
+    0x51DB3F96,  // "🤌🏿" 1F90C-1F3FF
     0x52076930,  // "🦀"
     0x5271289F,  // "🧑\u200d🚒" 1F9D1-200D-1F692
     0x52B72AE7,  // "🧑🏻\u200d✈️" 1F9D1-1F3FB-200D-2708-FE0F
@@ -6587,7 +6594,7 @@ const HASH_SUPPLEMENTAL_SYMBOLS_AND_PICTOGRAPHS: [u32; 882] = [
     0x591FAF33,  // "🤘🏼" 1F918-1F3FC
     0x5928170E,  // "🤾🏻\u200d♀️" 1F93E-1F3FB-200D-2640-FE0F
     0x594CE576,  // "🧑🏿\u200d🦲" 1F9D1-1F3FF-200D-1F9B2
-    0x59EF517D,  // "\U0001f978"
+    0x59EF517D,  // "🥸"
     0x5A434EDC,  // "🤾🏿" 1F93E-1F3FF
     0x5A5B5F49,  // "🧑🏻\u200d⚕️" 1F9D1-1F3FB-200D-2695-FE0F
     0x5A8FD6E6,  // "🤦🏼\u200d♂️" 1F926-1F3FC-200D-2642-FE0F
@@ -6618,7 +6625,7 @@ const HASH_SUPPLEMENTAL_SYMBOLS_AND_PICTOGRAPHS: [u32; 882] = [
     0x649F34D9,  // "🦶🏿" 1F9B6-1F3FF
     0x64B020E2,  // "🧚🏿\u200d♀️" 1F9DA-1F3FF-200D-2640-FE0F
     0x64E68819,  // "🥟"
-    0x65892314,  // "\U0001f977"
+    0x65892314,  // "🥷"
     0x65BD021F,  // "🧖🏻\u200d♀️" 1F9D6-1F3FB-200D-2640-FE0F
     0x65E6A52C,  // "🦹🏼\u200d♀️" 1F9B9-1F3FC-200D-2640-FE0F
     0x65F13977,  // "🧝🏽" 1F9DD-1F3FD
@@ -6671,7 +6678,7 @@ const HASH_SUPPLEMENTAL_SYMBOLS_AND_PICTOGRAPHS: [u32; 882] = [
     0x72D07B29,  // "🧑🏽\u200d⚖️" 1F9D1-1F3FD-200D-2696-FE0F
     0x730C5672,  // "🧝🏾\u200d♀️" 1F9DD-1F3FE-200D-2640-FE0F
     0x7344F757,  // "🧑🏼\u200d🌾" 1F9D1-1F3FC-200D-1F33E
-    0x73B2F6F9,  // "\U0001f977🏼" 1F977-1F3FC
+    0x73B2F6F9,  // "🥷🏼" 1F977-1F3FC
     0x73CAC324,  // "🧚🏿\u200d♂️" 1F9DA-1F3FF-200D-2642-FE0F
     0x74680DDC,  // "🤾\u200d♀️" 1F93E-200D-2640-FE0F
     0x747FDC37,  // "🦇"
@@ -6743,7 +6750,7 @@ const HASH_SUPPLEMENTAL_SYMBOLS_AND_PICTOGRAPHS: [u32; 882] = [
     0x85616479,  // "🦸🏼\u200d♀️" 1F9B8-1F3FC-200D-2640-FE0F
     0x856AD6AE,  // "🧑🏼\u200d🎤" 1F9D1-1F3FC-200D-1F3A4
     0x85C30BFC,  // "🤶🏽" 1F936-1F3FD
-    0x85C9916F,  // "\U0001f977🏽" 1F977-1F3FD
+    0x85C9916F,  // "🥷🏽" 1F977-1F3FD
     0x86F941C3,  // "🧘🏿" 1F9D8-1F3FF
     0x86FA1B9B,  // "🤟🏿" 1F91F-1F3FF
     0x8742F588,  // "🧑🏽\u200d🚒" 1F9D1-1F3FD-200D-1F692
@@ -6793,8 +6800,8 @@ const HASH_SUPPLEMENTAL_SYMBOLS_AND_PICTOGRAPHS: [u32; 882] = [
     0x957ECED8,  // "🧍🏻\u200d♂️" 1F9CD-1F3FB-200D-2642-FE0F
     0x96C3476A,  // "🧑🏾\u200d🦽" 1F9D1-1F3FE-200D-1F9BD
     0x97086F30,  // "🦉"
-    0x977D1458,  // "\U0001f9ad"
-    0x978E8659,  // "\U0001f9cb"
+    0x977D1458,  // "🦭"
+    0x978E8659,  // "🧋"
     0x97DF4A6A,  // "🦸🏾\u200d♀️" 1F9B8-1F3FE-200D-2640-FE0F
     0x984D644F,  // "🦸🏿" 1F9B8-1F3FF
     0x98545BA2,  // "🧑🏻\u200d⚖️" 1F9D1-1F3FB-200D-2696-FE0F
@@ -6804,7 +6811,7 @@ const HASH_SUPPLEMENTAL_SYMBOLS_AND_PICTOGRAPHS: [u32; 882] = [
     0x9930B16F,  // "🧑🏻\u200d🏭" 1F9D1-1F3FB-200D-1F3ED
     0x99315C0A,  // "🤽🏿\u200d♀️" 1F93D-1F3FF-200D-2640-FE0F
     0x993A8876,  // "🤞🏼" 1F91E-1F3FC
-    0x994B5ECA,  // "\U0001f9a4"
+    0x994B5ECA,  // "🦤"
     0x9981BCF5,  // "🧪"
     0x998B4A6D,  // "🤵🏼\u200d♂️" 1F935-1F3FC-200D-2642-FE0F
     0x9A25553F,  // "🤵🏼\u200d♀️" 1F935-1F3FC-200D-2640-FE0F
@@ -6813,7 +6820,7 @@ const HASH_SUPPLEMENTAL_SYMBOLS_AND_PICTOGRAPHS: [u32; 882] = [
     0x9AC8E3BB,  // "🧏🏿\u200d♀️" 1F9CF-1F3FF-200D-2640-FE0F
     0x9ADED966,  // "🤙🏾" 1F919-1F3FE
     0x9B0ED4F9,  // "🦹🏿\u200d♀️" 1F9B9-1F3FF-200D-2640-FE0F
-    0x9B545046,  // "\U0001f9a3"
+    0x9B545046,  // "🦣"
     0x9B82726E,  // "🧑🏿\u200d🤝\u200d🧑🏻" 1F9D1-1F3FF-200D-1F91D-200D-1F9D1-1F3FB
     0x9C13656B,  // "🧏🏿" 1F9CF-1F3FF
     0x9C2E07C7,  // "🥙"
@@ -6823,7 +6830,7 @@ const HASH_SUPPLEMENTAL_SYMBOLS_AND_PICTOGRAPHS: [u32; 882] = [
     0x9D0D0060,  // "🧑🏿\u200d🏭" 1F9D1-1F3FF-200D-1F3ED
     0x9D2D55E8,  // "🧘🏽\u200d♂️" 1F9D8-1F3FD-200D-2642-FE0F
     0x9D399917,  // "🤏🏿" 1F90F-1F3FF
-    0x9D457955,  // "\U0001f9ab"
+    0x9D457955,  // "🦫"
     0x9EEAB105,  // "🤰🏿" 1F930-1F3FF
     0x9F30279C,  // "🤙🏼" 1F919-1F3FC
     0x9F52590F,  // "🧑🏾\u200d🌾" 1F9D1-1F3FE-200D-1F33E
@@ -6856,7 +6863,7 @@ const HASH_SUPPLEMENTAL_SYMBOLS_AND_PICTOGRAPHS: [u32; 882] = [
     0xA61EA102,  // "🧑🏾\u200d⚖️" 1F9D1-1F3FE-200D-2696-FE0F
     0xA675A6F5,  // "🧑🏾\u200d🦳" 1F9D1-1F3FE-200D-1F9B3
     0xA6A8D391,  // "🤽🏻\u200d♀️" 1F93D-1F3FB-200D-2640-FE0F
-    0xA6B38DF9,  // "\U0001f977🏿" 1F977-1F3FF
+    0xA6B38DF9,  // "🥷🏿" 1F977-1F3FF
     0xA6C6E2BE,  // "🧏🏾\u200d♂️" 1F9CF-1F3FE-200D-2642-FE0F
     0xA70ACB2C,  // "🧑🏿\u200d🍳" 1F9D1-1F3FF-200D-1F373
     0xA71996A4,  // "🤦🏿" 1F926-1F3FF
@@ -6951,7 +6958,7 @@ const HASH_SUPPLEMENTAL_SYMBOLS_AND_PICTOGRAPHS: [u32; 882] = [
     0xBDD10844,  // "🤸\u200d♂️" 1F938-200D-2642-FE0F
     0xBDDE3A32,  // "🧑🏽\u200d🤝\u200d🧑🏿" 1F9D1-1F3FD-200D-1F91D-200D-1F9D1-1F3FF
     0xBE23ECCB,  // "🧘🏻\u200d♂️" 1F9D8-1F3FB-200D-2642-FE0F
-    0xBEB718F4,  // "\U0001f90c"
+    0xBEB718F4,  // "🤌"
     0xBF360185,  // "🧑🏿\u200d🔧" 1F9D1-1F3FF-200D-1F527
     0xBF6F5189,  // "🧑🏽\u200d🤝\u200d🧑🏾" 1F9D1-1F3FD-200D-1F91D-200D-1F9D1-1F3FE
     0xBFE923E2,  // "🤱🏾" 1F931-1F3FE
@@ -7031,7 +7038,7 @@ const HASH_SUPPLEMENTAL_SYMBOLS_AND_PICTOGRAPHS: [u32; 882] = [
     0xD9A11C42,  // "🦸🏽\u200d♀️" 1F9B8-1F3FD-200D-2640-FE0F
     0xD9A9AB29,  // "🤞🏽" 1F91E-1F3FD
     0xD9F0008C,  // "🧜\u200d♀️" 1F9DC-200D-2640-FE0F
-    0xD9F08FEF,  // "\U0001f977🏾" 1F977-1F3FE
+    0xD9F08FEF,  // "🥷🏾" 1F977-1F3FE
     0xD9F74EBE,  // "🧑🏻\u200d🎤" 1F9D1-1F3FB-200D-1F3A4
     0xDA36A68E,  // "🥓"
     0xDA4BC6AD,  // "🧍🏿" 1F9CD-1F3FF
@@ -7058,7 +7065,7 @@ const HASH_SUPPLEMENTAL_SYMBOLS_AND_PICTOGRAPHS: [u32; 882] = [
     0xDED16434,  // "🦓"
     0xDF212789,  // "🧑🏽\u200d🎨" 1F9D1-1F3FD-200D-1F3A8
     0xDF311DB9,  // "🤾🏾\u200d♂️" 1F93E-1F3FE-200D-2642-FE0F
-    0xDF3BCC09,  // "\U0001f977🏻" 1F977-1F3FB
+    0xDF3BCC09,  // "🥷🏻" 1F977-1F3FB
     0xDF81BEC8,  // "🧏\u200d♀️" 1F9CF-200D-2640-FE0F
     0xDFA52C6E,  // "🦢"
     0xDFB769DE,  // "🧛🏾" 1F9DB-1F3FE
@@ -7081,7 +7088,7 @@ const HASH_SUPPLEMENTAL_SYMBOLS_AND_PICTOGRAPHS: [u32; 882] = [
     0xE6B8AAD3,  // "🤚"
     0xE7AA5806,  // "🧚🏻" 1F9DA-1F3FB
     0xE7E8BC6A,  // "🦁"
-    0xE81968F6,  // "\U0001f90c🏾" 1F90C-1F3FE
+    0xE81968F6,  // "🤌🏾" 1F90C-1F3FE
     0xE8674739,  // "🧏🏼" 1F9CF-1F3FC
     0xE8931AC3,  // "🤽🏻" 1F93D-1F3FB
     0xE8C6EDED,  // "🤗"
@@ -7132,7 +7139,7 @@ const HASH_SUPPLEMENTAL_SYMBOLS_AND_PICTOGRAPHS: [u32; 882] = [
     0xF8AF1BA0,  // "🤞"
     0xF8C763C3,  // "🧑🏽\u200d🎤" 1F9D1-1F3FD-200D-1F3A4
     0xF8CD80FA,  // "🦹🏽\u200d♀️" 1F9B9-1F3FD-200D-2640-FE0F
-    0xF9303BA8,  // "\U0001f90c🏼" 1F90C-1F3FC
+    0xF9303BA8,  // "🤌🏼" 1F90C-1F3FC
     0xF93C660B,  // "🥋"
     0xF93D9129,  // "🧑\u200d🍼" 1F9D1-200D-1F37C
     0xF978896E,  // "🥡"
@@ -7178,7 +7185,7 @@ const OFFSET_SUPPLEMENTAL_SYMBOLS_AND_PICTOGRAPHS: [usize; 882] = [
     70490, // "🦹🏻\u200d♂️" 1F9B9-1F3FB-200D-2642-FE0F
     63575, // "🤶🏼" 1F936-1F3FC
     64121, // "🤷🏿" 1F937-1F3FF
-    59969, // "\U0001f90c🏻" 1F90C-1F3FB
+    59969, // "🤌🏻" 1F90C-1F3FB
     72279, // "🧏🏽\u200d♀️" 1F9CF-1F3FD-200D-2640-FE0F
     63377, // "🤵🏾" 1F935-1F3FE
     73138, // "🧑🏻\u200d🤝\u200d🧑🏼" 1F9D1-1F3FB-200D-1F91D-200D-1F9D1-1F3FC
@@ -7191,7 +7198,7 @@ const OFFSET_SUPPLEMENTAL_SYMBOLS_AND_PICTOGRAPHS: [usize; 882] = [
     60615, // "🤘🏽" 1F918-1F3FD
     81827, // "🧝🏻\u200d♀️" 1F9DD-1F3FB-200D-2640-FE0F
     76481, // "🧑🏿\u200d🔬" 1F9D1-1F3FF-200D-1F52C
-    60022, // "\U0001f90c🏽" 1F90C-1F3FD
+    60022, // "🤌🏽" 1F90C-1F3FD
     72366, // "🧏🏾\u200d♀️" 1F9CF-1F3FE-200D-2640-FE0F
     70917, // "🦹"
     69712, // "🦵🏽" 1F9B5-1F3FD
@@ -7279,7 +7286,7 @@ const OFFSET_SUPPLEMENTAL_SYMBOLS_AND_PICTOGRAPHS: [usize; 882] = [
     76859, // "🧑🏿\u200d🦽" 1F9D1-1F3FF-200D-1F9BD
     73635, // "🧑🏼\u200d🎓" 1F9D1-1F3FC-200D-1F393
     68750, // "🦔"
-    69409, // "\U0001f9ac"
+    69409, // "🦬"
     60306, // "🤏"
     76417, // "🧑🏿\u200d💼" 1F9D1-1F3FF-200D-1F4BC
     62811, // "🤲"
@@ -7318,7 +7325,7 @@ const OFFSET_SUPPLEMENTAL_SYMBOLS_AND_PICTOGRAPHS: [usize; 882] = [
     70056, // "🦸🏼\u200d♂️" 1F9B8-1F3FC-200D-2642-FE0F
     72424, // "🧏🏾" 1F9CF-1F3FE
     81159, // "🧛🏿\u200d♂️" 1F9DB-1F3FF-200D-2642-FE0F
-    67700, // "\U0001f972"
+    67700, // "🥲"
     68121, // "🥽"
     80868, // "🧛🏻" 1F9DB-1F3FB
     82364, // "🧟"
@@ -7440,7 +7447,7 @@ const OFFSET_SUPPLEMENTAL_SYMBOLS_AND_PICTOGRAPHS: [usize; 882] = [
     62097, // "🤦\u200d♀️" 1F926-200D-2640-FE0F
     61043, // "🤛🏽" 1F91B-1F3FD
     71585, // "🧍🏼" 1F9CD-1F3FC
-    60075, // "\U0001f90c🏿" 1F90C-1F3FF
+    60075, // "🤌🏿" 1F90C-1F3FF
     68173, // "🦀"
     77412, // "🧑\u200d🚒" 1F9D1-200D-1F692
     73478, // "🧑🏻\u200d✈️" 1F9D1-1F3FB-200D-2708-FE0F
@@ -7473,7 +7480,7 @@ const OFFSET_SUPPLEMENTAL_SYMBOLS_AND_PICTOGRAPHS: [usize; 882] = [
     60597, // "🤘🏼" 1F918-1F3FC
     65931, // "🤾🏻\u200d♀️" 1F93E-1F3FB-200D-2640-FE0F
     76788, // "🧑🏿\u200d🦲" 1F9D1-1F3FF-200D-1F9B2
-    68007, // "\U0001f978"
+    68007, // "🥸"
     66271, // "🤾🏿" 1F93E-1F3FF
     73422, // "🧑🏻\u200d⚕️" 1F9D1-1F3FB-200D-2695-FE0F
     61838, // "🤦🏼\u200d♂️" 1F926-1F3FC-200D-2642-FE0F
@@ -7504,7 +7511,7 @@ const OFFSET_SUPPLEMENTAL_SYMBOLS_AND_PICTOGRAPHS: [usize; 882] = [
     69879, // "🦶🏿" 1F9B6-1F3FF
     80642, // "🧚🏿\u200d♀️" 1F9DA-1F3FF-200D-2640-FE0F
     67197, // "🥟"
-    67983, // "\U0001f977"
+    67983, // "🥷"
     78285, // "🧖🏻\u200d♀️" 1F9D6-1F3FB-200D-2640-FE0F
     70550, // "🦹🏼\u200d♀️" 1F9B9-1F3FC-200D-2640-FE0F
     82018, // "🧝🏽" 1F9DD-1F3FD
@@ -7557,7 +7564,7 @@ const OFFSET_SUPPLEMENTAL_SYMBOLS_AND_PICTOGRAPHS: [usize; 882] = [
     75171, // "🧑🏽\u200d⚖️" 1F9D1-1F3FD-200D-2696-FE0F
     82041, // "🧝🏾\u200d♀️" 1F9DD-1F3FE-200D-2640-FE0F
     73526, // "🧑🏼\u200d🌾" 1F9D1-1F3FC-200D-1F33E
-    67884, // "\U0001f977🏼" 1F977-1F3FC
+    67884, // "🥷🏼" 1F977-1F3FC
     80670, // "🧚🏿\u200d♂️" 1F9DA-1F3FF-200D-2642-FE0F
     66298, // "🤾\u200d♀️" 1F93E-200D-2640-FE0F
     68375, // "🦇"
@@ -7629,7 +7636,7 @@ const OFFSET_SUPPLEMENTAL_SYMBOLS_AND_PICTOGRAPHS: [usize; 882] = [
     70030, // "🦸🏼\u200d♀️" 1F9B8-1F3FC-200D-2640-FE0F
     73660, // "🧑🏼\u200d🎤" 1F9D1-1F3FC-200D-1F3A4
     63600, // "🤶🏽" 1F936-1F3FD
-    67908, // "\U0001f977🏽" 1F977-1F3FD
+    67908, // "🥷🏽" 1F977-1F3FD
     79696, // "🧘🏿" 1F9D8-1F3FF
     61498, // "🤟🏿" 1F91F-1F3FF
     74803, // "🧑🏽\u200d🚒" 1F9D1-1F3FD-200D-1F692
@@ -7679,8 +7686,8 @@ const OFFSET_SUPPLEMENTAL_SYMBOLS_AND_PICTOGRAPHS: [usize; 882] = [
     71540, // "🧍🏻\u200d♂️" 1F9CD-1F3FB-200D-2642-FE0F
     75995, // "🧑🏾\u200d🦽" 1F9D1-1F3FE-200D-1F9BD
     68429, // "🦉"
-    69436, // "\U0001f9ad"
-    71508, // "\U0001f9cb"
+    69436, // "🦭"
+    71508, // "🧋"
     70203, // "🦸🏾\u200d♀️" 1F9B8-1F3FE-200D-2640-FE0F
     70349, // "🦸🏿" 1F9B8-1F3FF
     73448, // "🧑🏻\u200d⚖️" 1F9D1-1F3FB-200D-2696-FE0F
@@ -7690,7 +7697,7 @@ const OFFSET_SUPPLEMENTAL_SYMBOLS_AND_PICTOGRAPHS: [usize; 882] = [
     72895, // "🧑🏻\u200d🏭" 1F9D1-1F3FB-200D-1F3ED
     65742, // "🤽🏿\u200d♀️" 1F93D-1F3FF-200D-2640-FE0F
     61288, // "🤞🏼" 1F91E-1F3FC
-    69183, // "\U0001f9a4"
+    69183, // "🦤"
     82655, // "🧪"
     63206, // "🤵🏼\u200d♂️" 1F935-1F3FC-200D-2642-FE0F
     63182, // "🤵🏼\u200d♀️" 1F935-1F3FC-200D-2640-FE0F
@@ -7699,7 +7706,7 @@ const OFFSET_SUPPLEMENTAL_SYMBOLS_AND_PICTOGRAPHS: [usize; 882] = [
     72454, // "🧏🏿\u200d♀️" 1F9CF-1F3FF-200D-2640-FE0F
     60776, // "🤙🏾" 1F919-1F3FE
     70786, // "🦹🏿\u200d♀️" 1F9B9-1F3FF-200D-2640-FE0F
-    69156, // "\U0001f9a3"
+    69156, // "🦣"
     76567, // "🧑🏿\u200d🤝\u200d🧑🏻" 1F9D1-1F3FF-200D-1F91D-200D-1F9D1-1F3FB
     72512, // "🧏🏿" 1F9CF-1F3FF
     67046, // "🥙"
@@ -7709,7 +7716,7 @@ const OFFSET_SUPPLEMENTAL_SYMBOLS_AND_PICTOGRAPHS: [usize; 882] = [
     76354, // "🧑🏿\u200d🏭" 1F9D1-1F3FF-200D-1F3ED
     79539, // "🧘🏽\u200d♂️" 1F9D8-1F3FD-200D-2642-FE0F
     60281, // "🤏🏿" 1F90F-1F3FF
-    69383, // "\U0001f9ab"
+    69383, // "🦫"
     62518, // "🤰🏿" 1F930-1F3FF
     60719, // "🤙🏼" 1F919-1F3FC
     75251, // "🧑🏾\u200d🌾" 1F9D1-1F3FE-200D-1F33E
@@ -7742,7 +7749,7 @@ const OFFSET_SUPPLEMENTAL_SYMBOLS_AND_PICTOGRAPHS: [usize; 882] = [
     76049, // "🧑🏾\u200d⚖️" 1F9D1-1F3FE-200D-2696-FE0F
     75943, // "🧑🏾\u200d🦳" 1F9D1-1F3FE-200D-1F9B3
     65358, // "🤽🏻\u200d♀️" 1F93D-1F3FB-200D-2640-FE0F
-    67959, // "\U0001f977🏿" 1F977-1F3FF
+    67959, // "🥷🏿" 1F977-1F3FF
     72394, // "🧏🏾\u200d♂️" 1F9CF-1F3FE-200D-2642-FE0F
     76159, // "🧑🏿\u200d🍳" 1F9D1-1F3FF-200D-1F373
     62073, // "🤦🏿" 1F926-1F3FF
@@ -7837,7 +7844,7 @@ const OFFSET_SUPPLEMENTAL_SYMBOLS_AND_PICTOGRAPHS: [usize; 882] = [
     64619, // "🤸\u200d♂️" 1F938-200D-2642-FE0F
     74940, // "🧑🏽\u200d🤝\u200d🧑🏿" 1F9D1-1F3FD-200D-1F91D-200D-1F9D1-1F3FF
     79408, // "🧘🏻\u200d♂️" 1F9D8-1F3FB-200D-2642-FE0F
-    60104, // "\U0001f90c"
+    60104, // "🤌"
     76449, // "🧑🏿\u200d🔧" 1F9D1-1F3FF-200D-1F527
     74911, // "🧑🏽\u200d🤝\u200d🧑🏾" 1F9D1-1F3FD-200D-1F91D-200D-1F9D1-1F3FE
     62623, // "🤱🏾" 1F931-1F3FE
@@ -7917,7 +7924,7 @@ const OFFSET_SUPPLEMENTAL_SYMBOLS_AND_PICTOGRAPHS: [usize; 882] = [
     70115, // "🦸🏽\u200d♀️" 1F9B8-1F3FD-200D-2640-FE0F
     61306, // "🤞🏽" 1F91E-1F3FD
     81738, // "🧜\u200d♀️" 1F9DC-200D-2640-FE0F
-    67934, // "\U0001f977🏾" 1F977-1F3FE
+    67934, // "🥷🏾" 1F977-1F3FE
     72800, // "🧑🏻\u200d🎤" 1F9D1-1F3FB-200D-1F3A4
     66875, // "🥓"
     71688, // "🧍🏿" 1F9CD-1F3FF
@@ -7944,7 +7951,7 @@ const OFFSET_SUPPLEMENTAL_SYMBOLS_AND_PICTOGRAPHS: [usize; 882] = [
     68720, // "🦓"
     74556, // "🧑🏽\u200d🎨" 1F9D1-1F3FD-200D-1F3A8
     66172, // "🤾🏾\u200d♂️" 1F93E-1F3FE-200D-2642-FE0F
-    67859, // "\U0001f977🏻" 1F977-1F3FB
+    67859, // "🥷🏻" 1F977-1F3FB
     72542, // "🧏\u200d♀️" 1F9CF-200D-2640-FE0F
     69128, // "🦢"
     81105, // "🧛🏾" 1F9DB-1F3FE
@@ -7967,7 +7974,7 @@ const OFFSET_SUPPLEMENTAL_SYMBOLS_AND_PICTOGRAPHS: [usize; 882] = [
     60981, // "🤚"
     80353, // "🧚🏻" 1F9DA-1F3FB
     68204, // "🦁"
-    60048, // "\U0001f90c🏾" 1F90C-1F3FE
+    60048, // "🤌🏾" 1F90C-1F3FE
     72250, // "🧏🏼" 1F9CF-1F3FC
     65422, // "🤽🏻" 1F93D-1F3FB
     60547, // "🤗"
@@ -8018,7 +8025,7 @@ const OFFSET_SUPPLEMENTAL_SYMBOLS_AND_PICTOGRAPHS: [usize; 882] = [
     61364, // "🤞"
     74524, // "🧑🏽\u200d🎤" 1F9D1-1F3FD-200D-1F3A4
     70628, // "🦹🏽\u200d♀️" 1F9B9-1F3FD-200D-2640-FE0F
-    59994, // "\U0001f90c🏼" 1F90C-1F3FC
+    59994, // "🤌🏼" 1F90C-1F3FC
     66642, // "🥋"
     77056, // "🧑\u200d🍼" 1F9D1-200D-1F37C
     67251, // "🥡"
@@ -8056,126 +8063,6411 @@ fn find_symbols_and_pictographs_extended_a(cluster: &str, limit: u32) -> Option<
 
 /// Index of murmur3(grapheme cluster); sort matches OFFSET_SYMBOLS_AND_PICTOGRAPHS_EXTENDED_A
 const HASH_SYMBOLS_AND_PICTOGRAPHS_EXTENDED_A: [u32; 57] = [
-    0x0296B2E3,  // "\U0001fab5"
-    0x049BBC67,  // "\U0001faa4"
+    0x0296B2E3,  // "🪵"
+    0x049BBC67,  // "🪤"
     0x0524762A,  // "🩳"
-    0x0A1A6928,  // "\U0001fa9e"
-    0x0B66A480,  // "\U0001fa9a"
-    0x18EBAA23,  // "\U0001fa85"
-    0x1C11D7B6,  // "\U0001fa96"
+    0x0A1A6928,  // "🪞"
+    0x0B66A480,  // "🪚"
+    0x18EBAA23,  // "🪅"
+    0x1C11D7B6,  // "🪖"
     0x2069D861,  // "🩸"
-    0x2B248FAF,  // "\U0001faa1"
-    0x2FC6B5E2,  // "\U0001fa86"
-    0x304CA9C1,  // "\U0001faa7"
-    0x3B72AAF6,  // "\U0001fad4"
-    0x3C2FF8D0,  // "\U0001fad0"
-    0x3CC6DB29,  // "\U0001faa8"
-    0x3DA24B3A,  // "\U0001fac1"
+    0x2B248FAF,  // "🪡"
+    0x2FC6B5E2,  // "🪆"
+    0x304CA9C1,  // "🪧"
+    0x3B72AAF6,  // "🫔"
+    0x3C2FF8D0,  // "🫐"
+    0x3CC6DB29,  // "🪨"
+    0x3DA24B3A,  // "🫁"
     0x464D8BC4,  // "🪔"
-    0x4A0760A4,  // "\U0001fab2"
+    0x4A0760A4,  // "🪲"
     0x4AC863CC,  // "🪒"
     0x4B45D010,  // "🩺"
-    0x53FA6A26,  // "\U0001fab1"
-    0x57E83149,  // "\U0001fa9f"
-    0x581551B0,  // "\U0001fab6"
-    0x6293815B,  // "\U0001fa83"
+    0x53FA6A26,  // "🪱"
+    0x57E83149,  // "🪟"
+    0x581551B0,  // "🪶"
+    0x6293815B,  // "🪃"
     0x6555C91A,  // "🪐"
-    0x69E8CFB5,  // "\U0001fab3"
+    0x69E8CFB5,  // "🪳"
     0x7A346478,  // "🪀"
-    0x7FEADE75,  // "\U0001fa74"
-    0x8427B8BD,  // "\U0001fa9d"
-    0x85C72968,  // "\U0001faa0"
-    0x8BD06F70,  // "\U0001fab0"
+    0x7FEADE75,  // "🩴"
+    0x8427B8BD,  // "🪝"
+    0x85C72968,  // "🪠"
+    0x8BD06F70,  // "🪰"
     0x8CA9276C,  // "🩱"
-    0x8EA0E1A6,  // "\U0001fab4"
-    0x96E68A5C,  // "\U0001fac2"
+    0x8EA0E1A6,  // "🪴"
+    0x96E68A5C,  // "🫂"
     0xA1F6A0BF,  // "🪕"
-    0xA88BB5D0,  // "\U0001faa6"
-    0xABEAB4BE,  // "\U0001fa98"
+    0xA88BB5D0,  // "🪦"
+    0xABEAB4BE,  // "🪘"
     0xAC516601,  // "🩹"
-    0xAF7B7F7F,  // "\U0001faa2"
+    0xAF7B7F7F,  // "🪢"
     0xB6E2D80A,  // "🪁"
-    0xBE512ACC,  // "\U0001fa99"
-    0xC4788884,  // "\U0001fa84"
-    0xCA5516D3,  // "\U0001faa3"
-    0xCA7AEC06,  // "\U0001fad5"
-    0xCAA2ECAF,  // "\U0001fad3"
-    0xCB181854,  // "\U0001fa97"
-    0xCBA7A499,  // "\U0001fa9b"
-    0xCE1AF862,  // "\U0001fad1"
-    0xD29ABB28,  // "\U0001fad2"
+    0xBE512ACC,  // "🪙"
+    0xC4788884,  // "🪄"
+    0xCA5516D3,  // "🪣"
+    0xCA7AEC06,  // "🫕"
+    0xCAA2ECAF,  // "🫓"
+    0xCB181854,  // "🪗"
+    0xCBA7A499,  // "🪛"
+    0xCE1AF862,  // "🫑"
+    0xD29ABB28,  // "🫒"
     0xDA7B5E06,  // "🪑"
     0xDBAD5566,  // "🩲"
-    0xE0DC67EF,  // "\U0001faa5"
+    0xE0DC67EF,  // "🪥"
     0xE60B928B,  // "🪂"
     0xEC48623D,  // "🪓"
-    0xEE081BCD,  // "\U0001fad6"
-    0xF595BA4D,  // "\U0001fac0"
-    0xF91E2A8A,  // "\U0001fa9c"
+    0xEE081BCD,  // "🫖"
+    0xF595BA4D,  // "🫀"
+    0xF91E2A8A,  // "🪜"
     0xFE3C1358,  // "🩰"
 ];
 
 /// Lookup table of blit pattern offsets; sort matches HASH_SYMBOLS_AND_PICTOGRAPHS_EXTENDED_A
 const OFFSET_SYMBOLS_AND_PICTOGRAPHS_EXTENDED_A: [usize; 57] = [
-    84459, // "\U0001fab5"
-    84185, // "\U0001faa4"
+    84459, // "🪵"
+    84185, // "🪤"
     83340, // "🩳"
-    84020, // "\U0001fa9e"
-    83932, // "\U0001fa9a"
-    83609, // "\U0001fa85"
-    83817, // "\U0001fa96"
+    84020, // "🪞"
+    83932, // "🪚"
+    83609, // "🪅"
+    83817, // "🪖"
     83383, // "🩸"
-    84102, // "\U0001faa1"
-    83638, // "\U0001fa86"
-    84269, // "\U0001faa7"
-    84693, // "\U0001fad4"
-    84587, // "\U0001fad0"
-    84289, // "\U0001faa8"
-    84531, // "\U0001fac1"
+    84102, // "🪡"
+    83638, // "🪆"
+    84269, // "🪧"
+    84693, // "🫔"
+    84587, // "🫐"
+    84289, // "🪨"
+    84531, // "🫁"
     83756, // "🪔"
-    84372, // "\U0001fab2"
+    84372, // "🪲"
     83701, // "🪒"
     83432, // "🩺"
-    84345, // "\U0001fab1"
-    84040, // "\U0001fa9f"
-    84480, // "\U0001fab6"
-    83557, // "\U0001fa83"
+    84345, // "🪱"
+    84040, // "🪟"
+    84480, // "🪶"
+    83557, // "🪃"
     83657, // "🪐"
-    84402, // "\U0001fab3"
+    84402, // "🪳"
     83464, // "🪀"
-    83370, // "\U0001fa74"
-    84003, // "\U0001fa9d"
-    84072, // "\U0001faa0"
-    84317, // "\U0001fab0"
+    83370, // "🩴"
+    84003, // "🪝"
+    84072, // "🪠"
+    84317, // "🪰"
     83299, // "🩱"
-    84432, // "\U0001fab4"
-    84560, // "\U0001fac2"
+    84432, // "🪴"
+    84560, // "🫂"
     83786, // "🪕"
-    84240, // "\U0001faa6"
-    83873, // "\U0001fa98"
+    84240, // "🪦"
+    83873, // "🪘"
     83403, // "🩹"
-    84130, // "\U0001faa2"
+    84130, // "🪢"
     83495, // "🪁"
-    83903, // "\U0001fa99"
-    83583, // "\U0001fa84"
-    84156, // "\U0001faa3"
-    84719, // "\U0001fad5"
-    84665, // "\U0001fad3"
-    83847, // "\U0001fa97"
-    83955, // "\U0001fa9b"
-    84614, // "\U0001fad1"
-    84639, // "\U0001fad2"
+    83903, // "🪙"
+    83583, // "🪄"
+    84156, // "🪣"
+    84719, // "🫕"
+    84665, // "🫓"
+    83847, // "🪗"
+    83955, // "🪛"
+    84614, // "🫑"
+    84639, // "🫒"
     83675, // "🪑"
     83316, // "🩲"
-    84216, // "\U0001faa5"
+    84216, // "🪥"
     83524, // "🪂"
     83731, // "🪓"
-    84750, // "\U0001fad6"
-    84509, // "\U0001fac0"
-    83984, // "\U0001fa9c"
+    84750, // "🫖"
+    84509, // "🫀"
+    83984, // "🪜"
     83273, // "🩰"
 ];
 
+/// Return Some((advance, left bearing)) in pixels for the glyph with its blit
+/// pattern at DATA[offset]. The left edge of the pattern goes left bearing
+/// pixels right of the pen position (negative is to the left), and the pen
+/// moves advance pixels to the right after drawing the glyph. Glyphs without a
+/// record in METRICS get the spacing of blit.rs: a left bearing of 1, and an
+/// advance of the pattern width plus 3. Return None if offset is past the end
+/// of DATA.
+pub fn get_glyph_metrics(offset: usize) -> Option<(usize, isize)> {
+    if let Ok(index) = METRICS_OFFSET.binary_search(&offset) {
+        let m = METRICS[index];
+        return Some(((m >> 16) as usize, m as u16 as i16 as isize));
+    }
+    DATA.get(offset).map(|header| (((header >> 16) & 0xff) as usize + 3, 1))
+}
+
+/// Lookup table of blit pattern offsets in DATA order, for glyphs that do not
+/// have the default metrics; sort matches METRICS
+const METRICS_OFFSET: [usize; 3126] = [
+    0,    // "🀄"
+    24,   // "🃏"
+    48,   // "🅰"
+    81,   // "🅱"
+    113,  // "🅾"
+    145,  // "🅿"
+    178,  // "🆎"
+    210,  // "🆑"
+    242,  // "🆒"
+    275,  // "🆓"
+    307,  // "🆔"
+    339,  // "🆕"
+    372,  // "🆖"
+    404,  // "🆗"
+    436,  // "🆘"
+    469,  // "🆙"
+    501,  // "🆚"
+    533,  // "🇦🇨" 1F1E6-1F1E8
+    557,  // "🇦🇩" 1F1E6-1F1E9
+    581,  // "🇦🇪" 1F1E6-1F1EA
+    605,  // "🇦🇫" 1F1E6-1F1EB
+    630,  // "🇦🇬" 1F1E6-1F1EC
+    654,  // "🇦🇮" 1F1E6-1F1EE
+    678,  // "🇦🇱" 1F1E6-1F1F1
+    702,  // "🇦🇲" 1F1E6-1F1F2
+    725,  // "🇦🇴" 1F1E6-1F1F4
+    749,  // "🇦🇶" 1F1E6-1F1F6
+    772,  // "🇦🇷" 1F1E6-1F1F7
+    795,  // "🇦🇸" 1F1E6-1F1F8
+    818,  // "🇦🇹" 1F1E6-1F1F9
+    841,  // "🇦🇺" 1F1E6-1F1FA
+    865,  // "🇦🇼" 1F1E6-1F1FC
+    888,  // "🇦🇽" 1F1E6-1F1FD
+    913,  // "🇦🇿" 1F1E6-1F1FF
+    937,  // "🇦"
+    970,  // "🇧🇦" 1F1E7-1F1E6
+    995,  // "🇧🇧" 1F1E7-1F1E7
+    1020, // "🇧🇩" 1F1E7-1F1E9
+    1045, // "🇧🇪" 1F1E7-1F1EA
+    1070, // "🇧🇫" 1F1E7-1F1EB
+    1094, // "🇧🇬" 1F1E7-1F1EC
+    1117, // "🇧🇭" 1F1E7-1F1ED
+    1142, // "🇧🇮" 1F1E7-1F1EE
+    1167, // "🇧🇯" 1F1E7-1F1EF
+    1211, // "🇧🇲" 1F1E7-1F1F2
+    1236, // "🇧🇳" 1F1E7-1F1F3
+    1257, // "🇧🇴" 1F1E7-1F1F4
+    1282, // "🇧🇶" 1F1E7-1F1F6
+    1305, // "🇧🇷" 1F1E7-1F1F7
+    1329, // "🇧🇸" 1F1E7-1F1F8
+    1353, // "🇧🇹" 1F1E7-1F1F9
+    1376, // "🇧🇻" 1F1E7-1F1FB
+    1400, // "🇧🇼" 1F1E7-1F1FC
+    1424, // "🇧🇾" 1F1E7-1F1FE
+    1448, // "🇧🇿" 1F1E7-1F1FF
+    1472, // "🇧"
+    1505, // "🇨🇦" 1F1E8-1F1E6
+    1529, // "🇨🇨" 1F1E8-1F1E8
+    1553, // "🇨🇩" 1F1E8-1F1E9
+    1577, // "🇨🇫" 1F1E8-1F1EB
+    1601, // "🇨🇬" 1F1E8-1F1EC
+    1625, // "🇨🇭" 1F1E8-1F1ED
+    1644, // "🇨🇮" 1F1E8-1F1EE
+    1668, // "🇨🇰" 1F1E8-1F1F0
+    1692, // "🇨🇱" 1F1E8-1F1F1
+    1716, // "🇨🇲" 1F1E8-1F1F2
+    1740, // "🇨🇳" 1F1E8-1F1F3
+    1764, // "🇨🇴" 1F1E8-1F1F4
+    1788, // "🇨🇵" 1F1E8-1F1F5
+    1812, // "🇨🇷" 1F1E8-1F1F7
+    1836, // "🇨🇺" 1F1E8-1F1FA
+    1860, // "🇨🇻" 1F1E8-1F1FB
+    1884, // "🇨🇼" 1F1E8-1F1FC
+    1908, // "🇨🇽" 1F1E8-1F1FD
+    1932, // "🇨🇾" 1F1E8-1F1FE
+    1955, // "🇨🇿" 1F1E8-1F1FF
+    1979, // "🇨"
+    2011, // "🇩🇪" 1F1E9-1F1EA
+    2034, // "🇩🇬" 1F1E9-1F1EC
+    2057, // "🇩🇯" 1F1E9-1F1EF
+    2081, // "🇩🇰" 1F1E9-1F1F0
+    2106, // "🇩🇲" 1F1E9-1F1F2
+    2131, // "🇩🇴" 1F1E9-1F1F4
+    2156, // "🇩🇿" 1F1E9-1F1FF
+    2181, // "🇩"
+    2214, // "🇪🇦" 1F1EA-1F1E6
+    2239, // "🇪🇨" 1F1EA-1F1E8
+    2262, // "🇪🇪" 1F1EA-1F1EA
+    2284, // "🇪🇬" 1F1EA-1F1EC
+    2309, // "🇪🇭" 1F1EA-1F1ED
+    2334, // "🇪🇷" 1F1EA-1F1F7
+    2358, // "🇪🇸" 1F1EA-1F1F8
+    2383, // "🇪🇹" 1F1EA-1F1F9
+    2407, // "🇪🇺" 1F1EA-1F1FA
+    2432, // "🇪"
+    2464, // "🇫🇮" 1F1EB-1F1EE
+    2488, // "🇫🇯" 1F1EB-1F1EF
+    2512, // "🇫🇰" 1F1EB-1F1F0
+    2536, // "🇫🇲" 1F1EB-1F1F2
+    2560, // "🇫🇴" 1F1EB-1F1F4
+    2584, // "🇫🇷" 1F1EB-1F1F7
+    2608, // "🇫"
+    2640, // "🇬🇦" 1F1EC-1F1E6
+    2664, // "🇬🇧" 1F1EC-1F1E7
+    2688, // "🇬🇩" 1F1EC-1F1E9
+    2712, // "🇬🇪" 1F1EC-1F1EA
+    2736, // "🇬🇫" 1F1EC-1F1EB
+    2760, // "🇬🇬" 1F1EC-1F1EC
+    2784, // "🇬🇭" 1F1EC-1F1ED
+    2808, // "🇬🇮" 1F1EC-1F1EE
+    2831, // "🇬🇱" 1F1EC-1F1F1
+    2854, // "🇬🇲" 1F1EC-1F1F2
+    2877, // "🇬🇳" 1F1EC-1F1F3
+    2901, // "🇬🇵" 1F1EC-1F1F5
+    2925, // "🇬🇶" 1F1EC-1F1F6
+    2949, // "🇬🇷" 1F1EC-1F1F7
+    2972, // "🇬🇸" 1F1EC-1F1F8
+    2996, // "🇬🇹" 1F1EC-1F1F9
+    3019, // "🇬🇺" 1F1EC-1F1FA
+    3042, // "🇬🇼" 1F1EC-1F1FC
+    3066, // "🇬🇾" 1F1EC-1F1FE
+    3089, // "🇬"
+    3121, // "🇭🇰" 1F1ED-1F1F0
+    3145, // "🇭🇲" 1F1ED-1F1F2
+    3169, // "🇭🇳" 1F1ED-1F1F3
+    3193, // "🇭🇷" 1F1ED-1F1F7
+    3217, // "🇭🇹" 1F1ED-1F1F9
+    3241, // "🇭🇺" 1F1ED-1F1FA
+    3266, // "🇭"
+    3298, // "🇮🇨" 1F1EE-1F1E8
+    3322, // "🇮🇩" 1F1EE-1F1E9
+    3345, // "🇮🇪" 1F1EE-1F1EA
+    3369, // "🇮🇱" 1F1EE-1F1F1
+    3390, // "🇮🇲" 1F1EE-1F1F2
+    3415, // "🇮🇳" 1F1EE-1F1F3
+    3439, // "🇮🇴" 1F1EE-1F1F4
+    3463, // "🇮🇶" 1F1EE-1F1F6
+    3487, // "🇮🇷" 1F1EE-1F1F7
+    3511, // "🇮🇸" 1F1EE-1F1F8
+    3536, // "🇮🇹" 1F1EE-1F1F9
+    3561, // "🇮"
+    3593, // "🇯🇪" 1F1EF-1F1EA
+    3617, // "🇯🇲" 1F1EF-1F1F2
+    3641, // "🇯🇴" 1F1EF-1F1F4
+    3665, // "🇯🇵" 1F1EF-1F1F5
+    3688, // "🇯"
+    3721, // "🇰🇪" 1F1F0-1F1EA
+    3745, // "🇰🇬" 1F1F0-1F1EC
+    3769, // "🇰🇭" 1F1F0-1F1ED
+    3793, // "🇰🇮" 1F1F0-1F1EE
+    3817, // "🇰🇲" 1F1F0-1F1F2
+    3840, // "🇰🇳" 1F1F0-1F1F3
+    3864, // "🇰🇵" 1F1F0-1F1F5
+    3888, // "🇰🇷" 1F1F0-1F1F7
+    3911, // "🇰🇼" 1F1F0-1F1FC
+    3935, // "🇰🇾" 1F1F0-1F1FE
+    3959, // "🇰🇿" 1F1F0-1F1FF
+    3983, // "🇰"
+    4016, // "🇱🇦" 1F1F1-1F1E6
+    4040, // "🇱🇧" 1F1F1-1F1E7
+    4064, // "🇱🇨" 1F1F1-1F1E8
+    4087, // "🇱🇮" 1F1F1-1F1EE
+    4111, // "🇱🇰" 1F1F1-1F1F0
+    4134, // "🇱🇷" 1F1F1-1F1F7
+    4158, // "🇱🇸" 1F1F1-1F1F8
+    4181, // "🇱🇹" 1F1F1-1F1F9
+    4205, // "🇱🇺" 1F1F1-1F1FA
+    4228, // "🇱🇻" 1F1F1-1F1FB
+    4252, // "🇱🇾" 1F1F1-1F1FE
+    4275, // "🇱"
+    4307, // "🇲🇦" 1F1F2-1F1E6
+    4331, // "🇲🇨" 1F1F2-1F1E8
+    4354, // "🇲🇩" 1F1F2-1F1E9
+    4377, // "🇲🇪" 1F1F2-1F1EA
+    4400, // "🇲🇫" 1F1F2-1F1EB
+    4424, // "🇲🇬" 1F1F2-1F1EC
+    4448, // "🇲🇭" 1F1F2-1F1ED
+    4473, // "🇲🇰" 1F1F2-1F1F0
+    4498, // "🇲🇱" 1F1F2-1F1F1
+    4523, // "🇲🇲" 1F1F2-1F1F2
+    4547, // "🇲🇳" 1F1F2-1F1F3
+    4572, // "🇲🇴" 1F1F2-1F1F4
+    4596, // "🇲🇵" 1F1F2-1F1F5
+    4620, // "🇲🇶" 1F1F2-1F1F6
+    4645, // "🇲🇷" 1F1F2-1F1F7
+    4670, // "🇲🇸" 1F1F2-1F1F8
+    4695, // "🇲🇹" 1F1F2-1F1F9
+    4720, // "🇲🇺" 1F1F2-1F1FA
+    4744, // "🇲🇻" 1F1F2-1F1FB
+    4769, // "🇲🇼" 1F1F2-1F1FC
+    4794, // "🇲🇽" 1F1F2-1F1FD
+    4819, // "🇲🇾" 1F1F2-1F1FE
+    4843, // "🇲🇿" 1F1F2-1F1FF
+    4867, // "🇲"
+    4900, // "🇳🇦" 1F1F3-1F1E6
+    4924, // "🇳🇨" 1F1F3-1F1E8
+    4948, // "🇳🇪" 1F1F3-1F1EA
+    4972, // "🇳🇫" 1F1F3-1F1EB
+    4996, // "🇳🇬" 1F1F3-1F1EC
+    5020, // "🇳🇮" 1F1F3-1F1EE
+    5044, // "🇳🇱" 1F1F3-1F1F1
+    5068, // "🇳🇴" 1F1F3-1F1F4
+    5092, // "🇳🇵" 1F1F3-1F1F5
+    5105, // "🇳🇷" 1F1F3-1F1F7
+    5129, // "🇳🇺" 1F1F3-1F1FA
+    5153, // "🇳🇿" 1F1F3-1F1FF
+    5177, // "🇳"
+    5209, // "🇴🇲" 1F1F4-1F1F2
+    5233, // "🇴"
+    5265, // "🇵🇦" 1F1F5-1F1E6
+    5289, // "🇵🇪" 1F1F5-1F1EA
+    5313, // "🇵🇫" 1F1F5-1F1EB
+    5337, // "🇵🇬" 1F1F5-1F1EC
+    5361, // "🇵🇭" 1F1F5-1F1ED
+    5385, // "🇵🇰" 1F1F5-1F1F0
+    5409, // "🇵🇱" 1F1F5-1F1F1
+    5433, // "🇵🇲" 1F1F5-1F1F2
+    5457, // "🇵🇳" 1F1F5-1F1F3
+    5481, // "🇵🇷" 1F1F5-1F1F7
+    5505, // "🇵🇸" 1F1F5-1F1F8
+    5528, // "🇵🇹" 1F1F5-1F1F9
+    5552, // "🇵🇼" 1F1F5-1F1FC
+    5575, // "🇵🇾" 1F1F5-1F1FE
+    5599, // "🇵"
+    5631, // "🇶🇦" 1F1F6-1F1E6
+    5656, // "🇶"
+    5689, // "🇷🇪" 1F1F7-1F1EA
+    5713, // "🇷🇴" 1F1F7-1F1F4
+    5738, // "🇷🇸" 1F1F7-1F1F8
+    5760, // "🇷🇺" 1F1F7-1F1FA
+    5783, // "🇷🇼" 1F1F7-1F1FC
+    5807, // "🇷"
+    5840, // "🇸🇦" 1F1F8-1F1E6
+    5865, // "🇸🇧" 1F1F8-1F1E7
+    5890, // "🇸🇨" 1F1F8-1F1E8
+    5915, // "🇸🇩" 1F1F8-1F1E9
+    5940, // "🇸🇪" 1F1F8-1F1EA
+    5964, // "🇸🇬" 1F1F8-1F1EC
+    5987, // "🇸🇭" 1F1F8-1F1ED
+    6012, // "🇸🇮" 1F1F8-1F1EE
+    6035, // "🇸🇯" 1F1F8-1F1EF
+    6059, // "🇸🇰" 1F1F8-1F1F0
+    6082, // "🇸🇱" 1F1F8-1F1F1
+    6106, // "🇸🇲" 1F1F8-1F1F2
+    6129, // "🇸🇳" 1F1F8-1F1F3
+    6153, // "🇸🇴" 1F1F8-1F1F4
+    6177, // "🇸🇷" 1F1F8-1F1F7
+    6201, // "🇸🇸" 1F1F8-1F1F8
+    6225, // "🇸🇹" 1F1F8-1F1F9
+    6249, // "🇸🇻" 1F1F8-1F1FB
+    6273, // "🇸🇽" 1F1F8-1F1FD
+    6297, // "🇸🇾" 1F1F8-1F1FE
+    6321, // "🇸🇿" 1F1F8-1F1FF
+    6345, // "🇸"
+    6378, // "🇹🇦" 1F1F9-1F1E6
+    6402, // "🇹🇨" 1F1F9-1F1E8
+    6426, // "🇹🇩" 1F1F9-1F1E9
+    6450, // "🇹🇫" 1F1F9-1F1EB
+    6474, // "🇹🇬" 1F1F9-1F1EC
+    6498, // "🇹🇭" 1F1F9-1F1ED
+    6522, // "🇹🇯" 1F1F9-1F1EF
+    6546, // "🇹🇰" 1F1F9-1F1F0
+    6570, // "🇹🇱" 1F1F9-1F1F1
+    6594, // "🇹🇲" 1F1F9-1F1F2
+    6617, // "🇹🇳" 1F1F9-1F1F3
+    6641, // "🇹🇴" 1F1F9-1F1F4
+    6665, // "🇹🇷" 1F1F9-1F1F7
+    6689, // "🇹🇹" 1F1F9-1F1F9
+    6713, // "🇹🇻" 1F1F9-1F1FB
+    6736, // "🇹🇼" 1F1F9-1F1FC
+    6760, // "🇹🇿" 1F1F9-1F1FF
+    6784, // "🇹"
+    6816, // "🇺🇦" 1F1FA-1F1E6
+    6840, // "🇺🇬" 1F1FA-1F1EC
+    6865, // "🇺🇲" 1F1FA-1F1F2
+    6890, // "🇺🇳" 1F1FA-1F1F3
+    6914, // "🇺🇸" 1F1FA-1F1F8
+    6939, // "🇺🇾" 1F1FA-1F1FE
+    6964, // "🇺🇿" 1F1FA-1F1FF
+    6988, // "🇺"
+    7021, // "🇻🇦" 1F1FB-1F1E6
+    7036, // "🇻🇨" 1F1FB-1F1E8
+    7060, // "🇻🇪" 1F1FB-1F1EA
+    7084, // "🇻🇬" 1F1FB-1F1EC
+    7109, // "🇻🇮" 1F1FB-1F1EE
+    7130, // "🇻🇳" 1F1FB-1F1F3
+    7155, // "🇻🇺" 1F1FB-1F1FA
+    7180, // "🇻"
+    7212, // "🇼🇫" 1F1FC-1F1EB
+    7236, // "🇼🇸" 1F1FC-1F1F8
+    7260, // "🇼"
+    7292, // "🇽🇰" 1F1FD-1F1F0
+    7316, // "🇽"
+    7349, // "🇾🇪" 1F1FE-1F1EA
+    7373, // "🇾🇹" 1F1FE-1F1F9
+    7396, // "🇾"
+    7429, // "🇿🇦" 1F1FF-1F1E6
+    7453, // "🇿🇲" 1F1FF-1F1F2
+    7477, // "🇿🇼" 1F1FF-1F1FC
+    7501, // "🇿"
+    7533, // "🈁"
+    7565, // "🈂"
+    7598, // "🈚"
+    7630, // "🈯"
+    7662, // "🈲"
+    7694, // "🈳"
+    7727, // "🈴"
+    7759, // "🈵"
+    7791, // "🈶"
+    7823, // "🈷"
+    7855, // "🈸"
+    7887, // "🈹"
+    7920, // "🈺"
+    7952, // "🉐"
+    7984, // "🉑"
+    8045, // "🌁"
+    8076, // "🌂"
+    8102, // "🌃"
+    8135, // "🌄"
+    8168, // "🌅"
+    8200, // "🌆"
+    8233, // "🌇"
+    8266, // "🌈"
+    8298, // "🌉"
+    8331, // "🌊"
+    8361, // "🌋"
+    8394, // "🌌"
+    8427, // "🌍"
+    8459, // "🌎"
+    8491, // "🌏"
+    8524, // "🌐"
+    8556, // "🌑"
+    8588, // "🌒"
+    8681, // "🌕"
+    8713, // "🌖"
+    8745, // "🌗"
+    8777, // "🌘"
+    8809, // "🌙"
+    8837, // "🌚"
+    8869, // "🌛"
+    8892, // "🌜"
+    8945, // "🌞"
+    8977, // "🌟"
+    9009, // "🌠"
+    9041, // "🌡"
+    9069, // "🌤"
+    9153, // "🌧"
+    9183, // "🌨"
+    9241, // "🌪"
+    9268, // "🌫"
+    9319, // "🌭"
+    9345, // "🌮"
+    9393, // "🌰"
+    9425, // "🌱"
+    9455, // "🌲"
+    9481, // "🌳"
+    9510, // "🌴"
+    9596, // "🌷"
+    9625, // "🌸"
+    9656, // "🌹"
+    9676, // "🌺"
+    9706, // "🌻"
+    9737, // "🌼"
+    9759, // "🌽"
+    9786, // "🌾"
+    9808, // "🌿"
+    9836, // "🍀"
+    9866, // "🍁"
+    9898, // "🍂"
+    9929, // "🍃"
+    9958, // "🍄"
+    9991, // "🍅"
+    10023, // "🍆"
+    10053, // "🍇"
+    10083, // "🍈"
+    10128, // "🍊"
+    10160, // "🍋"
+    10192, // "🍌"
+    10217, // "🍍"
+    10241, // "🍎"
+    10268, // "🍏"
+    10295, // "🍐"
+    10322, // "🍑"
+    10354, // "🍒"
+    10382, // "🍓"
+    10409, // "🍔"
+    10438, // "🍕"
+    10491, // "🍗"
+    10519, // "🍘"
+    10549, // "🍙"
+    10576, // "🍚"
+    10603, // "🍛"
+    10624, // "🍜"
+    10656, // "🍝"
+    10685, // "🍞"
+    10712, // "🍟"
+    10737, // "🍠"
+    10767, // "🍡"
+    10794, // "🍢"
+    10820, // "🍣"
+    10850, // "🍤"
+    10904, // "🍦"
+    10920, // "🍧"
+    10949, // "🍨"
+    10976, // "🍩"
+    11001, // "🍪"
+    11030, // "🍫"
+    11062, // "🍬"
+    11094, // "🍭"
+    11126, // "🍮"
+    11150, // "🍯"
+    11174, // "🍰"
+    11205, // "🍱"
+    11236, // "🍲"
+    11260, // "🍳"
+    11293, // "🍴"
+    11314, // "🍵"
+    11346, // "🍶"
+    11375, // "🍷"
+    11396, // "🍸"
+    11419, // "🍹"
+    11445, // "🍺"
+    11475, // "🍻"
+    11535, // "🍽"
+    11567, // "🍾"
+    11599, // "🍿"
+    11627, // "🎀"
+    11655, // "🎁"
+    11686, // "🎂"
+    11718, // "🎃"
+    11750, // "🎄"
+    11835, // "🎅🏽" 1F385-1F3FD
+    11867, // "🎅🏾" 1F385-1F3FE
+    11928, // "🎅"
+    11960, // "🎆"
+    11993, // "🎇"
+    12025, // "🎈"
+    12041, // "🎉"
+    12101, // "🎋"
+    12129, // "🎌"
+    12158, // "🎍"
+    12187, // "🎎"
+    12217, // "🎏"
+    12246, // "🎐"
+    12265, // "🎑"
+    12298, // "🎒"
+    12330, // "🎓"
+    12360, // "🎖"
+    12377, // "🎗"
+    12394, // "🎙"
+    12418, // "🎚"
+    12444, // "🎛"
+    12476, // "🎞"
+    12498, // "🎟"
+    12529, // "🎠"
+    12558, // "🎡"
+    12589, // "🎢"
+    12619, // "🎣"
+    12650, // "🎤"
+    12680, // "🎥"
+    12706, // "🎦"
+    12738, // "🎧"
+    12771, // "🎨"
+    12799, // "🎩"
+    12831, // "🎪"
+    12857, // "🎫"
+    12886, // "🎬"
+    12918, // "🎭"
+    12950, // "🎮"
+    12974, // "🎯"
+    13005, // "🎰"
+    13029, // "🎱"
+    13062, // "🎲"
+    13086, // "🎳"
+    13118, // "🎴"
+    13144, // "🎵"
+    13175, // "🎶"
+    13206, // "🎷"
+    13233, // "🎸"
+    13265, // "🎹"
+    13298, // "🎺"
+    13325, // "🎻"
+    13356, // "🎼"
+    13388, // "🎽"
+    13417, // "🎾"
+    13449, // "🎿"
+    13478, // "🏀"
+    13510, // "🏁"
+    13565, // "🏂🏼" 1F3C2-1F3FC
+    13597, // "🏂🏽" 1F3C2-1F3FD
+    13689, // "🏂"
+    13721, // "🏃🏻\u200d♀️" 1F3C3-1F3FB-200D-2640-FE0F
+    13743, // "🏃🏻\u200d♂️" 1F3C3-1F3FB-200D-2642-FE0F
+    13763, // "🏃🏻" 1F3C3-1F3FB
+    13786, // "🏃🏼\u200d♀️" 1F3C3-1F3FC-200D-2640-FE0F
+    13807, // "🏃🏼\u200d♂️" 1F3C3-1F3FC-200D-2642-FE0F
+    13828, // "🏃🏼" 1F3C3-1F3FC
+    13850, // "🏃🏽\u200d♀️" 1F3C3-1F3FD-200D-2640-FE0F
+    13872, // "🏃🏽\u200d♂️" 1F3C3-1F3FD-200D-2642-FE0F
+    13893, // "🏃🏽" 1F3C3-1F3FD
+    13916, // "🏃🏾\u200d♀️" 1F3C3-1F3FE-200D-2640-FE0F
+    13938, // "🏃🏾\u200d♂️" 1F3C3-1F3FE-200D-2642-FE0F
+    13961, // "🏃🏾" 1F3C3-1F3FE
+    13984, // "🏃🏿\u200d♀️" 1F3C3-1F3FF-200D-2640-FE0F
+    14006, // "🏃🏿\u200d♂️" 1F3C3-1F3FF-200D-2642-FE0F
+    14029, // "🏃🏿" 1F3C3-1F3FF
+    14050, // "🏃\u200d♀️" 1F3C3-200D-2640-FE0F
+    14073, // "🏃\u200d♂️" 1F3C3-200D-2642-FE0F
+    14094, // "🏃"
+    14115, // "🏄🏻\u200d♀️" 1F3C4-1F3FB-200D-2640-FE0F
+    14147, // "🏄🏻\u200d♂️" 1F3C4-1F3FB-200D-2642-FE0F
+    14179, // "🏄🏻" 1F3C4-1F3FB
+    14211, // "🏄🏼\u200d♀️" 1F3C4-1F3FC-200D-2640-FE0F
+    14243, // "🏄🏼\u200d♂️" 1F3C4-1F3FC-200D-2642-FE0F
+    14275, // "🏄🏼" 1F3C4-1F3FC
+    14307, // "🏄🏽\u200d♀️" 1F3C4-1F3FD-200D-2640-FE0F
+    14339, // "🏄🏽\u200d♂️" 1F3C4-1F3FD-200D-2642-FE0F
+    14371, // "🏄🏽" 1F3C4-1F3FD
+    14403, // "🏄🏾\u200d♀️" 1F3C4-1F3FE-200D-2640-FE0F
+    14435, // "🏄🏾\u200d♂️" 1F3C4-1F3FE-200D-2642-FE0F
+    14467, // "🏄🏾" 1F3C4-1F3FE
+    14499, // "🏄🏿\u200d♀️" 1F3C4-1F3FF-200D-2640-FE0F
+    14531, // "🏄🏿\u200d♂️" 1F3C4-1F3FF-200D-2642-FE0F
+    14563, // "🏄🏿" 1F3C4-1F3FF
+    14595, // "🏄\u200d♀️" 1F3C4-200D-2640-FE0F
+    14627, // "🏄\u200d♂️" 1F3C4-200D-2642-FE0F
+    14659, // "🏄"
+    14721, // "🏆"
+    14753, // "🏇🏻" 1F3C7-1F3FB
+    14785, // "🏇🏼" 1F3C7-1F3FC
+    14816, // "🏇🏽" 1F3C7-1F3FD
+    14848, // "🏇🏾" 1F3C7-1F3FE
+    14880, // "🏇🏿" 1F3C7-1F3FF
+    14911, // "🏇"
+    14943, // "🏈"
+    14974, // "🏉"
+    15004, // "🏊🏻\u200d♀️" 1F3CA-1F3FB-200D-2640-FE0F
+    15034, // "🏊🏻\u200d♂️" 1F3CA-1F3FB-200D-2642-FE0F
+    15064, // "🏊🏻" 1F3CA-1F3FB
+    15094, // "🏊🏼\u200d♀️" 1F3CA-1F3FC-200D-2640-FE0F
+    15124, // "🏊🏼\u200d♂️" 1F3CA-1F3FC-200D-2642-FE0F
+    15154, // "🏊🏼" 1F3CA-1F3FC
+    15184, // "🏊🏽\u200d♀️" 1F3CA-1F3FD-200D-2640-FE0F
+    15215, // "🏊🏽\u200d♂️" 1F3CA-1F3FD-200D-2642-FE0F
+    15246, // "🏊🏽" 1F3CA-1F3FD
+    15277, // "🏊🏾\u200d♀️" 1F3CA-1F3FE-200D-2640-FE0F
+    15308, // "🏊🏾\u200d♂️" 1F3CA-1F3FE-200D-2642-FE0F
+    15339, // "🏊🏾" 1F3CA-1F3FE
+    15370, // "🏊🏿\u200d♀️" 1F3CA-1F3FF-200D-2640-FE0F
+    15401, // "🏊🏿\u200d♂️" 1F3CA-1F3FF-200D-2642-FE0F
+    15432, // "🏊🏿" 1F3CA-1F3FF
+    15463, // "🏊\u200d♀️" 1F3CA-200D-2640-FE0F
+    15493, // "🏊\u200d♂️" 1F3CA-200D-2642-FE0F
+    15524, // "🏊"
+    15555, // "🏋🏻\u200d♀️" 1F3CB-1F3FB-200D-2640-FE0F
+    15586, // "🏋🏻\u200d♂️" 1F3CB-1F3FB-200D-2642-FE0F
+    15617, // "🏋🏻" 1F3CB-1F3FB
+    15648, // "🏋🏼\u200d♀️" 1F3CB-1F3FC-200D-2640-FE0F
+    15679, // "🏋🏼\u200d♂️" 1F3CB-1F3FC-200D-2642-FE0F
+    15710, // "🏋🏼" 1F3CB-1F3FC
+    15741, // "🏋🏽\u200d♀️" 1F3CB-1F3FD-200D-2640-FE0F
+    15772, // "🏋🏽\u200d♂️" 1F3CB-1F3FD-200D-2642-FE0F
+    15803, // "🏋🏽" 1F3CB-1F3FD
+    15834, // "🏋🏾\u200d♀️" 1F3CB-1F3FE-200D-2640-FE0F
+    15865, // "🏋🏾\u200d♂️" 1F3CB-1F3FE-200D-2642-FE0F
+    15896, // "🏋🏾" 1F3CB-1F3FE
+    15927, // "🏋🏿\u200d♀️" 1F3CB-1F3FF-200D-2640-FE0F
+    15958, // "🏋🏿\u200d♂️" 1F3CB-1F3FF-200D-2642-FE0F
+    15989, // "🏋🏿" 1F3CB-1F3FF
+    16020, // "🏋️\u200d♀️" 1F3CB-FE0F-200D-2640-FE0F
+    16051, // "🏋️\u200d♂️" 1F3CB-FE0F-200D-2642-FE0F
+    16082, // "🏋"
+    16113, // "🏌🏻\u200d♀️" 1F3CC-1F3FB-200D-2640-FE0F
+    16132, // "🏌🏻\u200d♂️" 1F3CC-1F3FB-200D-2642-FE0F
+    16148, // "🏌🏻" 1F3CC-1F3FB
+    16167, // "🏌🏼\u200d♀️" 1F3CC-1F3FC-200D-2640-FE0F
+    16186, // "🏌🏼\u200d♂️" 1F3CC-1F3FC-200D-2642-FE0F
+    16202, // "🏌🏼" 1F3CC-1F3FC
+    16221, // "🏌🏽\u200d♀️" 1F3CC-1F3FD-200D-2640-FE0F
+    16240, // "🏌🏽\u200d♂️" 1F3CC-1F3FD-200D-2642-FE0F
+    16257, // "🏌🏽" 1F3CC-1F3FD
+    16276, // "🏌🏾\u200d♀️" 1F3CC-1F3FE-200D-2640-FE0F
+    16295, // "🏌🏾\u200d♂️" 1F3CC-1F3FE-200D-2642-FE0F
+    16312, // "🏌🏾" 1F3CC-1F3FE
+    16331, // "🏌🏿\u200d♀️" 1F3CC-1F3FF-200D-2640-FE0F
+    16349, // "🏌🏿\u200d♂️" 1F3CC-1F3FF-200D-2642-FE0F
+    16369, // "🏌🏿" 1F3CC-1F3FF
+    16388, // "🏌️\u200d♀️" 1F3CC-FE0F-200D-2640-FE0F
+    16406, // "🏌️\u200d♂️" 1F3CC-FE0F-200D-2642-FE0F
+    16426, // "🏌"
+    16445, // "🏍"
+    16468, // "🏎"
+    16487, // "🏏"
+    16549, // "🏑"
+    16577, // "🏒"
+    16605, // "🏓"
+    16635, // "🏔"
+    16665, // "🏕"
+    16696, // "🏖"
+    16758, // "🏘"
+    16786, // "🏙"
+    16818, // "🏚"
+    16841, // "🏛"
+    16869, // "🏜"
+    16900, // "🏝"
+    16932, // "🏞"
+    16964, // "🏟"
+    16993, // "🏠"
+    17020, // "🏡"
+    17049, // "🏢"
+    17078, // "🏣"
+    17110, // "🏤"
+    17141, // "🏥"
+    17203, // "🏧"
+    17235, // "🏨"
+    17267, // "🏩"
+    17299, // "🏪"
+    17330, // "🏫"
+    17362, // "🏬"
+    17394, // "🏭"
+    17424, // "🏮"
+    17455, // "🏯"
+    17511, // "🏳️\u200d🌈" 1F3F3-FE0F-200D-1F308
+    17535, // "🏳️\u200d⚧️" 1F3F3-FE0F-200D-26A7-FE0F
+    17558, // "🏳"
+    17582, // "🏴\u200d☠️" 1F3F4-200D-2620-FE0F
+    17606, // "🏴\U000e0067\U000e0062\U000e0065\U000e006e\U000e0067\U000e007f" 1F3F4-E0067-E0062-E0065-E006E-E0067-E007F
+    17630, // "🏴\U000e0067\U000e0062\U000e0073\U000e0063\U000e0074\U000e007f" 1F3F4-E0067-E0062-E0073-E0063-E0074-E007F
+    17653, // "🏴\U000e0067\U000e0062\U000e0077\U000e006c\U000e0073\U000e007f" 1F3F4-E0067-E0062-E0077-E006C-E0073-E007F
+    17676, // "🏴"
+    17703, // "🏵"
+    17734, // "🏷"
+    17762, // "🏸"
+    17795, // "🏹"
+    17826, // "🏺"
+    17849, // "🏻"
+    17871, // "🏼"
+    17894, // "🏽"
+    17916, // "🏾"
+    17939, // "🏿"
+    17962, // "🐀"
+    17986, // "🐁"
+    18005, // "🐂"
+    18029, // "🐃"
+    18057, // "🐄"
+    18081, // "🐅"
+    18103, // "🐆"
+    18155, // "🐈\u200d⬛" 1F408-200D-2B1B
+    18186, // "🐈"
+    18246, // "🐊"
+    18267, // "🐋"
+    18319, // "🐍"
+    18351, // "🐎"
+    18401, // "🐐"
+    18431, // "🐑"
+    18459, // "🐒"
+    18491, // "🐓"
+    18520, // "🐔"
+    18537, // "🐕\u200d🦺" 1F415-200D-1F9BA
+    18568, // "🐕"
+    18597, // "🐖"
+    18619, // "🐗"
+    18649, // "🐘"
+    18678, // "🐙"
+    18710, // "🐚"
+    18735, // "🐛"
+    18765, // "🐜"
+    18796, // "🐝"
+    18827, // "🐞"
+    18859, // "🐟"
+    18883, // "🐠"
+    18935, // "🐢"
+    18954, // "🐣"
+    18979, // "🐤"
+    19005, // "🐥"
+    19033, // "🐦"
+    19088, // "🐨"
+    19111, // "🐩"
+    19140, // "🐪"
+    19169, // "🐫"
+    19197, // "🐬"
+    19227, // "🐭"
+    19258, // "🐮"
+    19288, // "🐯"
+    19318, // "🐰"
+    19345, // "🐱"
+    19371, // "🐲"
+    19402, // "🐳"
+    19432, // "🐴"
+    19494, // "🐶"
+    19525, // "🐷"
+    19555, // "🐸"
+    19584, // "🐹"
+    19671, // "🐻"
+    19702, // "🐼"
+    19752, // "🐾"
+    19779, // "🐿"
+    19833, // "👁\u200d🗨" 1F441-200D-1F5E8
+    19863, // "👁"
+    19882, // "👂🏻" 1F442-1F3FB
+    19902, // "👂🏼" 1F442-1F3FC
+    19923, // "👂🏽" 1F442-1F3FD
+    19946, // "👂🏾" 1F442-1F3FE
+    19970, // "👂🏿" 1F442-1F3FF
+    19993, // "👂"
+    20015, // "👃🏻" 1F443-1F3FB
+    20039, // "👃🏼" 1F443-1F3FC
+    20061, // "👃🏽" 1F443-1F3FD
+    20085, // "👃🏾" 1F443-1F3FE
+    20112, // "👃🏿" 1F443-1F3FF
+    20139, // "👃"
+    20163, // "👄"
+    20186, // "👅"
+    20210, // "👆🏻" 1F446-1F3FB
+    20233, // "👆🏼" 1F446-1F3FC
+    20256, // "👆🏽" 1F446-1F3FD
+    20280, // "👆🏾" 1F446-1F3FE
+    20305, // "👆🏿" 1F446-1F3FF
+    20330, // "👆"
+    20353, // "👇🏻" 1F447-1F3FB
+    20377, // "👇🏼" 1F447-1F3FC
+    20400, // "👇🏽" 1F447-1F3FD
+    20423, // "👇🏾" 1F447-1F3FE
+    20447, // "👇🏿" 1F447-1F3FF
+    20473, // "👇"
+    20517, // "👈🏼" 1F448-1F3FC
+    20538, // "👈🏽" 1F448-1F3FD
+    20563, // "👈🏾" 1F448-1F3FE
+    20588, // "👈🏿" 1F448-1F3FF
+    20612, // "👈"
+    20636, // "👉🏻" 1F449-1F3FB
+    20682, // "👉🏽" 1F449-1F3FD
+    20706, // "👉🏾" 1F449-1F3FE
+    20730, // "👉🏿" 1F449-1F3FF
+    20754, // "👉"
+    20778, // "👊🏻" 1F44A-1F3FB
+    20822, // "👊🏽" 1F44A-1F3FD
+    20845, // "👊🏾" 1F44A-1F3FE
+    20893, // "👊"
+    20915, // "👋🏻" 1F44B-1F3FB
+    20947, // "👋🏼" 1F44B-1F3FC
+    20979, // "👋🏽" 1F44B-1F3FD
+    21011, // "👋🏾" 1F44B-1F3FE
+    21043, // "👋🏿" 1F44B-1F3FF
+    21074, // "👋"
+    21106, // "👌🏻" 1F44C-1F3FB
+    21128, // "👌🏼" 1F44C-1F3FC
+    21151, // "👌🏽" 1F44C-1F3FD
+    21175, // "👌🏾" 1F44C-1F3FE
+    21200, // "👌🏿" 1F44C-1F3FF
+    21225, // "👌"
+    21247, // "👍🏻" 1F44D-1F3FB
+    21274, // "👍🏼" 1F44D-1F3FC
+    21300, // "👍🏽" 1F44D-1F3FD
+    21326, // "👍🏾" 1F44D-1F3FE
+    21355, // "👍🏿" 1F44D-1F3FF
+    21384, // "👍"
+    21409, // "👎🏻" 1F44E-1F3FB
+    21436, // "👎🏼" 1F44E-1F3FC
+    21465, // "👎🏽" 1F44E-1F3FD
+    21494, // "👎🏾" 1F44E-1F3FE
+    21522, // "👎🏿" 1F44E-1F3FF
+    21551, // "👎"
+    21578, // "👏🏻" 1F44F-1F3FB
+    21610, // "👏🏼" 1F44F-1F3FC
+    21642, // "👏🏽" 1F44F-1F3FD
+    21673, // "👏🏾" 1F44F-1F3FE
+    21705, // "👏🏿" 1F44F-1F3FF
+    21737, // "👏"
+    21786, // "👐🏼" 1F450-1F3FC
+    21802, // "👐🏽" 1F450-1F3FD
+    21822, // "👐🏾" 1F450-1F3FE
+    21842, // "👐🏿" 1F450-1F3FF
+    21862, // "👐"
+    21882, // "👑"
+    21928, // "👓"
+    21941, // "👔"
+    21974, // "👕"
+    22003, // "👖"
+    22059, // "👘"
+    22088, // "👙"
+    22111, // "👚"
+    22139, // "👛"
+    22171, // "👜"
+    22202, // "👝"
+    22222, // "👞"
+    22251, // "👟"
+    22281, // "👠"
+    22310, // "👡"
+    22326, // "👢"
+    22358, // "👣"
+    22387, // "👤"
+    22446, // "👦🏻" 1F466-1F3FB
+    22475, // "👦🏼" 1F466-1F3FC
+    22531, // "👦🏾" 1F466-1F3FE
+    22561, // "👦🏿" 1F466-1F3FF
+    22592, // "👦"
+    22619, // "👧🏻" 1F467-1F3FB
+    22646, // "👧🏼" 1F467-1F3FC
+    22670, // "👧🏽" 1F467-1F3FD
+    22697, // "👧🏾" 1F467-1F3FE
+    22724, // "👧🏿" 1F467-1F3FF
+    22751, // "👧"
+    22775, // "👨🏻\u200d🌾" 1F468-1F3FB-200D-1F33E
+    22807, // "👨🏻\u200d🍳" 1F468-1F3FB-200D-1F373
+    22838, // "👨🏻\u200d🍼" 1F468-1F3FB-200D-1F37C
+    22889, // "👨🏻\u200d🎓" 1F468-1F3FB-200D-1F393
+    22915, // "👨🏻\u200d🎤" 1F468-1F3FB-200D-1F3A4
+    22946, // "👨🏻\u200d🎨" 1F468-1F3FB-200D-1F3A8
+    22977, // "👨🏻\u200d🏫" 1F468-1F3FB-200D-1F3EB
+    23009, // "👨🏻\u200d🏭" 1F468-1F3FB-200D-1F3ED
+    23072, // "👨🏻\u200d💼" 1F468-1F3FB-200D-1F4BC
+    23104, // "👨🏻\u200d🔧" 1F468-1F3FB-200D-1F527
+    23167, // "👨🏻\u200d🚀" 1F468-1F3FB-200D-1F680
+    23198, // "👨🏻\u200d🚒" 1F468-1F3FB-200D-1F692
+    23223, // "👨🏻\u200d🤝\u200d👨🏼" 1F468-1F3FB-200D-1F91D-200D-1F468-1F3FC
+    23250, // "👨🏻\u200d🤝\u200d👨🏽" 1F468-1F3FB-200D-1F91D-200D-1F468-1F3FD
+    23279, // "👨🏻\u200d🤝\u200d👨🏾" 1F468-1F3FB-200D-1F91D-200D-1F468-1F3FE
+    23307, // "👨🏻\u200d🤝\u200d👨🏿" 1F468-1F3FB-200D-1F91D-200D-1F468-1F3FF
+    23335, // "👨🏻\u200d🦯" 1F468-1F3FB-200D-1F9AF
+    23362, // "👨🏻\u200d🦰" 1F468-1F3FB-200D-1F9B0
+    23384, // "👨🏻\u200d🦱" 1F468-1F3FB-200D-1F9B1
+    23410, // "👨🏻\u200d🦲" 1F468-1F3FB-200D-1F9B2
+    23429, // "👨🏻\u200d🦳" 1F468-1F3FB-200D-1F9B3
+    23451, // "👨🏻\u200d🦼" 1F468-1F3FB-200D-1F9BC
+    23478, // "👨🏻\u200d🦽" 1F468-1F3FB-200D-1F9BD
+    23506, // "👨🏻\u200d⚕️" 1F468-1F3FB-200D-2695-FE0F
+    23532, // "👨🏻\u200d⚖️" 1F468-1F3FB-200D-2696-FE0F
+    23562, // "👨🏻\u200d✈️" 1F468-1F3FB-200D-2708-FE0F
+    23586, // "👨🏻" 1F468-1F3FB
+    23611, // "👨🏼\u200d🌾" 1F468-1F3FC-200D-1F33E
+    23643, // "👨🏼\u200d🍳" 1F468-1F3FC-200D-1F373
+    23673, // "👨🏼\u200d🍼" 1F468-1F3FC-200D-1F37C
+    23693, // "👨🏼\u200d🎄" 1F468-1F3FC-200D-1F384
+    23725, // "👨🏼\u200d🎓" 1F468-1F3FC-200D-1F393
+    23751, // "👨🏼\u200d🎤" 1F468-1F3FC-200D-1F3A4
+    23782, // "👨🏼\u200d🎨" 1F468-1F3FC-200D-1F3A8
+    23810, // "👨🏼\u200d🏫" 1F468-1F3FC-200D-1F3EB
+    23843, // "👨🏼\u200d🏭" 1F468-1F3FC-200D-1F3ED
+    23875, // "👨🏼\u200d💻" 1F468-1F3FC-200D-1F4BB
+    23905, // "👨🏼\u200d💼" 1F468-1F3FC-200D-1F4BC
+    23966, // "👨🏼\u200d🔬" 1F468-1F3FC-200D-1F52C
+    23996, // "👨🏼\u200d🚀" 1F468-1F3FC-200D-1F680
+    24027, // "👨🏼\u200d🚒" 1F468-1F3FC-200D-1F692
+    24052, // "👨🏼\u200d🤝\u200d👨🏻" 1F468-1F3FC-200D-1F91D-200D-1F468-1F3FB
+    24081, // "👨🏼\u200d🤝\u200d👨🏽" 1F468-1F3FC-200D-1F91D-200D-1F468-1F3FD
+    24109, // "👨🏼\u200d🤝\u200d👨🏾" 1F468-1F3FC-200D-1F91D-200D-1F468-1F3FE
+    24136, // "👨🏼\u200d🤝\u200d👨🏿" 1F468-1F3FC-200D-1F91D-200D-1F468-1F3FF
+    24165, // "👨🏼\u200d🦯" 1F468-1F3FC-200D-1F9AF
+    24193, // "👨🏼\u200d🦰" 1F468-1F3FC-200D-1F9B0
+    24217, // "👨🏼\u200d🦱" 1F468-1F3FC-200D-1F9B1
+    24240, // "👨🏼\u200d🦲" 1F468-1F3FC-200D-1F9B2
+    24259, // "👨🏼\u200d🦳" 1F468-1F3FC-200D-1F9B3
+    24281, // "👨🏼\u200d🦼" 1F468-1F3FC-200D-1F9BC
+    24310, // "👨🏼\u200d🦽" 1F468-1F3FC-200D-1F9BD
+    24338, // "👨🏼\u200d⚕️" 1F468-1F3FC-200D-2695-FE0F
+    24362, // "👨🏼\u200d⚖️" 1F468-1F3FC-200D-2696-FE0F
+    24392, // "👨🏼\u200d✈️" 1F468-1F3FC-200D-2708-FE0F
+    24416, // "👨🏼" 1F468-1F3FC
+    24443, // "👨🏽\u200d🌾" 1F468-1F3FD-200D-1F33E
+    24505, // "👨🏽\u200d🍼" 1F468-1F3FD-200D-1F37C
+    24526, // "👨🏽\u200d🎄" 1F468-1F3FD-200D-1F384
+    24559, // "👨🏽\u200d🎓" 1F468-1F3FD-200D-1F393
+    24584, // "👨🏽\u200d🎤" 1F468-1F3FD-200D-1F3A4
+    24616, // "👨🏽\u200d🎨" 1F468-1F3FD-200D-1F3A8
+    24678, // "👨🏽\u200d🏭" 1F468-1F3FD-200D-1F3ED
+    24710, // "👨🏽\u200d💻" 1F468-1F3FD-200D-1F4BB
+    24741, // "👨🏽\u200d💼" 1F468-1F3FD-200D-1F4BC
+    24773, // "👨🏽\u200d🔧" 1F468-1F3FD-200D-1F527
+    24805, // "👨🏽\u200d🔬" 1F468-1F3FD-200D-1F52C
+    24837, // "👨🏽\u200d🚀" 1F468-1F3FD-200D-1F680
+    24868, // "👨🏽\u200d🚒" 1F468-1F3FD-200D-1F692
+    24893, // "👨🏽\u200d🤝\u200d👨🏻" 1F468-1F3FD-200D-1F91D-200D-1F468-1F3FB
+    24920, // "👨🏽\u200d🤝\u200d👨🏼" 1F468-1F3FD-200D-1F91D-200D-1F468-1F3FC
+    24949, // "👨🏽\u200d🤝\u200d👨🏾" 1F468-1F3FD-200D-1F91D-200D-1F468-1F3FE
+    24978, // "👨🏽\u200d🤝\u200d👨🏿" 1F468-1F3FD-200D-1F91D-200D-1F468-1F3FF
+    25007, // "👨🏽\u200d🦯" 1F468-1F3FD-200D-1F9AF
+    25035, // "👨🏽\u200d🦰" 1F468-1F3FD-200D-1F9B0
+    25058, // "👨🏽\u200d🦱" 1F468-1F3FD-200D-1F9B1
+    25084, // "👨🏽\u200d🦲" 1F468-1F3FD-200D-1F9B2
+    25105, // "👨🏽\u200d🦳" 1F468-1F3FD-200D-1F9B3
+    25127, // "👨🏽\u200d🦼" 1F468-1F3FD-200D-1F9BC
+    25155, // "👨🏽\u200d🦽" 1F468-1F3FD-200D-1F9BD
+    25182, // "👨🏽\u200d⚕️" 1F468-1F3FD-200D-2695-FE0F
+    25206, // "👨🏽\u200d⚖️" 1F468-1F3FD-200D-2696-FE0F
+    25236, // "👨🏽\u200d✈️" 1F468-1F3FD-200D-2708-FE0F
+    25261, // "👨🏽" 1F468-1F3FD
+    25287, // "👨🏾\u200d🌾" 1F468-1F3FE-200D-1F33E
+    25319, // "👨🏾\u200d🍳" 1F468-1F3FE-200D-1F373
+    25349, // "👨🏾\u200d🍼" 1F468-1F3FE-200D-1F37C
+    25400, // "👨🏾\u200d🎓" 1F468-1F3FE-200D-1F393
+    25426, // "👨🏾\u200d🎤" 1F468-1F3FE-200D-1F3A4
+    25457, // "👨🏾\u200d🎨" 1F468-1F3FE-200D-1F3A8
+    25489, // "👨🏾\u200d🏫" 1F468-1F3FE-200D-1F3EB
+    25521, // "👨🏾\u200d🏭" 1F468-1F3FE-200D-1F3ED
+    25584, // "👨🏾\u200d💼" 1F468-1F3FE-200D-1F4BC
+    25616, // "👨🏾\u200d🔧" 1F468-1F3FE-200D-1F527
+    25679, // "👨🏾\u200d🚀" 1F468-1F3FE-200D-1F680
+    25709, // "👨🏾\u200d🚒" 1F468-1F3FE-200D-1F692
+    25735, // "👨🏾\u200d🤝\u200d👨🏻" 1F468-1F3FE-200D-1F91D-200D-1F468-1F3FB
+    25764, // "👨🏾\u200d🤝\u200d👨🏼" 1F468-1F3FE-200D-1F91D-200D-1F468-1F3FC
+    25791, // "👨🏾\u200d🤝\u200d👨🏽" 1F468-1F3FE-200D-1F91D-200D-1F468-1F3FD
+    25818, // "👨🏾\u200d🤝\u200d👨🏿" 1F468-1F3FE-200D-1F91D-200D-1F468-1F3FF
+    25847, // "👨🏾\u200d🦯" 1F468-1F3FE-200D-1F9AF
+    25874, // "👨🏾\u200d🦰" 1F468-1F3FE-200D-1F9B0
+    25898, // "👨🏾\u200d🦱" 1F468-1F3FE-200D-1F9B1
+    25925, // "👨🏾\u200d🦲" 1F468-1F3FE-200D-1F9B2
+    25947, // "👨🏾\u200d🦳" 1F468-1F3FE-200D-1F9B3
+    25971, // "👨🏾\u200d🦼" 1F468-1F3FE-200D-1F9BC
+    25998, // "👨🏾\u200d🦽" 1F468-1F3FE-200D-1F9BD
+    26026, // "👨🏾\u200d⚕️" 1F468-1F3FE-200D-2695-FE0F
+    26052, // "👨🏾\u200d⚖️" 1F468-1F3FE-200D-2696-FE0F
+    26082, // "👨🏾\u200d✈️" 1F468-1F3FE-200D-2708-FE0F
+    26106, // "👨🏾" 1F468-1F3FE
+    26133, // "👨🏿\u200d🌾" 1F468-1F3FF-200D-1F33E
+    26196, // "👨🏿\u200d🍼" 1F468-1F3FF-200D-1F37C
+    26217, // "👨🏿\u200d🎄" 1F468-1F3FF-200D-1F384
+    26250, // "👨🏿\u200d🎓" 1F468-1F3FF-200D-1F393
+    26275, // "👨🏿\u200d🎤" 1F468-1F3FF-200D-1F3A4
+    26306, // "👨🏿\u200d🎨" 1F468-1F3FF-200D-1F3A8
+    26335, // "👨🏿\u200d🏫" 1F468-1F3FF-200D-1F3EB
+    26368, // "👨🏿\u200d🏭" 1F468-1F3FF-200D-1F3ED
+    26400, // "👨🏿\u200d💻" 1F468-1F3FF-200D-1F4BB
+    26432, // "👨🏿\u200d💼" 1F468-1F3FF-200D-1F4BC
+    26493, // "👨🏿\u200d🔬" 1F468-1F3FF-200D-1F52C
+    26524, // "👨🏿\u200d🚀" 1F468-1F3FF-200D-1F680
+    26555, // "👨🏿\u200d🚒" 1F468-1F3FF-200D-1F692
+    26580, // "👨🏿\u200d🤝\u200d👨🏻" 1F468-1F3FF-200D-1F91D-200D-1F468-1F3FB
+    26607, // "👨🏿\u200d🤝\u200d👨🏼" 1F468-1F3FF-200D-1F91D-200D-1F468-1F3FC
+    26634, // "👨🏿\u200d🤝\u200d👨🏽" 1F468-1F3FF-200D-1F91D-200D-1F468-1F3FD
+    26663, // "👨🏿\u200d🤝\u200d👨🏾" 1F468-1F3FF-200D-1F91D-200D-1F468-1F3FE
+    26692, // "👨🏿\u200d🦯" 1F468-1F3FF-200D-1F9AF
+    26720, // "👨🏿\u200d🦰" 1F468-1F3FF-200D-1F9B0
+    26744, // "👨🏿\u200d🦱" 1F468-1F3FF-200D-1F9B1
+    26771, // "👨🏿\u200d🦲" 1F468-1F3FF-200D-1F9B2
+    26794, // "👨🏿\u200d🦳" 1F468-1F3FF-200D-1F9B3
+    26817, // "👨🏿\u200d🦼" 1F468-1F3FF-200D-1F9BC
+    26846, // "👨🏿\u200d🦽" 1F468-1F3FF-200D-1F9BD
+    26874, // "👨🏿\u200d⚕️" 1F468-1F3FF-200D-2695-FE0F
+    26900, // "👨🏿\u200d⚖️" 1F468-1F3FF-200D-2696-FE0F
+    26930, // "👨🏿\u200d✈️" 1F468-1F3FF-200D-2708-FE0F
+    26954, // "👨🏿" 1F468-1F3FF
+    26981, // "👨\u200d🌾" 1F468-200D-1F33E
+    27013, // "👨\u200d🍳" 1F468-200D-1F373
+    27044, // "👨\u200d🍼" 1F468-200D-1F37C
+    27095, // "👨\u200d🎓" 1F468-200D-1F393
+    27121, // "👨\u200d🎤" 1F468-200D-1F3A4
+    27152, // "👨\u200d🎨" 1F468-200D-1F3A8
+    27213, // "👨\u200d🏭" 1F468-200D-1F3ED
+    27245, // "👨\u200d👦\u200d👦" 1F468-200D-1F466-200D-1F466
+    27304, // "👨\u200d👧\u200d👦" 1F468-200D-1F467-200D-1F466
+    27336, // "👨\u200d👧\u200d👧" 1F468-200D-1F467-200D-1F467
+    27368, // "👨\u200d👧" 1F468-200D-1F467
+    27399, // "👨\u200d👨\u200d👦\u200d👦" 1F468-200D-1F468-200D-1F466-200D-1F466
+    27431, // "👨\u200d👨\u200d👦" 1F468-200D-1F468-200D-1F466
+    27463, // "👨\u200d👨\u200d👧\u200d👦" 1F468-200D-1F468-200D-1F467-200D-1F466
+    27495, // "👨\u200d👨\u200d👧\u200d👧" 1F468-200D-1F468-200D-1F467-200D-1F467
+    27527, // "👨\u200d👨\u200d👧" 1F468-200D-1F468-200D-1F467
+    27559, // "👨\u200d👩\u200d👦\u200d👦" 1F468-200D-1F469-200D-1F466-200D-1F466
+    27591, // "👨\u200d👩\u200d👦" 1F468-200D-1F469-200D-1F466
+    27623, // "👨\u200d👩\u200d👧\u200d👦" 1F468-200D-1F469-200D-1F467-200D-1F466
+    27655, // "👨\u200d👩\u200d👧\u200d👧" 1F468-200D-1F469-200D-1F467-200D-1F467
+    27687, // "👨\u200d👩\u200d👧" 1F468-200D-1F469-200D-1F467
+    27719, // "👨\u200d💻" 1F468-200D-1F4BB
+    27751, // "👨\u200d💼" 1F468-200D-1F4BC
+    27812, // "👨\u200d🔬" 1F468-200D-1F52C
+    27842, // "👨\u200d🚀" 1F468-200D-1F680
+    27873, // "👨\u200d🚒" 1F468-200D-1F692
+    27898, // "👨\u200d🦯" 1F468-200D-1F9AF
+    27924, // "👨\u200d🦰" 1F468-200D-1F9B0
+    27947, // "👨\u200d🦱" 1F468-200D-1F9B1
+    27971, // "👨\u200d🦲" 1F468-200D-1F9B2
+    27990, // "👨\u200d🦳" 1F468-200D-1F9B3
+    28012, // "👨\u200d🦼" 1F468-200D-1F9BC
+    28041, // "👨\u200d🦽" 1F468-200D-1F9BD
+    28069, // "👨\u200d⚕️" 1F468-200D-2695-FE0F
+    28094, // "👨\u200d⚖️" 1F468-200D-2696-FE0F
+    28124, // "👨\u200d✈️" 1F468-200D-2708-FE0F
+    28148, // "👨\u200d❤️\u200d👨" 1F468-200D-2764-FE0F-200D-1F468
+    28180, // "👨\u200d❤️\u200d💋\u200d👨" 1F468-200D-2764-FE0F-200D-1F48B-200D-1F468
+    28209, // "👨"
+    28235, // "👩🏻\u200d🌾" 1F469-1F3FB-200D-1F33E
+    28267, // "👩🏻\u200d🍳" 1F469-1F3FB-200D-1F373
+    28296, // "👩🏻\u200d🍼" 1F469-1F3FB-200D-1F37C
+    28318, // "👩🏻\u200d🎄" 1F469-1F3FB-200D-1F384
+    28342, // "👩🏻\u200d🎓" 1F469-1F3FB-200D-1F393
+    28368, // "👩🏻\u200d🎤" 1F469-1F3FB-200D-1F3A4
+    28397, // "👩🏻\u200d🎨" 1F469-1F3FB-200D-1F3A8
+    28426, // "👩🏻\u200d🏫" 1F469-1F3FB-200D-1F3EB
+    28488, // "👩🏻\u200d💻" 1F469-1F3FB-200D-1F4BB
+    28520, // "👩🏻\u200d💼" 1F469-1F3FB-200D-1F4BC
+    28611, // "👩🏻\u200d🚀" 1F469-1F3FB-200D-1F680
+    28643, // "👩🏻\u200d🚒" 1F469-1F3FB-200D-1F692
+    28668, // "👩🏻\u200d🤝\u200d👨🏼" 1F469-1F3FB-200D-1F91D-200D-1F468-1F3FC
+    28697, // "👩🏻\u200d🤝\u200d👨🏽" 1F469-1F3FB-200D-1F91D-200D-1F468-1F3FD
+    28723, // "👩🏻\u200d🤝\u200d👨🏾" 1F469-1F3FB-200D-1F91D-200D-1F468-1F3FE
+    28750, // "👩🏻\u200d🤝\u200d👨🏿" 1F469-1F3FB-200D-1F91D-200D-1F468-1F3FF
+    28779, // "👩🏻\u200d🤝\u200d👩🏼" 1F469-1F3FB-200D-1F91D-200D-1F469-1F3FC
+    28805, // "👩🏻\u200d🤝\u200d👩🏽" 1F469-1F3FB-200D-1F91D-200D-1F469-1F3FD
+    28832, // "👩🏻\u200d🤝\u200d👩🏾" 1F469-1F3FB-200D-1F91D-200D-1F469-1F3FE
+    28861, // "👩🏻\u200d🤝\u200d👩🏿" 1F469-1F3FB-200D-1F91D-200D-1F469-1F3FF
+    28889, // "👩🏻\u200d🦯" 1F469-1F3FB-200D-1F9AF
+    28917, // "👩🏻\u200d🦰" 1F469-1F3FB-200D-1F9B0
+    28945, // "👩🏻\u200d🦱" 1F469-1F3FB-200D-1F9B1
+    28975, // "👩🏻\u200d🦲" 1F469-1F3FB-200D-1F9B2
+    28995, // "👩🏻\u200d🦳" 1F469-1F3FB-200D-1F9B3
+    29022, // "👩🏻\u200d🦼" 1F469-1F3FB-200D-1F9BC
+    29051, // "👩🏻\u200d🦽" 1F469-1F3FB-200D-1F9BD
+    29079, // "👩🏻\u200d⚕️" 1F469-1F3FB-200D-2695-FE0F
+    29102, // "👩🏻\u200d⚖️" 1F469-1F3FB-200D-2696-FE0F
+    29132, // "👩🏻\u200d✈️" 1F469-1F3FB-200D-2708-FE0F
+    29156, // "👩🏻" 1F469-1F3FB
+    29184, // "👩🏼\u200d🌾" 1F469-1F3FC-200D-1F33E
+    29216, // "👩🏼\u200d🍳" 1F469-1F3FC-200D-1F373
+    29245, // "👩🏼\u200d🍼" 1F469-1F3FC-200D-1F37C
+    29265, // "👩🏼\u200d🎄" 1F469-1F3FC-200D-1F384
+    29290, // "👩🏼\u200d🎓" 1F469-1F3FC-200D-1F393
+    29314, // "👩🏼\u200d🎤" 1F469-1F3FC-200D-1F3A4
+    29345, // "👩🏼\u200d🎨" 1F469-1F3FC-200D-1F3A8
+    29376, // "👩🏼\u200d🏫" 1F469-1F3FC-200D-1F3EB
+    29408, // "👩🏼\u200d🏭" 1F469-1F3FC-200D-1F3ED
+    29440, // "👩🏼\u200d💻" 1F469-1F3FC-200D-1F4BB
+    29469, // "👩🏼\u200d💼" 1F469-1F3FC-200D-1F4BC
+    29501, // "👩🏼\u200d🔧" 1F469-1F3FC-200D-1F527
+    29533, // "👩🏼\u200d🔬" 1F469-1F3FC-200D-1F52C
+    29588, // "👩🏼\u200d🚒" 1F469-1F3FC-200D-1F692
+    29614, // "👩🏼\u200d🤝\u200d👨🏻" 1F469-1F3FC-200D-1F91D-200D-1F468-1F3FB
+    29641, // "👩🏼\u200d🤝\u200d👨🏽" 1F469-1F3FC-200D-1F91D-200D-1F468-1F3FD
+    29670, // "👩🏼\u200d🤝\u200d👨🏾" 1F469-1F3FC-200D-1F91D-200D-1F468-1F3FE
+    29698, // "👩🏼\u200d🤝\u200d👨🏿" 1F469-1F3FC-200D-1F91D-200D-1F468-1F3FF
+    29725, // "👩🏼\u200d🤝\u200d👩🏻" 1F469-1F3FC-200D-1F91D-200D-1F469-1F3FB
+    29754, // "👩🏼\u200d🤝\u200d👩🏽" 1F469-1F3FC-200D-1F91D-200D-1F469-1F3FD
+    29782, // "👩🏼\u200d🤝\u200d👩🏾" 1F469-1F3FC-200D-1F91D-200D-1F469-1F3FE
+    29809, // "👩🏼\u200d🤝\u200d👩🏿" 1F469-1F3FC-200D-1F91D-200D-1F469-1F3FF
+    29838, // "👩🏼\u200d🦯" 1F469-1F3FC-200D-1F9AF
+    29866, // "👩🏼\u200d🦰" 1F469-1F3FC-200D-1F9B0
+    29919, // "👩🏼\u200d🦲" 1F469-1F3FC-200D-1F9B2
+    29939, // "👩🏼\u200d🦳" 1F469-1F3FC-200D-1F9B3
+    29963, // "👩🏼\u200d🦼" 1F469-1F3FC-200D-1F9BC
+    29990, // "👩🏼\u200d🦽" 1F469-1F3FC-200D-1F9BD
+    30018, // "👩🏼\u200d⚕️" 1F469-1F3FC-200D-2695-FE0F
+    30040, // "👩🏼\u200d⚖️" 1F469-1F3FC-200D-2696-FE0F
+    30069, // "👩🏼\u200d✈️" 1F469-1F3FC-200D-2708-FE0F
+    30092, // "👩🏼" 1F469-1F3FC
+    30119, // "👩🏽\u200d🌾" 1F469-1F3FD-200D-1F33E
+    30182, // "👩🏽\u200d🍼" 1F469-1F3FD-200D-1F37C
+    30203, // "👩🏽\u200d🎄" 1F469-1F3FD-200D-1F384
+    30229, // "👩🏽\u200d🎓" 1F469-1F3FD-200D-1F393
+    30253, // "👩🏽\u200d🎤" 1F469-1F3FD-200D-1F3A4
+    30283, // "👩🏽\u200d🎨" 1F469-1F3FD-200D-1F3A8
+    30314, // "👩🏽\u200d🏫" 1F469-1F3FD-200D-1F3EB
+    30375, // "👩🏽\u200d💻" 1F469-1F3FD-200D-1F4BB
+    30407, // "👩🏽\u200d💼" 1F469-1F3FD-200D-1F4BC
+    30468, // "👩🏽\u200d🔬" 1F469-1F3FD-200D-1F52C
+    30498, // "👩🏽\u200d🚀" 1F469-1F3FD-200D-1F680
+    30528, // "👩🏽\u200d🚒" 1F469-1F3FD-200D-1F692
+    30553, // "👩🏽\u200d🤝\u200d👨🏻" 1F469-1F3FD-200D-1F91D-200D-1F468-1F3FB
+    30580, // "👩🏽\u200d🤝\u200d👨🏼" 1F469-1F3FD-200D-1F91D-200D-1F468-1F3FC
+    30607, // "👩🏽\u200d🤝\u200d👨🏾" 1F469-1F3FD-200D-1F91D-200D-1F468-1F3FE
+    30636, // "👩🏽\u200d🤝\u200d👨🏿" 1F469-1F3FD-200D-1F91D-200D-1F468-1F3FF
+    30665, // "👩🏽\u200d🤝\u200d👩🏻" 1F469-1F3FD-200D-1F91D-200D-1F469-1F3FB
+    30692, // "👩🏽\u200d🤝\u200d👩🏼" 1F469-1F3FD-200D-1F91D-200D-1F469-1F3FC
+    30721, // "👩🏽\u200d🤝\u200d👩🏾" 1F469-1F3FD-200D-1F91D-200D-1F469-1F3FE
+    30750, // "👩🏽\u200d🤝\u200d👩🏿" 1F469-1F3FD-200D-1F91D-200D-1F469-1F3FF
+    30777, // "👩🏽\u200d🦯" 1F469-1F3FD-200D-1F9AF
+    30804, // "👩🏽\u200d🦰" 1F469-1F3FD-200D-1F9B0
+    30832, // "👩🏽\u200d🦱" 1F469-1F3FD-200D-1F9B1
+    30862, // "👩🏽\u200d🦲" 1F469-1F3FD-200D-1F9B2
+    30884, // "👩🏽\u200d🦳" 1F469-1F3FD-200D-1F9B3
+    30910, // "👩🏽\u200d🦼" 1F469-1F3FD-200D-1F9BC
+    30939, // "👩🏽\u200d🦽" 1F469-1F3FD-200D-1F9BD
+    30966, // "👩🏽\u200d⚕️" 1F469-1F3FD-200D-2695-FE0F
+    30988, // "👩🏽\u200d⚖️" 1F469-1F3FD-200D-2696-FE0F
+    31018, // "👩🏽\u200d✈️" 1F469-1F3FD-200D-2708-FE0F
+    31043, // "👩🏽" 1F469-1F3FD
+    31071, // "👩🏾\u200d🌾" 1F469-1F3FE-200D-1F33E
+    31103, // "👩🏾\u200d🍳" 1F469-1F3FE-200D-1F373
+    31132, // "👩🏾\u200d🍼" 1F469-1F3FE-200D-1F37C
+    31154, // "👩🏾\u200d🎄" 1F469-1F3FE-200D-1F384
+    31180, // "👩🏾\u200d🎓" 1F469-1F3FE-200D-1F393
+    31206, // "👩🏾\u200d🎤" 1F469-1F3FE-200D-1F3A4
+    31235, // "👩🏾\u200d🎨" 1F469-1F3FE-200D-1F3A8
+    31266, // "👩🏾\u200d🏫" 1F469-1F3FE-200D-1F3EB
+    31298, // "👩🏾\u200d🏭" 1F469-1F3FE-200D-1F3ED
+    31330, // "👩🏾\u200d💻" 1F469-1F3FE-200D-1F4BB
+    31361, // "👩🏾\u200d💼" 1F469-1F3FE-200D-1F4BC
+    31393, // "👩🏾\u200d🔧" 1F469-1F3FE-200D-1F527
+    31452, // "👩🏾\u200d🚀" 1F469-1F3FE-200D-1F680
+    31484, // "👩🏾\u200d🚒" 1F469-1F3FE-200D-1F692
+    31509, // "👩🏾\u200d🤝\u200d👨🏻" 1F469-1F3FE-200D-1F91D-200D-1F468-1F3FB
+    31538, // "👩🏾\u200d🤝\u200d👨🏼" 1F469-1F3FE-200D-1F91D-200D-1F468-1F3FC
+    31565, // "👩🏾\u200d🤝\u200d👨🏽" 1F469-1F3FE-200D-1F91D-200D-1F468-1F3FD
+    31592, // "👩🏾\u200d🤝\u200d👨🏿" 1F469-1F3FE-200D-1F91D-200D-1F468-1F3FF
+    31621, // "👩🏾\u200d🤝\u200d👩🏻" 1F469-1F3FE-200D-1F91D-200D-1F469-1F3FB
+    31649, // "👩🏾\u200d🤝\u200d👩🏼" 1F469-1F3FE-200D-1F91D-200D-1F469-1F3FC
+    31676, // "👩🏾\u200d🤝\u200d👩🏽" 1F469-1F3FE-200D-1F91D-200D-1F469-1F3FD
+    31705, // "👩🏾\u200d🤝\u200d👩🏿" 1F469-1F3FE-200D-1F91D-200D-1F469-1F3FF
+    31734, // "👩🏾\u200d🦯" 1F469-1F3FE-200D-1F9AF
+    31760, // "👩🏾\u200d🦰" 1F469-1F3FE-200D-1F9B0
+    31788, // "👩🏾\u200d🦱" 1F469-1F3FE-200D-1F9B1
+    31818, // "👩🏾\u200d🦲" 1F469-1F3FE-200D-1F9B2
+    31839, // "👩🏾\u200d🦳" 1F469-1F3FE-200D-1F9B3
+    31863, // "👩🏾\u200d🦼" 1F469-1F3FE-200D-1F9BC
+    31892, // "👩🏾\u200d🦽" 1F469-1F3FE-200D-1F9BD
+    31920, // "👩🏾\u200d⚕️" 1F469-1F3FE-200D-2695-FE0F
+    31943, // "👩🏾\u200d⚖️" 1F469-1F3FE-200D-2696-FE0F
+    31973, // "👩🏾\u200d✈️" 1F469-1F3FE-200D-2708-FE0F
+    31997, // "👩🏾" 1F469-1F3FE
+    32025, // "👩🏿\u200d🌾" 1F469-1F3FF-200D-1F33E
+    32057, // "👩🏿\u200d🍳" 1F469-1F3FF-200D-1F373
+    32086, // "👩🏿\u200d🍼" 1F469-1F3FF-200D-1F37C
+    32107, // "👩🏿\u200d🎄" 1F469-1F3FF-200D-1F384
+    32133, // "👩🏿\u200d🎓" 1F469-1F3FF-200D-1F393
+    32158, // "👩🏿\u200d🎤" 1F469-1F3FF-200D-1F3A4
+    32188, // "👩🏿\u200d🎨" 1F469-1F3FF-200D-1F3A8
+    32219, // "👩🏿\u200d🏫" 1F469-1F3FF-200D-1F3EB
+    32250, // "👩🏿\u200d🏭" 1F469-1F3FF-200D-1F3ED
+    32282, // "👩🏿\u200d💻" 1F469-1F3FF-200D-1F4BB
+    32313, // "👩🏿\u200d💼" 1F469-1F3FF-200D-1F4BC
+    32345, // "👩🏿\u200d🔧" 1F469-1F3FF-200D-1F527
+    32377, // "👩🏿\u200d🔬" 1F469-1F3FF-200D-1F52C
+    32433, // "👩🏿\u200d🚒" 1F469-1F3FF-200D-1F692
+    32459, // "👩🏿\u200d🤝\u200d👨🏻" 1F469-1F3FF-200D-1F91D-200D-1F468-1F3FB
+    32486, // "👩🏿\u200d🤝\u200d👨🏼" 1F469-1F3FF-200D-1F91D-200D-1F468-1F3FC
+    32515, // "👩🏿\u200d🤝\u200d👨🏽" 1F469-1F3FF-200D-1F91D-200D-1F468-1F3FD
+    32544, // "👩🏿\u200d🤝\u200d👨🏾" 1F469-1F3FF-200D-1F91D-200D-1F468-1F3FE
+    32571, // "👩🏿\u200d🤝\u200d👩🏻" 1F469-1F3FF-200D-1F91D-200D-1F469-1F3FB
+    32600, // "👩🏿\u200d🤝\u200d👩🏼" 1F469-1F3FF-200D-1F91D-200D-1F469-1F3FC
+    32629, // "👩🏿\u200d🤝\u200d👩🏽" 1F469-1F3FF-200D-1F91D-200D-1F469-1F3FD
+    32657, // "👩🏿\u200d🤝\u200d👩🏾" 1F469-1F3FF-200D-1F91D-200D-1F469-1F3FE
+    32685, // "👩🏿\u200d🦯" 1F469-1F3FF-200D-1F9AF
+    32712, // "👩🏿\u200d🦰" 1F469-1F3FF-200D-1F9B0
+    32740, // "👩🏿\u200d🦱" 1F469-1F3FF-200D-1F9B1
+    32770, // "👩🏿\u200d🦲" 1F469-1F3FF-200D-1F9B2
+    32791, // "👩🏿\u200d🦳" 1F469-1F3FF-200D-1F9B3
+    32815, // "👩🏿\u200d🦼" 1F469-1F3FF-200D-1F9BC
+    32842, // "👩🏿\u200d🦽" 1F469-1F3FF-200D-1F9BD
+    32870, // "👩🏿\u200d⚕️" 1F469-1F3FF-200D-2695-FE0F
+    32893, // "👩🏿\u200d⚖️" 1F469-1F3FF-200D-2696-FE0F
+    32922, // "👩🏿\u200d✈️" 1F469-1F3FF-200D-2708-FE0F
+    32945, // "👩🏿" 1F469-1F3FF
+    32973, // "👩\u200d🌾" 1F469-200D-1F33E
+    33036, // "👩\u200d🍼" 1F469-200D-1F37C
+    33056, // "👩\u200d🎄" 1F469-200D-1F384
+    33081, // "👩\u200d🎓" 1F469-200D-1F393
+    33106, // "👩\u200d🎤" 1F469-200D-1F3A4
+    33136, // "👩\u200d🎨" 1F469-200D-1F3A8
+    33165, // "👩\u200d🏫" 1F469-200D-1F3EB
+    33227, // "👩\u200d👦\u200d👦" 1F469-200D-1F466-200D-1F466
+    33316, // "👩\u200d👧\u200d👧" 1F469-200D-1F467-200D-1F467
+    33346, // "👩\u200d👧" 1F469-200D-1F467
+    33378, // "👩\u200d👩\u200d👦\u200d👦" 1F469-200D-1F469-200D-1F466-200D-1F466
+    33410, // "👩\u200d👩\u200d👦" 1F469-200D-1F469-200D-1F466
+    33442, // "👩\u200d👩\u200d👧\u200d👦" 1F469-200D-1F469-200D-1F467-200D-1F466
+    33475, // "👩\u200d👩\u200d👧\u200d👧" 1F469-200D-1F469-200D-1F467-200D-1F467
+    33507, // "👩\u200d👩\u200d👧" 1F469-200D-1F469-200D-1F467
+    33539, // "👩\u200d💻" 1F469-200D-1F4BB
+    33571, // "👩\u200d💼" 1F469-200D-1F4BC
+    33633, // "👩\u200d🔬" 1F469-200D-1F52C
+    33663, // "👩\u200d🚀" 1F469-200D-1F680
+    33692, // "👩\u200d🚒" 1F469-200D-1F692
+    33717, // "👩\u200d🦯" 1F469-200D-1F9AF
+    33745, // "👩\u200d🦰" 1F469-200D-1F9B0
+    33773, // "👩\u200d🦱" 1F469-200D-1F9B1
+    33802, // "👩\u200d🦲" 1F469-200D-1F9B2
+    33821, // "👩\u200d🦳" 1F469-200D-1F9B3
+    33845, // "👩\u200d🦼" 1F469-200D-1F9BC
+    33874, // "👩\u200d🦽" 1F469-200D-1F9BD
+    33902, // "👩\u200d⚕️" 1F469-200D-2695-FE0F
+    33924, // "👩\u200d⚖️" 1F469-200D-2696-FE0F
+    33953, // "👩\u200d✈️" 1F469-200D-2708-FE0F
+    33976, // "👩\u200d❤️\u200d👨" 1F469-200D-2764-FE0F-200D-1F468
+    34008, // "👩\u200d❤️\u200d👩" 1F469-200D-2764-FE0F-200D-1F469
+    34041, // "👩\u200d❤️\u200d💋\u200d👨" 1F469-200D-2764-FE0F-200D-1F48B-200D-1F468
+    34073, // "👩\u200d❤️\u200d💋\u200d👩" 1F469-200D-2764-FE0F-200D-1F48B-200D-1F469
+    34105, // "👩"
+    34130, // "👪"
+    34162, // "👫🏻" 1F46B-1F3FB
+    34188, // "👫🏼" 1F46B-1F3FC
+    34215, // "👫🏽" 1F46B-1F3FD
+    34244, // "👫🏾" 1F46B-1F3FE
+    34273, // "👫🏿" 1F46B-1F3FF
+    34302, // "👫"
+    34331, // "👬🏻" 1F46C-1F3FB
+    34357, // "👬🏼" 1F46C-1F3FC
+    34384, // "👬🏽" 1F46C-1F3FD
+    34413, // "👬🏾" 1F46C-1F3FE
+    34442, // "👬🏿" 1F46C-1F3FF
+    34471, // "👬"
+    34498, // "👭🏻" 1F46D-1F3FB
+    34525, // "👭🏼" 1F46D-1F3FC
+    34550, // "👭🏽" 1F46D-1F3FD
+    34577, // "👭🏾" 1F46D-1F3FE
+    34606, // "👭🏿" 1F46D-1F3FF
+    34635, // "👭"
+    34662, // "👮🏻\u200d♀️" 1F46E-1F3FB-200D-2640-FE0F
+    34686, // "👮🏻\u200d♂️" 1F46E-1F3FB-200D-2642-FE0F
+    34712, // "👮🏻" 1F46E-1F3FB
+    34737, // "👮🏼\u200d♀️" 1F46E-1F3FC-200D-2640-FE0F
+    34761, // "👮🏼\u200d♂️" 1F46E-1F3FC-200D-2642-FE0F
+    34787, // "👮🏼" 1F46E-1F3FC
+    34812, // "👮🏽\u200d♀️" 1F46E-1F3FD-200D-2640-FE0F
+    34836, // "👮🏽\u200d♂️" 1F46E-1F3FD-200D-2642-FE0F
+    34861, // "👮🏽" 1F46E-1F3FD
+    34887, // "👮🏾\u200d♀️" 1F46E-1F3FE-200D-2640-FE0F
+    34911, // "👮🏾\u200d♂️" 1F46E-1F3FE-200D-2642-FE0F
+    34936, // "👮🏾" 1F46E-1F3FE
+    34962, // "👮🏿\u200d♀️" 1F46E-1F3FF-200D-2640-FE0F
+    34986, // "👮🏿\u200d♂️" 1F46E-1F3FF-200D-2642-FE0F
+    35011, // "👮🏿" 1F46E-1F3FF
+    35037, // "👮\u200d♀️" 1F46E-200D-2640-FE0F
+    35061, // "👮\u200d♂️" 1F46E-200D-2642-FE0F
+    35086, // "👮"
+    35112, // "👯\u200d♀️" 1F46F-200D-2640-FE0F
+    35173, // "👯"
+    35203, // "👰🏻\u200d♀️" 1F470-1F3FB-200D-2640-FE0F
+    35293, // "👰🏼\u200d♀️" 1F470-1F3FC-200D-2640-FE0F
+    35323, // "👰🏼\u200d♂️" 1F470-1F3FC-200D-2642-FE0F
+    35384, // "👰🏽\u200d♀️" 1F470-1F3FD-200D-2640-FE0F
+    35414, // "👰🏽\u200d♂️" 1F470-1F3FD-200D-2642-FE0F
+    35475, // "👰🏾\u200d♀️" 1F470-1F3FE-200D-2640-FE0F
+    35505, // "👰🏾\u200d♂️" 1F470-1F3FE-200D-2642-FE0F
+    35566, // "👰🏿\u200d♀️" 1F470-1F3FF-200D-2640-FE0F
+    35596, // "👰🏿\u200d♂️" 1F470-1F3FF-200D-2642-FE0F
+    35657, // "👰\u200d♀️" 1F470-200D-2640-FE0F
+    35687, // "👰\u200d♂️" 1F470-200D-2642-FE0F
+    35748, // "👱🏻\u200d♀️" 1F471-1F3FB-200D-2640-FE0F
+    35774, // "👱🏻\u200d♂️" 1F471-1F3FB-200D-2642-FE0F
+    35796, // "👱🏻" 1F471-1F3FB
+    35820, // "👱🏼\u200d♀️" 1F471-1F3FC-200D-2640-FE0F
+    35846, // "👱🏼\u200d♂️" 1F471-1F3FC-200D-2642-FE0F
+    35868, // "👱🏼" 1F471-1F3FC
+    35892, // "👱🏽\u200d♀️" 1F471-1F3FD-200D-2640-FE0F
+    35918, // "👱🏽\u200d♂️" 1F471-1F3FD-200D-2642-FE0F
+    35940, // "👱🏽" 1F471-1F3FD
+    35965, // "👱🏾\u200d♀️" 1F471-1F3FE-200D-2640-FE0F
+    35991, // "👱🏾\u200d♂️" 1F471-1F3FE-200D-2642-FE0F
+    36015, // "👱🏾" 1F471-1F3FE
+    36040, // "👱🏿\u200d♀️" 1F471-1F3FF-200D-2640-FE0F
+    36066, // "👱🏿\u200d♂️" 1F471-1F3FF-200D-2642-FE0F
+    36090, // "👱🏿" 1F471-1F3FF
+    36115, // "👱\u200d♀️" 1F471-200D-2640-FE0F
+    36141, // "👱\u200d♂️" 1F471-200D-2642-FE0F
+    36163, // "👱"
+    36186, // "👲🏻" 1F472-1F3FB
+    36213, // "👲🏼" 1F472-1F3FC
+    36239, // "👲🏽" 1F472-1F3FD
+    36265, // "👲🏾" 1F472-1F3FE
+    36292, // "👲🏿" 1F472-1F3FF
+    36319, // "👲"
+    36345, // "👳🏻\u200d♀️" 1F473-1F3FB-200D-2640-FE0F
+    36367, // "👳🏻\u200d♂️" 1F473-1F3FB-200D-2642-FE0F
+    36389, // "👳🏻" 1F473-1F3FB
+    36413, // "👳🏼\u200d♀️" 1F473-1F3FC-200D-2640-FE0F
+    36435, // "👳🏼\u200d♂️" 1F473-1F3FC-200D-2642-FE0F
+    36457, // "👳🏼" 1F473-1F3FC
+    36481, // "👳🏽\u200d♀️" 1F473-1F3FD-200D-2640-FE0F
+    36503, // "👳🏽\u200d♂️" 1F473-1F3FD-200D-2642-FE0F
+    36525, // "👳🏽" 1F473-1F3FD
+    36549, // "👳🏾\u200d♀️" 1F473-1F3FE-200D-2640-FE0F
+    36571, // "👳🏾\u200d♂️" 1F473-1F3FE-200D-2642-FE0F
+    36593, // "👳🏾" 1F473-1F3FE
+    36617, // "👳🏿\u200d♀️" 1F473-1F3FF-200D-2640-FE0F
+    36639, // "👳🏿\u200d♂️" 1F473-1F3FF-200D-2642-FE0F
+    36661, // "👳🏿" 1F473-1F3FF
+    36685, // "👳\u200d♀️" 1F473-200D-2640-FE0F
+    36707, // "👳\u200d♂️" 1F473-200D-2642-FE0F
+    36729, // "👳"
+    36753, // "👴🏻" 1F474-1F3FB
+    36781, // "👴🏼" 1F474-1F3FC
+    36836, // "👴🏾" 1F474-1F3FE
+    36865, // "👴🏿" 1F474-1F3FF
+    36895, // "👴"
+    36922, // "👵🏻" 1F475-1F3FB
+    36951, // "👵🏼" 1F475-1F3FC
+    36980, // "👵🏽" 1F475-1F3FD
+    37007, // "👵🏾" 1F475-1F3FE
+    37036, // "👵🏿" 1F475-1F3FF
+    37065, // "👵"
+    37120, // "👶🏼" 1F476-1F3FC
+    37150, // "👶🏽" 1F476-1F3FD
+    37180, // "👶🏾" 1F476-1F3FE
+    37210, // "👶🏿" 1F476-1F3FF
+    37241, // "👶"
+    37271, // "👷🏻\u200d♀️" 1F477-1F3FB-200D-2640-FE0F
+    37294, // "👷🏻\u200d♂️" 1F477-1F3FB-200D-2642-FE0F
+    37318, // "👷🏻" 1F477-1F3FB
+    37343, // "👷🏼\u200d♀️" 1F477-1F3FC-200D-2640-FE0F
+    37366, // "👷🏼\u200d♂️" 1F477-1F3FC-200D-2642-FE0F
+    37391, // "👷🏼" 1F477-1F3FC
+    37417, // "👷🏽\u200d♀️" 1F477-1F3FD-200D-2640-FE0F
+    37441, // "👷🏽\u200d♂️" 1F477-1F3FD-200D-2642-FE0F
+    37466, // "👷🏽" 1F477-1F3FD
+    37492, // "👷🏾\u200d♀️" 1F477-1F3FE-200D-2640-FE0F
+    37516, // "👷🏾\u200d♂️" 1F477-1F3FE-200D-2642-FE0F
+    37541, // "👷🏾" 1F477-1F3FE
+    37567, // "👷🏿\u200d♀️" 1F477-1F3FF-200D-2640-FE0F
+    37591, // "👷🏿\u200d♂️" 1F477-1F3FF-200D-2642-FE0F
+    37616, // "👷🏿" 1F477-1F3FF
+    37642, // "👷\u200d♀️" 1F477-200D-2640-FE0F
+    37666, // "👷\u200d♂️" 1F477-200D-2642-FE0F
+    37691, // "👷"
+    37717, // "👸🏻" 1F478-1F3FB
+    37746, // "👸🏼" 1F478-1F3FC
+    37774, // "👸🏽" 1F478-1F3FD
+    37803, // "👸🏾" 1F478-1F3FE
+    37862, // "👸"
+    37891, // "👹"
+    37922, // "👺"
+    37980, // "👼🏻" 1F47C-1F3FB
+    38010, // "👼🏼" 1F47C-1F3FC
+    38071, // "👼🏾" 1F47C-1F3FE
+    38101, // "👼🏿" 1F47C-1F3FF
+    38162, // "👽"
+    38192, // "👾"
+    38222, // "👿"
+    38254, // "💀"
+    38281, // "💁🏻\u200d♀️" 1F481-1F3FB-200D-2640-FE0F
+    38313, // "💁🏻\u200d♂️" 1F481-1F3FB-200D-2642-FE0F
+    38375, // "💁🏼\u200d♀️" 1F481-1F3FC-200D-2640-FE0F
+    38406, // "💁🏼\u200d♂️" 1F481-1F3FC-200D-2642-FE0F
+    38466, // "💁🏽\u200d♀️" 1F481-1F3FD-200D-2640-FE0F
+    38498, // "💁🏽\u200d♂️" 1F481-1F3FD-200D-2642-FE0F
+    38530, // "💁🏽" 1F481-1F3FD
+    38561, // "💁🏾\u200d♀️" 1F481-1F3FE-200D-2640-FE0F
+    38593, // "💁🏾\u200d♂️" 1F481-1F3FE-200D-2642-FE0F
+    38625, // "💁🏾" 1F481-1F3FE
+    38656, // "💁🏿\u200d♀️" 1F481-1F3FF-200D-2640-FE0F
+    38688, // "💁🏿\u200d♂️" 1F481-1F3FF-200D-2642-FE0F
+    38720, // "💁🏿" 1F481-1F3FF
+    38781, // "💁\u200d♂️" 1F481-200D-2642-FE0F
+    38810, // "💁"
+    38840, // "💂🏻\u200d♀️" 1F482-1F3FB-200D-2640-FE0F
+    38859, // "💂🏻\u200d♂️" 1F482-1F3FB-200D-2642-FE0F
+    38880, // "💂🏻" 1F482-1F3FB
+    38901, // "💂🏼\u200d♀️" 1F482-1F3FC-200D-2640-FE0F
+    38920, // "💂🏼\u200d♂️" 1F482-1F3FC-200D-2642-FE0F
+    38941, // "💂🏼" 1F482-1F3FC
+    38962, // "💂🏽\u200d♀️" 1F482-1F3FD-200D-2640-FE0F
+    38981, // "💂🏽\u200d♂️" 1F482-1F3FD-200D-2642-FE0F
+    39002, // "💂🏽" 1F482-1F3FD
+    39023, // "💂🏾\u200d♀️" 1F482-1F3FE-200D-2640-FE0F
+    39042, // "💂🏾\u200d♂️" 1F482-1F3FE-200D-2642-FE0F
+    39063, // "💂🏾" 1F482-1F3FE
+    39084, // "💂🏿\u200d♀️" 1F482-1F3FF-200D-2640-FE0F
+    39103, // "💂🏿\u200d♂️" 1F482-1F3FF-200D-2642-FE0F
+    39126, // "💂🏿" 1F482-1F3FF
+    39146, // "💂\u200d♀️" 1F482-200D-2640-FE0F
+    39165, // "💂\u200d♂️" 1F482-200D-2642-FE0F
+    39188, // "💂"
+    39208, // "💃🏻" 1F483-1F3FB
+    39234, // "💃🏼" 1F483-1F3FC
+    39262, // "💃🏽" 1F483-1F3FD
+    39291, // "💃🏾" 1F483-1F3FE
+    39320, // "💃🏿" 1F483-1F3FF
+    39352, // "💃"
+    39378, // "💄"
+    39399, // "💅🏻" 1F485-1F3FB
+    39431, // "💅🏼" 1F485-1F3FC
+    39463, // "💅🏽" 1F485-1F3FD
+    39495, // "💅🏾" 1F485-1F3FE
+    39527, // "💅🏿" 1F485-1F3FF
+    39560, // "💅"
+    39592, // "💆🏻\u200d♀️" 1F486-1F3FB-200D-2640-FE0F
+    39617, // "💆🏻\u200d♂️" 1F486-1F3FB-200D-2642-FE0F
+    39640, // "💆🏻" 1F486-1F3FB
+    39664, // "💆🏼\u200d♀️" 1F486-1F3FC-200D-2640-FE0F
+    39688, // "💆🏼\u200d♂️" 1F486-1F3FC-200D-2642-FE0F
+    39710, // "💆🏼" 1F486-1F3FC
+    39733, // "💆🏽\u200d♀️" 1F486-1F3FD-200D-2640-FE0F
+    39758, // "💆🏽\u200d♂️" 1F486-1F3FD-200D-2642-FE0F
+    39781, // "💆🏽" 1F486-1F3FD
+    39807, // "💆🏾\u200d♀️" 1F486-1F3FE-200D-2640-FE0F
+    39832, // "💆🏾\u200d♂️" 1F486-1F3FE-200D-2642-FE0F
+    39855, // "💆🏾" 1F486-1F3FE
+    39881, // "💆🏿\u200d♀️" 1F486-1F3FF-200D-2640-FE0F
+    39907, // "💆🏿\u200d♂️" 1F486-1F3FF-200D-2642-FE0F
+    39930, // "💆🏿" 1F486-1F3FF
+    39956, // "💆\u200d♀️" 1F486-200D-2640-FE0F
+    39980, // "💆\u200d♂️" 1F486-200D-2642-FE0F
+    40004, // "💆"
+    40028, // "💇🏻\u200d♀️" 1F487-1F3FB-200D-2640-FE0F
+    40060, // "💇🏻\u200d♂️" 1F487-1F3FB-200D-2642-FE0F
+    40090, // "💇🏻" 1F487-1F3FB
+    40122, // "💇🏼\u200d♀️" 1F487-1F3FC-200D-2640-FE0F
+    40154, // "💇🏼\u200d♂️" 1F487-1F3FC-200D-2642-FE0F
+    40184, // "💇🏼" 1F487-1F3FC
+    40215, // "💇🏽\u200d♀️" 1F487-1F3FD-200D-2640-FE0F
+    40247, // "💇🏽\u200d♂️" 1F487-1F3FD-200D-2642-FE0F
+    40277, // "💇🏽" 1F487-1F3FD
+    40309, // "💇🏾\u200d♀️" 1F487-1F3FE-200D-2640-FE0F
+    40341, // "💇🏾\u200d♂️" 1F487-1F3FE-200D-2642-FE0F
+    40371, // "💇🏾" 1F487-1F3FE
+    40403, // "💇🏿\u200d♀️" 1F487-1F3FF-200D-2640-FE0F
+    40436, // "💇🏿\u200d♂️" 1F487-1F3FF-200D-2642-FE0F
+    40465, // "💇🏿" 1F487-1F3FF
+    40527, // "💇\u200d♂️" 1F487-200D-2642-FE0F
+    40556, // "💇"
+    40588, // "💈"
+    40605, // "💉"
+    40634, // "💊"
+    40666, // "💋"
+    40691, // "💌"
+    40714, // "💍"
+    40735, // "💎"
+    40760, // "💏"
+    40788, // "💐"
+    40820, // "💑"
+    40852, // "💒"
+    40883, // "💓"
+    40911, // "💔"
+    40939, // "💕"
+    40970, // "💖"
+    40999, // "💗"
+    41057, // "💙"
+    41086, // "💚"
+    41142, // "💜"
+    41171, // "💝"
+    41200, // "💞"
+    41231, // "💟"
+    41264, // "💠"
+    41294, // "💡"
+    41338, // "💣"
+    41370, // "💤"
+    41425, // "💦"
+    41457, // "💧"
+    41476, // "💨"
+    41505, // "💩"
+    41537, // "💪🏻" 1F4AA-1F3FB
+    41594, // "💪🏽" 1F4AA-1F3FD
+    41624, // "💪🏾" 1F4AA-1F3FE
+    41655, // "💪🏿" 1F4AA-1F3FF
+    41686, // "💪"
+    41716, // "💫"
+    41799, // "💮"
+    41828, // "💯"
+    41859, // "💰"
+    41888, // "💱"
+    41915, // "💲"
+    41934, // "💳"
+    41958, // "💴"
+    41985, // "💵"
+    42012, // "💶"
+    42039, // "💷"
+    42066, // "💸"
+    42096, // "💹"
+    42128, // "💺"
+    42154, // "💻"
+    42179, // "💼"
+    42212, // "💽"
+    42245, // "💾"
+    42278, // "💿"
+    42338, // "📁"
+    42364, // "📂"
+    42419, // "📄"
+    42444, // "📅"
+    42476, // "📆"
+    42507, // "📇"
+    42538, // "📈"
+    42564, // "📉"
+    42590, // "📊"
+    42617, // "📋"
+    42643, // "📌"
+    42675, // "📍"
+    42690, // "📎"
+    42717, // "📏"
+    42776, // "📑"
+    42806, // "📒"
+    42839, // "📓"
+    42870, // "📔"
+    42901, // "📕"
+    42932, // "📖"
+    42956, // "📗"
+    42987, // "📘"
+    43048, // "📚"
+    43078, // "📛"
+    43140, // "📝"
+    43170, // "📞"
+    43202, // "📟"
+    43226, // "📠"
+    43258, // "📡"
+    43283, // "📢"
+    43312, // "📣"
+    43334, // "📤"
+    43366, // "📥"
+    43398, // "📦"
+    43422, // "📧"
+    43445, // "📨"
+    43462, // "📩"
+    43493, // "📪"
+    43520, // "📫"
+    43573, // "📭"
+    43601, // "📮"
+    43625, // "📯"
+    43657, // "📰"
+    43687, // "📱"
+    43708, // "📲"
+    43741, // "📳"
+    43773, // "📴"
+    43805, // "📵"
+    43838, // "📶"
+    43870, // "📷"
+    43895, // "📸"
+    43922, // "📹"
+    43949, // "📺"
+    43978, // "📻"
+    44009, // "📼"
+    44031, // "📽"
+    44061, // "📿"
+    44093, // "🔀"
+    44126, // "🔁"
+    44158, // "🔂"
+    44190, // "🔃"
+    44223, // "🔄"
+    44255, // "🔅"
+    44276, // "🔆"
+    44308, // "🔇"
+    44334, // "🔈"
+    44351, // "🔉"
+    44374, // "🔊"
+    44405, // "🔋"
+    44426, // "🔌"
+    44454, // "🔍"
+    44486, // "🔎"
+    44518, // "🔏"
+    44548, // "🔐"
+    44580, // "🔑"
+    44612, // "🔒"
+    44634, // "🔓"
+    44657, // "🔔"
+    44683, // "🔕"
+    44710, // "🔖"
+    44741, // "🔗"
+    44772, // "🔘"
+    44804, // "🔙"
+    44836, // "🔚"
+    44869, // "🔛"
+    44901, // "🔜"
+    44934, // "🔝"
+    44966, // "🔞"
+    44999, // "🔟"
+    45031, // "🔠"
+    45064, // "🔡"
+    45096, // "🔢"
+    45128, // "🔣"
+    45161, // "🔤"
+    45193, // "🔥"
+    45221, // "🔦"
+    45250, // "🔧"
+    45282, // "🔨"
+    45309, // "🔩"
+    45368, // "🔫"
+    45394, // "🔬"
+    45420, // "🔭"
+    45450, // "🔮"
+    45479, // "🔯"
+    45511, // "🔰"
+    45562, // "🔲"
+    45595, // "🔳"
+    45627, // "🔴"
+    45659, // "🔵"
+    45691, // "🔶"
+    45750, // "🔸"
+    45761, // "🔹"
+    45773, // "🔺"
+    45785, // "🔻"
+    45797, // "🔼"
+    45830, // "🔽"
+    45862, // "🕉"
+    45894, // "🕊"
+    45921, // "🕋"
+    45954, // "🕌"
+    45983, // "🕍"
+    46014, // "🕎"
+    46047, // "🕐"
+    46079, // "🕑"
+    46111, // "🕒"
+    46143, // "🕓"
+    46175, // "🕔"
+    46207, // "🕕"
+    46239, // "🕖"
+    46271, // "🕗"
+    46303, // "🕘"
+    46335, // "🕙"
+    46367, // "🕚"
+    46399, // "🕛"
+    46431, // "🕜"
+    46463, // "🕝"
+    46495, // "🕞"
+    46527, // "🕟"
+    46559, // "🕠"
+    46591, // "🕡"
+    46623, // "🕢"
+    46655, // "🕣"
+    46687, // "🕤"
+    46719, // "🕥"
+    46751, // "🕦"
+    46783, // "🕧"
+    46815, // "🕯"
+    46829, // "🕰"
+    46855, // "🕳"
+    46870, // "🕴🏻\u200d♀️" 1F574-1F3FB-200D-2640-FE0F
+    46882, // "🕴🏻\u200d♂️" 1F574-1F3FB-200D-2642-FE0F
+    46894, // "🕴🏻" 1F574-1F3FB
+    46905, // "🕴🏼\u200d♀️" 1F574-1F3FC-200D-2640-FE0F
+    46917, // "🕴🏼\u200d♂️" 1F574-1F3FC-200D-2642-FE0F
+    46929, // "🕴🏼" 1F574-1F3FC
+    46940, // "🕴🏽\u200d♀️" 1F574-1F3FD-200D-2640-FE0F
+    46952, // "🕴🏽\u200d♂️" 1F574-1F3FD-200D-2642-FE0F
+    46964, // "🕴🏽" 1F574-1F3FD
+    46975, // "🕴🏾\u200d♀️" 1F574-1F3FE-200D-2640-FE0F
+    46987, // "🕴🏾\u200d♂️" 1F574-1F3FE-200D-2642-FE0F
+    46999, // "🕴🏾" 1F574-1F3FE
+    47010, // "🕴🏿\u200d♀️" 1F574-1F3FF-200D-2640-FE0F
+    47022, // "🕴🏿\u200d♂️" 1F574-1F3FF-200D-2642-FE0F
+    47034, // "🕴🏿" 1F574-1F3FF
+    47045, // "🕴️\u200d♀️" 1F574-FE0F-200D-2640-FE0F
+    47057, // "🕴️\u200d♂️" 1F574-FE0F-200D-2642-FE0F
+    47069, // "🕴"
+    47082, // "🕵🏻\u200d♀️" 1F575-1F3FB-200D-2640-FE0F
+    47108, // "🕵🏻\u200d♂️" 1F575-1F3FB-200D-2642-FE0F
+    47134, // "🕵🏻" 1F575-1F3FB
+    47161, // "🕵🏼\u200d♀️" 1F575-1F3FC-200D-2640-FE0F
+    47187, // "🕵🏼\u200d♂️" 1F575-1F3FC-200D-2642-FE0F
+    47213, // "🕵🏼" 1F575-1F3FC
+    47240, // "🕵🏽\u200d♀️" 1F575-1F3FD-200D-2640-FE0F
+    47266, // "🕵🏽\u200d♂️" 1F575-1F3FD-200D-2642-FE0F
+    47292, // "🕵🏽" 1F575-1F3FD
+    47319, // "🕵🏾\u200d♀️" 1F575-1F3FE-200D-2640-FE0F
+    47345, // "🕵🏾\u200d♂️" 1F575-1F3FE-200D-2642-FE0F
+    47371, // "🕵🏾" 1F575-1F3FE
+    47398, // "🕵🏿\u200d♀️" 1F575-1F3FF-200D-2640-FE0F
+    47424, // "🕵🏿\u200d♂️" 1F575-1F3FF-200D-2642-FE0F
+    47451, // "🕵🏿" 1F575-1F3FF
+    47478, // "🕵️\u200d♀️" 1F575-FE0F-200D-2640-FE0F
+    47505, // "🕵️\u200d♂️" 1F575-FE0F-200D-2642-FE0F
+    47532, // "🕵"
+    47559, // "🕶"
+    47570, // "🕷"
+    47597, // "🕸"
+    47623, // "🕹"
+    47651, // "🕺🏻" 1F57A-1F3FB
+    47669, // "🕺🏼" 1F57A-1F3FC
+    47688, // "🕺🏽" 1F57A-1F3FD
+    47708, // "🕺🏾" 1F57A-1F3FE
+    47727, // "🕺🏿" 1F57A-1F3FF
+    47747, // "🕺"
+    47766, // "🖇"
+    47824, // "🖋"
+    47856, // "🖌"
+    47886, // "🖍"
+    47918, // "🖐🏻" 1F590-1F3FB
+    47943, // "🖐🏼" 1F590-1F3FC
+    47969, // "🖐🏽" 1F590-1F3FD
+    47996, // "🖐🏾" 1F590-1F3FE
+    48023, // "🖐🏿" 1F590-1F3FF
+    48050, // "🖐"
+    48076, // "🖕🏻" 1F595-1F3FB
+    48096, // "🖕🏼" 1F595-1F3FC
+    48117, // "🖕🏽" 1F595-1F3FD
+    48140, // "🖕🏾" 1F595-1F3FE
+    48162, // "🖕🏿" 1F595-1F3FF
+    48183, // "🖕"
+    48203, // "🖖🏻" 1F596-1F3FB
+    48229, // "🖖🏼" 1F596-1F3FC
+    48255, // "🖖🏽" 1F596-1F3FD
+    48283, // "🖖🏾" 1F596-1F3FE
+    48310, // "🖖🏿" 1F596-1F3FF
+    48338, // "🖖"
+    48364, // "🖤"
+    48393, // "🖥"
+    48425, // "🖨"
+    48452, // "🖱"
+    48475, // "🖲"
+    48502, // "🖼"
+    48526, // "🗂"
+    48551, // "🗃"
+    48580, // "🗄"
+    48601, // "🗑"
+    48633, // "🗒"
+    48659, // "🗓"
+    48688, // "🗜"
+    48717, // "🗝"
+    48748, // "🗞"
+    48778, // "🗡"
+    48809, // "🗣"
+    48838, // "🗨"
+    48867, // "🗯"
+    48892, // "🗳"
+    48919, // "🗺"
+    48943, // "🗻"
+    48967, // "🗼"
+    48990, // "🗽"
+    49022, // "🗾"
+    49054, // "🗿"
+    49107, // "😁"
+    49139, // "😂"
+    49201, // "😄"
+    49233, // "😅"
+    49295, // "😇"
+    49327, // "😈"
+    49390, // "😊"
+    49422, // "😋"
+    49484, // "😍"
+    49516, // "😎"
+    49608, // "😑"
+    49640, // "😒"
+    49702, // "😔"
+    49734, // "😕"
+    49796, // "😗"
+    49828, // "😘"
+    49890, // "😚"
+    49922, // "😛"
+    49984, // "😝"
+    50016, // "😞"
+    50107, // "😡"
+    50139, // "😢"
+    50199, // "😤"
+    50230, // "😥"
+    50290, // "😧"
+    50321, // "😨"
+    50380, // "😪"
+    50411, // "😫"
+    50471, // "😭"
+    50502, // "😮"
+    50592, // "😱"
+    50624, // "😲"
+    50686, // "😴"
+    50718, // "😵"
+    50780, // "😷"
+    50812, // "😸"
+    50844, // "😹"
+    50876, // "😺"
+    50908, // "😻"
+    50970, // "😽"
+    51002, // "😾"
+    51094, // "🙁"
+    51126, // "🙂"
+    51188, // "🙄"
+    51220, // "🙅🏻\u200d♀️" 1F645-1F3FB-200D-2640-FE0F
+    51281, // "🙅🏻" 1F645-1F3FB
+    51311, // "🙅🏼\u200d♀️" 1F645-1F3FC-200D-2640-FE0F
+    51372, // "🙅🏼" 1F645-1F3FC
+    51402, // "🙅🏽\u200d♀️" 1F645-1F3FD-200D-2640-FE0F
+    51464, // "🙅🏽" 1F645-1F3FD
+    51496, // "🙅🏾\u200d♀️" 1F645-1F3FE-200D-2640-FE0F
+    51528, // "🙅🏾\u200d♂️" 1F645-1F3FE-200D-2642-FE0F
+    51559, // "🙅🏾" 1F645-1F3FE
+    51590, // "🙅🏿\u200d♀️" 1F645-1F3FF-200D-2640-FE0F
+    51622, // "🙅🏿\u200d♂️" 1F645-1F3FF-200D-2642-FE0F
+    51654, // "🙅🏿" 1F645-1F3FF
+    51685, // "🙅\u200d♀️" 1F645-200D-2640-FE0F
+    51714, // "🙅\u200d♂️" 1F645-200D-2642-FE0F
+    51743, // "🙅"
+    51771, // "🙆🏻\u200d♀️" 1F646-1F3FB-200D-2640-FE0F
+    51803, // "🙆🏻\u200d♂️" 1F646-1F3FB-200D-2642-FE0F
+    51835, // "🙆🏻" 1F646-1F3FB
+    51866, // "🙆🏼\u200d♀️" 1F646-1F3FC-200D-2640-FE0F
+    51897, // "🙆🏼\u200d♂️" 1F646-1F3FC-200D-2642-FE0F
+    51928, // "🙆🏼" 1F646-1F3FC
+    51958, // "🙆🏽\u200d♀️" 1F646-1F3FD-200D-2640-FE0F
+    51990, // "🙆🏽\u200d♂️" 1F646-1F3FD-200D-2642-FE0F
+    52022, // "🙆🏽" 1F646-1F3FD
+    52053, // "🙆🏾\u200d♀️" 1F646-1F3FE-200D-2640-FE0F
+    52085, // "🙆🏾\u200d♂️" 1F646-1F3FE-200D-2642-FE0F
+    52117, // "🙆🏾" 1F646-1F3FE
+    52149, // "🙆🏿\u200d♀️" 1F646-1F3FF-200D-2640-FE0F
+    52181, // "🙆🏿\u200d♂️" 1F646-1F3FF-200D-2642-FE0F
+    52213, // "🙆🏿" 1F646-1F3FF
+    52245, // "🙆\u200d♀️" 1F646-200D-2640-FE0F
+    52277, // "🙆\u200d♂️" 1F646-200D-2642-FE0F
+    52309, // "🙆"
+    52341, // "🙇🏻\u200d♀️" 1F647-1F3FB-200D-2640-FE0F
+    52373, // "🙇🏻\u200d♂️" 1F647-1F3FB-200D-2642-FE0F
+    52405, // "🙇🏻" 1F647-1F3FB
+    52438, // "🙇🏼\u200d♀️" 1F647-1F3FC-200D-2640-FE0F
+    52470, // "🙇🏼\u200d♂️" 1F647-1F3FC-200D-2642-FE0F
+    52502, // "🙇🏼" 1F647-1F3FC
+    52535, // "🙇🏽\u200d♀️" 1F647-1F3FD-200D-2640-FE0F
+    52567, // "🙇🏽\u200d♂️" 1F647-1F3FD-200D-2642-FE0F
+    52599, // "🙇🏽" 1F647-1F3FD
+    52631, // "🙇🏾\u200d♀️" 1F647-1F3FE-200D-2640-FE0F
+    52663, // "🙇🏾\u200d♂️" 1F647-1F3FE-200D-2642-FE0F
+    52695, // "🙇🏾" 1F647-1F3FE
+    52727, // "🙇🏿\u200d♀️" 1F647-1F3FF-200D-2640-FE0F
+    52759, // "🙇🏿\u200d♂️" 1F647-1F3FF-200D-2642-FE0F
+    52791, // "🙇🏿" 1F647-1F3FF
+    52823, // "🙇\u200d♀️" 1F647-200D-2640-FE0F
+    52855, // "🙇\u200d♂️" 1F647-200D-2642-FE0F
+    52887, // "🙇"
+    52919, // "🙈"
+    52950, // "🙉"
+    52981, // "🙊"
+    53013, // "🙋🏻\u200d♀️" 1F64B-1F3FB-200D-2640-FE0F
+    53039, // "🙋🏻\u200d♂️" 1F64B-1F3FB-200D-2642-FE0F
+    53065, // "🙋🏻" 1F64B-1F3FB
+    53091, // "🙋🏼\u200d♀️" 1F64B-1F3FC-200D-2640-FE0F
+    53117, // "🙋🏼\u200d♂️" 1F64B-1F3FC-200D-2642-FE0F
+    53143, // "🙋🏼" 1F64B-1F3FC
+    53169, // "🙋🏽\u200d♀️" 1F64B-1F3FD-200D-2640-FE0F
+    53196, // "🙋🏽\u200d♂️" 1F64B-1F3FD-200D-2642-FE0F
+    53222, // "🙋🏽" 1F64B-1F3FD
+    53248, // "🙋🏾\u200d♀️" 1F64B-1F3FE-200D-2640-FE0F
+    53275, // "🙋🏾\u200d♂️" 1F64B-1F3FE-200D-2642-FE0F
+    53301, // "🙋🏾" 1F64B-1F3FE
+    53327, // "🙋🏿\u200d♀️" 1F64B-1F3FF-200D-2640-FE0F
+    53354, // "🙋🏿\u200d♂️" 1F64B-1F3FF-200D-2642-FE0F
+    53380, // "🙋🏿" 1F64B-1F3FF
+    53406, // "🙋\u200d♀️" 1F64B-200D-2640-FE0F
+    53433, // "🙋\u200d♂️" 1F64B-200D-2642-FE0F
+    53459, // "🙋"
+    53514, // "🙌🏼" 1F64C-1F3FC
+    53546, // "🙌🏽" 1F64C-1F3FD
+    53578, // "🙌🏾" 1F64C-1F3FE
+    53610, // "🙌🏿" 1F64C-1F3FF
+    53642, // "🙌"
+    53674, // "🙍🏻\u200d♀️" 1F64D-1F3FB-200D-2640-FE0F
+    53697, // "🙍🏻\u200d♂️" 1F64D-1F3FB-200D-2642-FE0F
+    53721, // "🙍🏻" 1F64D-1F3FB
+    53745, // "🙍🏼\u200d♀️" 1F64D-1F3FC-200D-2640-FE0F
+    53768, // "🙍🏼\u200d♂️" 1F64D-1F3FC-200D-2642-FE0F
+    53792, // "🙍🏼" 1F64D-1F3FC
+    53814, // "🙍🏽\u200d♀️" 1F64D-1F3FD-200D-2640-FE0F
+    53837, // "🙍🏽\u200d♂️" 1F64D-1F3FD-200D-2642-FE0F
+    53861, // "🙍🏽" 1F64D-1F3FD
+    53885, // "🙍🏾\u200d♀️" 1F64D-1F3FE-200D-2640-FE0F
+    53908, // "🙍🏾\u200d♂️" 1F64D-1F3FE-200D-2642-FE0F
+    53931, // "🙍🏾" 1F64D-1F3FE
+    53955, // "🙍🏿\u200d♀️" 1F64D-1F3FF-200D-2640-FE0F
+    53979, // "🙍🏿\u200d♂️" 1F64D-1F3FF-200D-2642-FE0F
+    54002, // "🙍🏿" 1F64D-1F3FF
+    54026, // "🙍\u200d♀️" 1F64D-200D-2640-FE0F
+    54050, // "🙍\u200d♂️" 1F64D-200D-2642-FE0F
+    54073, // "🙍"
+    54097, // "🙎🏻\u200d♀️" 1F64E-1F3FB-200D-2640-FE0F
+    54121, // "🙎🏻\u200d♂️" 1F64E-1F3FB-200D-2642-FE0F
+    54144, // "🙎🏻" 1F64E-1F3FB
+    54168, // "🙎🏼\u200d♀️" 1F64E-1F3FC-200D-2640-FE0F
+    54192, // "🙎🏼\u200d♂️" 1F64E-1F3FC-200D-2642-FE0F
+    54215, // "🙎🏼" 1F64E-1F3FC
+    54239, // "🙎🏽\u200d♀️" 1F64E-1F3FD-200D-2640-FE0F
+    54263, // "🙎🏽\u200d♂️" 1F64E-1F3FD-200D-2642-FE0F
+    54286, // "🙎🏽" 1F64E-1F3FD
+    54309, // "🙎🏾\u200d♀️" 1F64E-1F3FE-200D-2640-FE0F
+    54333, // "🙎🏾\u200d♂️" 1F64E-1F3FE-200D-2642-FE0F
+    54357, // "🙎🏾" 1F64E-1F3FE
+    54380, // "🙎🏿\u200d♀️" 1F64E-1F3FF-200D-2640-FE0F
+    54404, // "🙎🏿\u200d♂️" 1F64E-1F3FF-200D-2642-FE0F
+    54428, // "🙎🏿" 1F64E-1F3FF
+    54451, // "🙎\u200d♀️" 1F64E-200D-2640-FE0F
+    54475, // "🙎\u200d♂️" 1F64E-200D-2642-FE0F
+    54499, // "🙎"
+    54521, // "🙏🏻" 1F64F-1F3FB
+    54552, // "🙏🏼" 1F64F-1F3FC
+    54583, // "🙏🏽" 1F64F-1F3FD
+    54615, // "🙏🏾" 1F64F-1F3FE
+    54647, // "🙏🏿" 1F64F-1F3FF
+    54679, // "🙏"
+    54710, // "🚀"
+    54742, // "🚁"
+    54769, // "🚂"
+    54799, // "🚃"
+    54820, // "🚄"
+    54846, // "🚅"
+    54873, // "🚆"
+    54902, // "🚇"
+    54935, // "🚈"
+    54963, // "🚉"
+    54994, // "🚊"
+    55021, // "🚋"
+    55054, // "🚌"
+    55077, // "🚍"
+    55109, // "🚎"
+    55138, // "🚏"
+    55158, // "🚐"
+    55183, // "🚑"
+    55211, // "🚒"
+    55236, // "🚓"
+    55258, // "🚔"
+    55288, // "🚕"
+    55334, // "🚗"
+    55354, // "🚘"
+    55382, // "🚙"
+    55404, // "🚚"
+    55429, // "🚛"
+    55454, // "🚜"
+    55482, // "🚝"
+    55514, // "🚞"
+    55546, // "🚟"
+    55578, // "🚠"
+    55607, // "🚡"
+    55635, // "🚢"
+    55667, // "🚣🏻\u200d♀️" 1F6A3-1F3FB-200D-2640-FE0F
+    55694, // "🚣🏻\u200d♂️" 1F6A3-1F3FB-200D-2642-FE0F
+    55721, // "🚣🏻" 1F6A3-1F3FB
+    55748, // "🚣🏼\u200d♀️" 1F6A3-1F3FC-200D-2640-FE0F
+    55775, // "🚣🏼\u200d♂️" 1F6A3-1F3FC-200D-2642-FE0F
+    55802, // "🚣🏼" 1F6A3-1F3FC
+    55829, // "🚣🏽\u200d♀️" 1F6A3-1F3FD-200D-2640-FE0F
+    55856, // "🚣🏽\u200d♂️" 1F6A3-1F3FD-200D-2642-FE0F
+    55883, // "🚣🏽" 1F6A3-1F3FD
+    55910, // "🚣🏾\u200d♀️" 1F6A3-1F3FE-200D-2640-FE0F
+    55937, // "🚣🏾\u200d♂️" 1F6A3-1F3FE-200D-2642-FE0F
+    55964, // "🚣🏾" 1F6A3-1F3FE
+    55991, // "🚣🏿\u200d♀️" 1F6A3-1F3FF-200D-2640-FE0F
+    56018, // "🚣🏿\u200d♂️" 1F6A3-1F3FF-200D-2642-FE0F
+    56046, // "🚣🏿" 1F6A3-1F3FF
+    56074, // "🚣\u200d♀️" 1F6A3-200D-2640-FE0F
+    56101, // "🚣\u200d♂️" 1F6A3-200D-2642-FE0F
+    56128, // "🚣"
+    56155, // "🚤"
+    56176, // "🚥"
+    56193, // "🚦"
+    56210, // "🚧"
+    56237, // "🚨"
+    56269, // "🚩"
+    56291, // "🚪"
+    56312, // "🚫"
+    56345, // "🚬"
+    56372, // "🚭"
+    56405, // "🚮"
+    56437, // "🚯"
+    56470, // "🚰"
+    56502, // "🚱"
+    56535, // "🚲"
+    56558, // "🚳"
+    56591, // "🚴🏻\u200d♀️" 1F6B4-1F3FB-200D-2640-FE0F
+    56623, // "🚴🏻\u200d♂️" 1F6B4-1F3FB-200D-2642-FE0F
+    56655, // "🚴🏻" 1F6B4-1F3FB
+    56687, // "🚴🏼\u200d♀️" 1F6B4-1F3FC-200D-2640-FE0F
+    56719, // "🚴🏼\u200d♂️" 1F6B4-1F3FC-200D-2642-FE0F
+    56751, // "🚴🏼" 1F6B4-1F3FC
+    56783, // "🚴🏽\u200d♀️" 1F6B4-1F3FD-200D-2640-FE0F
+    56815, // "🚴🏽\u200d♂️" 1F6B4-1F3FD-200D-2642-FE0F
+    56847, // "🚴🏽" 1F6B4-1F3FD
+    56879, // "🚴🏾\u200d♀️" 1F6B4-1F3FE-200D-2640-FE0F
+    56911, // "🚴🏾\u200d♂️" 1F6B4-1F3FE-200D-2642-FE0F
+    56943, // "🚴🏾" 1F6B4-1F3FE
+    56974, // "🚴🏿\u200d♀️" 1F6B4-1F3FF-200D-2640-FE0F
+    57005, // "🚴🏿\u200d♂️" 1F6B4-1F3FF-200D-2642-FE0F
+    57036, // "🚴🏿" 1F6B4-1F3FF
+    57067, // "🚴\u200d♀️" 1F6B4-200D-2640-FE0F
+    57098, // "🚴\u200d♂️" 1F6B4-200D-2642-FE0F
+    57129, // "🚴"
+    57160, // "🚵🏻\u200d♀️" 1F6B5-1F3FB-200D-2640-FE0F
+    57192, // "🚵🏻\u200d♂️" 1F6B5-1F3FB-200D-2642-FE0F
+    57223, // "🚵🏻" 1F6B5-1F3FB
+    57254, // "🚵🏼\u200d♀️" 1F6B5-1F3FC-200D-2640-FE0F
+    57286, // "🚵🏼\u200d♂️" 1F6B5-1F3FC-200D-2642-FE0F
+    57317, // "🚵🏼" 1F6B5-1F3FC
+    57348, // "🚵🏽\u200d♀️" 1F6B5-1F3FD-200D-2640-FE0F
+    57380, // "🚵🏽\u200d♂️" 1F6B5-1F3FD-200D-2642-FE0F
+    57411, // "🚵🏽" 1F6B5-1F3FD
+    57442, // "🚵🏾\u200d♀️" 1F6B5-1F3FE-200D-2640-FE0F
+    57473, // "🚵🏾\u200d♂️" 1F6B5-1F3FE-200D-2642-FE0F
+    57505, // "🚵🏾" 1F6B5-1F3FE
+    57537, // "🚵🏿\u200d♀️" 1F6B5-1F3FF-200D-2640-FE0F
+    57568, // "🚵🏿\u200d♂️" 1F6B5-1F3FF-200D-2642-FE0F
+    57600, // "🚵🏿" 1F6B5-1F3FF
+    57632, // "🚵\u200d♀️" 1F6B5-200D-2640-FE0F
+    57663, // "🚵\u200d♂️" 1F6B5-200D-2642-FE0F
+    57695, // "🚵"
+    57727, // "🚶🏻\u200d♀️" 1F6B6-1F3FB-200D-2640-FE0F
+    57742, // "🚶🏻\u200d♂️" 1F6B6-1F3FB-200D-2642-FE0F
+    57757, // "🚶🏻" 1F6B6-1F3FB
+    57774, // "🚶🏼\u200d♀️" 1F6B6-1F3FC-200D-2640-FE0F
+    57789, // "🚶🏼\u200d♂️" 1F6B6-1F3FC-200D-2642-FE0F
+    57803, // "🚶🏼" 1F6B6-1F3FC
+    57820, // "🚶🏽\u200d♀️" 1F6B6-1F3FD-200D-2640-FE0F
+    57835, // "🚶🏽\u200d♂️" 1F6B6-1F3FD-200D-2642-FE0F
+    57851, // "🚶🏽" 1F6B6-1F3FD
+    57866, // "🚶🏾\u200d♀️" 1F6B6-1F3FE-200D-2640-FE0F
+    57883, // "🚶🏾\u200d♂️" 1F6B6-1F3FE-200D-2642-FE0F
+    57899, // "🚶🏾" 1F6B6-1F3FE
+    57914, // "🚶🏿\u200d♀️" 1F6B6-1F3FF-200D-2640-FE0F
+    57931, // "🚶🏿\u200d♂️" 1F6B6-1F3FF-200D-2642-FE0F
+    57947, // "🚶🏿" 1F6B6-1F3FF
+    57962, // "🚶\u200d♀️" 1F6B6-200D-2640-FE0F
+    57979, // "🚶\u200d♂️" 1F6B6-200D-2642-FE0F
+    57995, // "🚶"
+    58010, // "🚷"
+    58072, // "🚹"
+    58104, // "🚺"
+    58137, // "🚻"
+    58169, // "🚼"
+    58201, // "🚽"
+    58226, // "🚾"
+    58259, // "🚿"
+    58290, // "🛀🏻" 1F6C0-1F3FB
+    58320, // "🛀🏼" 1F6C0-1F3FC
+    58349, // "🛀🏽" 1F6C0-1F3FD
+    58379, // "🛀🏾" 1F6C0-1F3FE
+    58409, // "🛀🏿" 1F6C0-1F3FF
+    58438, // "🛀"
+    58468, // "🛁"
+    58498, // "🛂"
+    58531, // "🛃"
+    58563, // "🛄"
+    58595, // "🛅"
+    58658, // "🛌🏻" 1F6CC-1F3FB
+    58680, // "🛌🏼" 1F6CC-1F3FC
+    58700, // "🛌🏽" 1F6CC-1F3FD
+    58721, // "🛌🏾" 1F6CC-1F3FE
+    58743, // "🛌🏿" 1F6CC-1F3FF
+    58763, // "🛌"
+    58784, // "🛍"
+    58815, // "🛎"
+    58839, // "🛏"
+    58860, // "🛐"
+    58892, // "🛑"
+    58920, // "🛒"
+    58975, // "🛖"
+    59003, // "🛗"
+    59036, // "🛠"
+    59067, // "🛡"
+    59094, // "🛢"
+    59115, // "🛣"
+    59147, // "🛤"
+    59179, // "🛥"
+    59199, // "🛩"
+    59230, // "🛫"
+    59259, // "🛬"
+    59291, // "🛰"
+    59322, // "🛳"
+    59349, // "🛴"
+    59381, // "🛵"
+    59405, // "🛶"
+    59424, // "🛷"
+    59448, // "🛸"
+    59477, // "🛹"
+    59506, // "🛺"
+    59533, // "🛻"
+    59554, // "🛼"
+    59585, // "🟠"
+    59617, // "🟡"
+    59648, // "🟢"
+    59680, // "🟣"
+    59712, // "🟤"
+    59744, // "🟥"
+    59777, // "🟦"
+    59809, // "🟧"
+    59841, // "🟨"
+    59872, // "🟩"
+    59904, // "🟪"
+    59936, // "🟫"
+    59969, // "🤌🏻" 1F90C-1F3FB
+    59994, // "🤌🏼" 1F90C-1F3FC
+    60022, // "🤌🏽" 1F90C-1F3FD
+    60048, // "🤌🏾" 1F90C-1F3FE
+    60075, // "🤌🏿" 1F90C-1F3FF
+    60104, // "🤌"
+    60130, // "🤍"
+    60156, // "🤎"
+    60185, // "🤏🏻" 1F90F-1F3FB
+    60208, // "🤏🏼" 1F90F-1F3FC
+    60255, // "🤏🏾" 1F90F-1F3FE
+    60281, // "🤏🏿" 1F90F-1F3FF
+    60329, // "🤐"
+    60361, // "🤑"
+    60393, // "🤒"
+    60455, // "🤔"
+    60485, // "🤕"
+    60517, // "🤖"
+    60547, // "🤗"
+    60579, // "🤘🏻" 1F918-1F3FB
+    60597, // "🤘🏼" 1F918-1F3FC
+    60615, // "🤘🏽" 1F918-1F3FD
+    60634, // "🤘🏾" 1F918-1F3FE
+    60654, // "🤘🏿" 1F918-1F3FF
+    60673, // "🤘"
+    60691, // "🤙🏻" 1F919-1F3FB
+    60746, // "🤙🏽" 1F919-1F3FD
+    60776, // "🤙🏾" 1F919-1F3FE
+    60862, // "🤚🏻" 1F91A-1F3FB
+    60884, // "🤚🏼" 1F91A-1F3FC
+    60907, // "🤚🏽" 1F91A-1F3FD
+    60930, // "🤚🏾" 1F91A-1F3FE
+    60954, // "🤚🏿" 1F91A-1F3FF
+    60981, // "🤚"
+    61003, // "🤛🏻" 1F91B-1F3FB
+    61023, // "🤛🏼" 1F91B-1F3FC
+    61043, // "🤛🏽" 1F91B-1F3FD
+    61063, // "🤛🏾" 1F91B-1F3FE
+    61084, // "🤛🏿" 1F91B-1F3FF
+    61125, // "🤜🏻" 1F91C-1F3FB
+    61145, // "🤜🏼" 1F91C-1F3FC
+    61166, // "🤜🏽" 1F91C-1F3FD
+    61187, // "🤜🏾" 1F91C-1F3FE
+    61208, // "🤜🏿" 1F91C-1F3FF
+    61229, // "🤜"
+    61249, // "🤝"
+    61270, // "🤞🏻" 1F91E-1F3FB
+    61288, // "🤞🏼" 1F91E-1F3FC
+    61306, // "🤞🏽" 1F91E-1F3FD
+    61325, // "🤞🏾" 1F91E-1F3FE
+    61344, // "🤞🏿" 1F91E-1F3FF
+    61364, // "🤞"
+    61382, // "🤟🏻" 1F91F-1F3FB
+    61411, // "🤟🏼" 1F91F-1F3FC
+    61439, // "🤟🏽" 1F91F-1F3FD
+    61468, // "🤟🏾" 1F91F-1F3FE
+    61528, // "🤟"
+    61557, // "🤠"
+    61589, // "🤡"
+    61621, // "🤢"
+    61653, // "🤣"
+    61684, // "🤤"
+    61716, // "🤥"
+    61744, // "🤦🏻\u200d♀️" 1F926-1F3FB-200D-2640-FE0F
+    61767, // "🤦🏻\u200d♂️" 1F926-1F3FB-200D-2642-FE0F
+    61791, // "🤦🏻" 1F926-1F3FB
+    61815, // "🤦🏼\u200d♀️" 1F926-1F3FC-200D-2640-FE0F
+    61838, // "🤦🏼\u200d♂️" 1F926-1F3FC-200D-2642-FE0F
+    61862, // "🤦🏼" 1F926-1F3FC
+    61886, // "🤦🏽\u200d♀️" 1F926-1F3FD-200D-2640-FE0F
+    61909, // "🤦🏽\u200d♂️" 1F926-1F3FD-200D-2642-FE0F
+    61933, // "🤦🏽" 1F926-1F3FD
+    61957, // "🤦🏾\u200d♀️" 1F926-1F3FE-200D-2640-FE0F
+    61980, // "🤦🏾\u200d♂️" 1F926-1F3FE-200D-2642-FE0F
+    62003, // "🤦🏾" 1F926-1F3FE
+    62027, // "🤦🏿\u200d♀️" 1F926-1F3FF-200D-2640-FE0F
+    62050, // "🤦🏿\u200d♂️" 1F926-1F3FF-200D-2642-FE0F
+    62073, // "🤦🏿" 1F926-1F3FF
+    62097, // "🤦\u200d♀️" 1F926-200D-2640-FE0F
+    62120, // "🤦\u200d♂️" 1F926-200D-2642-FE0F
+    62143, // "🤦"
+    62166, // "🤧"
+    62226, // "🤩"
+    62257, // "🤪"
+    62319, // "🤬"
+    62351, // "🤭"
+    62440, // "🤰🏻" 1F930-1F3FB
+    62460, // "🤰🏼" 1F930-1F3FC
+    62478, // "🤰🏽" 1F930-1F3FD
+    62498, // "🤰🏾" 1F930-1F3FE
+    62518, // "🤰🏿" 1F930-1F3FF
+    62536, // "🤰"
+    62556, // "🤱🏻" 1F931-1F3FB
+    62579, // "🤱🏼" 1F931-1F3FC
+    62600, // "🤱🏽" 1F931-1F3FD
+    62623, // "🤱🏾" 1F931-1F3FE
+    62646, // "🤱🏿" 1F931-1F3FF
+    62669, // "🤱"
+    62692, // "🤲🏻" 1F932-1F3FB
+    62713, // "🤲🏼" 1F932-1F3FC
+    62760, // "🤲🏾" 1F932-1F3FE
+    62785, // "🤲🏿" 1F932-1F3FF
+    62811, // "🤲"
+    62836, // "🤳🏻" 1F933-1F3FB
+    62858, // "🤳🏼" 1F933-1F3FC
+    62881, // "🤳🏽" 1F933-1F3FD
+    62903, // "🤳🏾" 1F933-1F3FE
+    62925, // "🤳🏿" 1F933-1F3FF
+    62948, // "🤳"
+    62970, // "🤴🏻" 1F934-1F3FB
+    62992, // "🤴🏼" 1F934-1F3FC
+    63014, // "🤴🏽" 1F934-1F3FD
+    63037, // "🤴🏾" 1F934-1F3FE
+    63061, // "🤴🏿" 1F934-1F3FF
+    63085, // "🤴"
+    63108, // "🤵🏻\u200d♀️" 1F935-1F3FB-200D-2640-FE0F
+    63131, // "🤵🏻\u200d♂️" 1F935-1F3FB-200D-2642-FE0F
+    63156, // "🤵🏻" 1F935-1F3FB
+    63182, // "🤵🏼\u200d♀️" 1F935-1F3FC-200D-2640-FE0F
+    63206, // "🤵🏼\u200d♂️" 1F935-1F3FC-200D-2642-FE0F
+    63230, // "🤵🏼" 1F935-1F3FC
+    63255, // "🤵🏽\u200d♀️" 1F935-1F3FD-200D-2640-FE0F
+    63279, // "🤵🏽\u200d♂️" 1F935-1F3FD-200D-2642-FE0F
+    63303, // "🤵🏽" 1F935-1F3FD
+    63329, // "🤵🏾\u200d♀️" 1F935-1F3FE-200D-2640-FE0F
+    63353, // "🤵🏾\u200d♂️" 1F935-1F3FE-200D-2642-FE0F
+    63377, // "🤵🏾" 1F935-1F3FE
+    63403, // "🤵🏿\u200d♀️" 1F935-1F3FF-200D-2640-FE0F
+    63427, // "🤵🏿\u200d♂️" 1F935-1F3FF-200D-2642-FE0F
+    63452, // "🤵🏿" 1F935-1F3FF
+    63478, // "🤵\u200d♀️" 1F935-200D-2640-FE0F
+    63502, // "🤵\u200d♂️" 1F935-200D-2642-FE0F
+    63526, // "🤵"
+    63551, // "🤶🏻" 1F936-1F3FB
+    63575, // "🤶🏼" 1F936-1F3FC
+    63600, // "🤶🏽" 1F936-1F3FD
+    63625, // "🤶🏾" 1F936-1F3FE
+    63651, // "🤶🏿" 1F936-1F3FF
+    63677, // "🤶"
+    63701, // "🤷🏻\u200d♀️" 1F937-1F3FB-200D-2640-FE0F
+    63730, // "🤷🏻\u200d♂️" 1F937-1F3FB-200D-2642-FE0F
+    63759, // "🤷🏻" 1F937-1F3FB
+    63788, // "🤷🏼\u200d♀️" 1F937-1F3FC-200D-2640-FE0F
+    63817, // "🤷🏼\u200d♂️" 1F937-1F3FC-200D-2642-FE0F
+    63846, // "🤷🏼" 1F937-1F3FC
+    63873, // "🤷🏽\u200d♀️" 1F937-1F3FD-200D-2640-FE0F
+    63902, // "🤷🏽\u200d♂️" 1F937-1F3FD-200D-2642-FE0F
+    63931, // "🤷🏽" 1F937-1F3FD
+    63963, // "🤷🏾\u200d♀️" 1F937-1F3FE-200D-2640-FE0F
+    63994, // "🤷🏾\u200d♂️" 1F937-1F3FE-200D-2642-FE0F
+    64026, // "🤷🏾" 1F937-1F3FE
+    64058, // "🤷🏿\u200d♀️" 1F937-1F3FF-200D-2640-FE0F
+    64089, // "🤷🏿\u200d♂️" 1F937-1F3FF-200D-2642-FE0F
+    64121, // "🤷🏿" 1F937-1F3FF
+    64153, // "🤷\u200d♀️" 1F937-200D-2640-FE0F
+    64182, // "🤷\u200d♂️" 1F937-200D-2642-FE0F
+    64214, // "🤷"
+    64246, // "🤸🏻\u200d♀️" 1F938-1F3FB-200D-2640-FE0F
+    64268, // "🤸🏻\u200d♂️" 1F938-1F3FB-200D-2642-FE0F
+    64290, // "🤸🏻" 1F938-1F3FB
+    64312, // "🤸🏼\u200d♀️" 1F938-1F3FC-200D-2640-FE0F
+    64334, // "🤸🏼\u200d♂️" 1F938-1F3FC-200D-2642-FE0F
+    64356, // "🤸🏼" 1F938-1F3FC
+    64379, // "🤸🏽\u200d♀️" 1F938-1F3FD-200D-2640-FE0F
+    64401, // "🤸🏽\u200d♂️" 1F938-1F3FD-200D-2642-FE0F
+    64426, // "🤸🏽" 1F938-1F3FD
+    64449, // "🤸🏾\u200d♀️" 1F938-1F3FE-200D-2640-FE0F
+    64474, // "🤸🏾\u200d♂️" 1F938-1F3FE-200D-2642-FE0F
+    64499, // "🤸🏾" 1F938-1F3FE
+    64522, // "🤸🏿\u200d♀️" 1F938-1F3FF-200D-2640-FE0F
+    64547, // "🤸🏿\u200d♂️" 1F938-1F3FF-200D-2642-FE0F
+    64572, // "🤸🏿" 1F938-1F3FF
+    64595, // "🤸\u200d♀️" 1F938-200D-2640-FE0F
+    64619, // "🤸\u200d♂️" 1F938-200D-2642-FE0F
+    64642, // "🤸"
+    64665, // "🤹🏻\u200d♀️" 1F939-1F3FB-200D-2640-FE0F
+    64697, // "🤹🏻\u200d♂️" 1F939-1F3FB-200D-2642-FE0F
+    64729, // "🤹🏻" 1F939-1F3FB
+    64759, // "🤹🏼\u200d♀️" 1F939-1F3FC-200D-2640-FE0F
+    64791, // "🤹🏼\u200d♂️" 1F939-1F3FC-200D-2642-FE0F
+    64823, // "🤹🏼" 1F939-1F3FC
+    64855, // "🤹🏽\u200d♀️" 1F939-1F3FD-200D-2640-FE0F
+    64887, // "🤹🏽\u200d♂️" 1F939-1F3FD-200D-2642-FE0F
+    64919, // "🤹🏽" 1F939-1F3FD
+    64951, // "🤹🏾\u200d♀️" 1F939-1F3FE-200D-2640-FE0F
+    64983, // "🤹🏾\u200d♂️" 1F939-1F3FE-200D-2642-FE0F
+    65015, // "🤹🏾" 1F939-1F3FE
+    65047, // "🤹🏿\u200d♀️" 1F939-1F3FF-200D-2640-FE0F
+    65079, // "🤹🏿\u200d♂️" 1F939-1F3FF-200D-2642-FE0F
+    65111, // "🤹🏿" 1F939-1F3FF
+    65143, // "🤹\u200d♀️" 1F939-200D-2640-FE0F
+    65175, // "🤹\u200d♂️" 1F939-200D-2642-FE0F
+    65207, // "🤹"
+    65239, // "🤺"
+    65266, // "🤼\u200d♀️" 1F93C-200D-2640-FE0F
+    65296, // "🤼\u200d♂️" 1F93C-200D-2642-FE0F
+    65327, // "🤼"
+    65358, // "🤽🏻\u200d♀️" 1F93D-1F3FB-200D-2640-FE0F
+    65390, // "🤽🏻\u200d♂️" 1F93D-1F3FB-200D-2642-FE0F
+    65422, // "🤽🏻" 1F93D-1F3FB
+    65454, // "🤽🏼\u200d♀️" 1F93D-1F3FC-200D-2640-FE0F
+    65486, // "🤽🏼\u200d♂️" 1F93D-1F3FC-200D-2642-FE0F
+    65518, // "🤽🏼" 1F93D-1F3FC
+    65550, // "🤽🏽\u200d♀️" 1F93D-1F3FD-200D-2640-FE0F
+    65582, // "🤽🏽\u200d♂️" 1F93D-1F3FD-200D-2642-FE0F
+    65614, // "🤽🏽" 1F93D-1F3FD
+    65646, // "🤽🏾\u200d♀️" 1F93D-1F3FE-200D-2640-FE0F
+    65678, // "🤽🏾\u200d♂️" 1F93D-1F3FE-200D-2642-FE0F
+    65710, // "🤽🏾" 1F93D-1F3FE
+    65742, // "🤽🏿\u200d♀️" 1F93D-1F3FF-200D-2640-FE0F
+    65774, // "🤽🏿\u200d♂️" 1F93D-1F3FF-200D-2642-FE0F
+    65806, // "🤽🏿" 1F93D-1F3FF
+    65838, // "🤽\u200d♀️" 1F93D-200D-2640-FE0F
+    65869, // "🤽\u200d♂️" 1F93D-200D-2642-FE0F
+    65900, // "🤽"
+    65931, // "🤾🏻\u200d♀️" 1F93E-1F3FB-200D-2640-FE0F
+    65955, // "🤾🏻\u200d♂️" 1F93E-1F3FB-200D-2642-FE0F
+    65981, // "🤾🏻" 1F93E-1F3FB
+    66003, // "🤾🏼\u200d♀️" 1F93E-1F3FC-200D-2640-FE0F
+    66027, // "🤾🏼\u200d♂️" 1F93E-1F3FC-200D-2642-FE0F
+    66051, // "🤾🏼" 1F93E-1F3FC
+    66073, // "🤾🏽\u200d♀️" 1F93E-1F3FD-200D-2640-FE0F
+    66097, // "🤾🏽\u200d♂️" 1F93E-1F3FD-200D-2642-FE0F
+    66123, // "🤾🏽" 1F93E-1F3FD
+    66148, // "🤾🏾\u200d♀️" 1F93E-1F3FE-200D-2640-FE0F
+    66172, // "🤾🏾\u200d♂️" 1F93E-1F3FE-200D-2642-FE0F
+    66198, // "🤾🏾" 1F93E-1F3FE
+    66223, // "🤾🏿\u200d♀️" 1F93E-1F3FF-200D-2640-FE0F
+    66247, // "🤾🏿\u200d♂️" 1F93E-1F3FF-200D-2642-FE0F
+    66271, // "🤾🏿" 1F93E-1F3FF
+    66298, // "🤾\u200d♀️" 1F93E-200D-2640-FE0F
+    66314, // "🤾\u200d♂️" 1F93E-200D-2642-FE0F
+    66334, // "🤾"
+    66352, // "🤿"
+    66384, // "🥀"
+    66405, // "🥁"
+    66438, // "🥂"
+    66466, // "🥃"
+    66484, // "🥄"
+    66497, // "🥅"
+    66553, // "🥈"
+    66585, // "🥉"
+    66617, // "🥊"
+    66642, // "🥋"
+    66671, // "🥌"
+    66696, // "🥍"
+    66758, // "🥏"
+    66783, // "🥐"
+    66814, // "🥑"
+    66845, // "🥒"
+    66875, // "🥓"
+    66905, // "🥔"
+    66932, // "🥕"
+    66963, // "🥖"
+    67013, // "🥘"
+    67046, // "🥙"
+    67073, // "🥚"
+    67093, // "🥛"
+    67112, // "🥜"
+    67142, // "🥝"
+    67170, // "🥞"
+    67197, // "🥟"
+    67220, // "🥠"
+    67251, // "🥡"
+    67280, // "🥢"
+    67307, // "🥣"
+    67331, // "🥤"
+    67357, // "🥥"
+    67386, // "🥦"
+    67438, // "🥨"
+    67459, // "🥩"
+    67501, // "🥫"
+    67521, // "🥬"
+    67553, // "🥭"
+    67609, // "🥯"
+    67637, // "🥰"
+    67700, // "🥲"
+    67732, // "🥳"
+    67794, // "🥵"
+    67826, // "🥶"
+    67859, // "🥷🏻" 1F977-1F3FB
+    67884, // "🥷🏼" 1F977-1F3FC
+    67908, // "🥷🏽" 1F977-1F3FD
+    67934, // "🥷🏾" 1F977-1F3FE
+    67959, // "🥷🏿" 1F977-1F3FF
+    67983, // "🥷"
+    68007, // "🥸"
+    68069, // "🥻"
+    68092, // "🥼"
+    68121, // "🥽"
+    68138, // "🥾"
+    68161, // "🥿"
+    68173, // "🦀"
+    68204, // "🦁"
+    68236, // "🦂"
+    68265, // "🦃"
+    68297, // "🦄"
+    68329, // "🦅"
+    68353, // "🦆"
+    68375, // "🦇"
+    68398, // "🦈"
+    68429, // "🦉"
+    68453, // "🦊"
+    68481, // "🦋"
+    68510, // "🦌"
+    68543, // "🦍"
+    68574, // "🦎"
+    68605, // "🦏"
+    68636, // "🦐"
+    68668, // "🦑"
+    68693, // "🦒"
+    68720, // "🦓"
+    68750, // "🦔"
+    68778, // "🦕"
+    68808, // "🦖"
+    68838, // "🦗"
+    68862, // "🦘"
+    68894, // "🦙"
+    68947, // "🦛"
+    68968, // "🦜"
+    68994, // "🦝"
+    69021, // "🦞"
+    69048, // "🦟"
+    69079, // "🦠"
+    69110, // "🦡"
+    69128, // "🦢"
+    69156, // "🦣"
+    69183, // "🦤"
+    69206, // "🦥"
+    69236, // "🦦"
+    69265, // "🦧"
+    69296, // "🦨"
+    69328, // "🦩"
+    69358, // "🦪"
+    69383, // "🦫"
+    69409, // "🦬"
+    69436, // "🦭"
+    69464, // "🦮"
+    69494, // "🦯"
+    69521, // "🦰"
+    69552, // "🦱"
+    69584, // "🦲"
+    69643, // "🦴"
+    69675, // "🦵🏻" 1F9B5-1F3FB
+    69692, // "🦵🏼" 1F9B5-1F3FC
+    69712, // "🦵🏽" 1F9B5-1F3FD
+    69731, // "🦵🏾" 1F9B5-1F3FE
+    69751, // "🦵🏿" 1F9B5-1F3FF
+    69773, // "🦵"
+    69792, // "🦶🏻" 1F9B6-1F3FB
+    69813, // "🦶🏼" 1F9B6-1F3FC
+    69856, // "🦶🏾" 1F9B6-1F3FE
+    69879, // "🦶🏿" 1F9B6-1F3FF
+    69924, // "🦷"
+    69942, // "🦸🏻\u200d♀️" 1F9B8-1F3FB-200D-2640-FE0F
+    69970, // "🦸🏻\u200d♂️" 1F9B8-1F3FB-200D-2642-FE0F
+    70000, // "🦸🏻" 1F9B8-1F3FB
+    70030, // "🦸🏼\u200d♀️" 1F9B8-1F3FC-200D-2640-FE0F
+    70056, // "🦸🏼\u200d♂️" 1F9B8-1F3FC-200D-2642-FE0F
+    70086, // "🦸🏼" 1F9B8-1F3FC
+    70115, // "🦸🏽\u200d♀️" 1F9B8-1F3FD-200D-2640-FE0F
+    70143, // "🦸🏽\u200d♂️" 1F9B8-1F3FD-200D-2642-FE0F
+    70173, // "🦸🏽" 1F9B8-1F3FD
+    70203, // "🦸🏾\u200d♀️" 1F9B8-1F3FE-200D-2640-FE0F
+    70231, // "🦸🏾\u200d♂️" 1F9B8-1F3FE-200D-2642-FE0F
+    70261, // "🦸🏾" 1F9B8-1F3FE
+    70291, // "🦸🏿\u200d♀️" 1F9B8-1F3FF-200D-2640-FE0F
+    70319, // "🦸🏿\u200d♂️" 1F9B8-1F3FF-200D-2642-FE0F
+    70349, // "🦸🏿" 1F9B8-1F3FF
+    70379, // "🦸\u200d♀️" 1F9B8-200D-2640-FE0F
+    70407, // "🦸\u200d♂️" 1F9B8-200D-2642-FE0F
+    70437, // "🦸"
+    70466, // "🦹🏻\u200d♀️" 1F9B9-1F3FB-200D-2640-FE0F
+    70490, // "🦹🏻\u200d♂️" 1F9B9-1F3FB-200D-2642-FE0F
+    70521, // "🦹🏻" 1F9B9-1F3FB
+    70550, // "🦹🏼\u200d♀️" 1F9B9-1F3FC-200D-2640-FE0F
+    70572, // "🦹🏼\u200d♂️" 1F9B9-1F3FC-200D-2642-FE0F
+    70601, // "🦹🏼" 1F9B9-1F3FC
+    70628, // "🦹🏽\u200d♀️" 1F9B9-1F3FD-200D-2640-FE0F
+    70650, // "🦹🏽\u200d♂️" 1F9B9-1F3FD-200D-2642-FE0F
+    70680, // "🦹🏽" 1F9B9-1F3FD
+    70707, // "🦹🏾\u200d♀️" 1F9B9-1F3FE-200D-2640-FE0F
+    70729, // "🦹🏾\u200d♂️" 1F9B9-1F3FE-200D-2642-FE0F
+    70759, // "🦹🏾" 1F9B9-1F3FE
+    70786, // "🦹🏿\u200d♀️" 1F9B9-1F3FF-200D-2640-FE0F
+    70808, // "🦹🏿\u200d♂️" 1F9B9-1F3FF-200D-2642-FE0F
+    70838, // "🦹🏿" 1F9B9-1F3FF
+    70865, // "🦹\u200d♀️" 1F9B9-200D-2640-FE0F
+    70887, // "🦹\u200d♂️" 1F9B9-200D-2642-FE0F
+    70917, // "🦹"
+    70974, // "🦻🏻" 1F9BB-1F3FB
+    71000, // "🦻🏼" 1F9BB-1F3FC
+    71029, // "🦻🏽" 1F9BB-1F3FD
+    71058, // "🦻🏾" 1F9BB-1F3FE
+    71085, // "🦻🏿" 1F9BB-1F3FF
+    71114, // "🦻"
+    71142, // "🦼"
+    71168, // "🦽"
+    71189, // "🦾"
+    71219, // "🦿"
+    71241, // "🧀"
+    71268, // "🧁"
+    71293, // "🧂"
+    71313, // "🧃"
+    71330, // "🧄"
+    71355, // "🧅"
+    71380, // "🧆"
+    71411, // "🧇"
+    71435, // "🧈"
+    71458, // "🧉"
+    71483, // "🧊"
+    71508, // "🧋"
+    71529, // "🧍🏻\u200d♀️" 1F9CD-1F3FB-200D-2640-FE0F
+    71540, // "🧍🏻\u200d♂️" 1F9CD-1F3FB-200D-2642-FE0F
+    71552, // "🧍🏻" 1F9CD-1F3FB
+    71563, // "🧍🏼\u200d♀️" 1F9CD-1F3FC-200D-2640-FE0F
+    71574, // "🧍🏼\u200d♂️" 1F9CD-1F3FC-200D-2642-FE0F
+    71585, // "🧍🏼" 1F9CD-1F3FC
+    71596, // "🧍🏽\u200d♀️" 1F9CD-1F3FD-200D-2640-FE0F
+    71607, // "🧍🏽\u200d♂️" 1F9CD-1F3FD-200D-2642-FE0F
+    71619, // "🧍🏽" 1F9CD-1F3FD
+    71630, // "🧍🏾\u200d♀️" 1F9CD-1F3FE-200D-2640-FE0F
+    71641, // "🧍🏾\u200d♂️" 1F9CD-1F3FE-200D-2642-FE0F
+    71653, // "🧍🏾" 1F9CD-1F3FE
+    71665, // "🧍🏿\u200d♀️" 1F9CD-1F3FF-200D-2640-FE0F
+    71676, // "🧍🏿\u200d♂️" 1F9CD-1F3FF-200D-2642-FE0F
+    71688, // "🧍🏿" 1F9CD-1F3FF
+    71700, // "🧍\u200d♀️" 1F9CD-200D-2640-FE0F
+    71709, // "🧍\u200d♂️" 1F9CD-200D-2642-FE0F
+    71720, // "🧍"
+    71731, // "🧎🏻\u200d♀️" 1F9CE-1F3FB-200D-2640-FE0F
+    71753, // "🧎🏻\u200d♂️" 1F9CE-1F3FB-200D-2642-FE0F
+    71774, // "🧎🏻" 1F9CE-1F3FB
+    71794, // "🧎🏼\u200d♀️" 1F9CE-1F3FC-200D-2640-FE0F
+    71816, // "🧎🏼\u200d♂️" 1F9CE-1F3FC-200D-2642-FE0F
+    71837, // "🧎🏼" 1F9CE-1F3FC
+    71857, // "🧎🏽\u200d♀️" 1F9CE-1F3FD-200D-2640-FE0F
+    71879, // "🧎🏽\u200d♂️" 1F9CE-1F3FD-200D-2642-FE0F
+    71900, // "🧎🏽" 1F9CE-1F3FD
+    71922, // "🧎🏾\u200d♀️" 1F9CE-1F3FE-200D-2640-FE0F
+    71943, // "🧎🏾\u200d♂️" 1F9CE-1F3FE-200D-2642-FE0F
+    71964, // "🧎🏾" 1F9CE-1F3FE
+    71986, // "🧎🏿\u200d♀️" 1F9CE-1F3FF-200D-2640-FE0F
+    72007, // "🧎🏿\u200d♂️" 1F9CE-1F3FF-200D-2642-FE0F
+    72028, // "🧎🏿" 1F9CE-1F3FF
+    72050, // "🧎\u200d♀️" 1F9CE-200D-2640-FE0F
+    72071, // "🧎\u200d♂️" 1F9CE-200D-2642-FE0F
+    72092, // "🧎"
+    72114, // "🧏🏻\u200d♀️" 1F9CF-1F3FB-200D-2640-FE0F
+    72140, // "🧏🏻\u200d♂️" 1F9CF-1F3FB-200D-2642-FE0F
+    72198, // "🧏🏼\u200d♀️" 1F9CF-1F3FC-200D-2640-FE0F
+    72223, // "🧏🏼\u200d♂️" 1F9CF-1F3FC-200D-2642-FE0F
+    72306, // "🧏🏽\u200d♂️" 1F9CF-1F3FD-200D-2642-FE0F
+    72336, // "🧏🏽" 1F9CF-1F3FD
+    72394, // "🧏🏾\u200d♂️" 1F9CF-1F3FE-200D-2642-FE0F
+    72424, // "🧏🏾" 1F9CF-1F3FE
+    72482, // "🧏🏿\u200d♂️" 1F9CF-1F3FF-200D-2642-FE0F
+    72512, // "🧏🏿" 1F9CF-1F3FF
+    72569, // "🧏\u200d♂️" 1F9CF-200D-2642-FE0F
+    72599, // "🧏"
+    72629, // "🧐"
+    72660, // "🧑🏻\u200d🌾" 1F9D1-1F3FB-200D-1F33E
+    72692, // "🧑🏻\u200d🍳" 1F9D1-1F3FB-200D-1F373
+    72724, // "🧑🏻\u200d🍼" 1F9D1-1F3FB-200D-1F37C
+    72746, // "🧑🏻\u200d🎄" 1F9D1-1F3FB-200D-1F384
+    72774, // "🧑🏻\u200d🎓" 1F9D1-1F3FB-200D-1F393
+    72800, // "🧑🏻\u200d🎤" 1F9D1-1F3FB-200D-1F3A4
+    72832, // "🧑🏻\u200d🎨" 1F9D1-1F3FB-200D-1F3A8
+    72863, // "🧑🏻\u200d🏫" 1F9D1-1F3FB-200D-1F3EB
+    72895, // "🧑🏻\u200d🏭" 1F9D1-1F3FB-200D-1F3ED
+    72958, // "🧑🏻\u200d💼" 1F9D1-1F3FB-200D-1F4BC
+    72990, // "🧑🏻\u200d🔧" 1F9D1-1F3FB-200D-1F527
+    73052, // "🧑🏻\u200d🚀" 1F9D1-1F3FB-200D-1F680
+    73084, // "🧑🏻\u200d🚒" 1F9D1-1F3FB-200D-1F692
+    73109, // "🧑🏻\u200d🤝\u200d🧑🏻" 1F9D1-1F3FB-200D-1F91D-200D-1F9D1-1F3FB
+    73138, // "🧑🏻\u200d🤝\u200d🧑🏼" 1F9D1-1F3FB-200D-1F91D-200D-1F9D1-1F3FC
+    73164, // "🧑🏻\u200d🤝\u200d🧑🏽" 1F9D1-1F3FB-200D-1F91D-200D-1F9D1-1F3FD
+    73191, // "🧑🏻\u200d🤝\u200d🧑🏾" 1F9D1-1F3FB-200D-1F91D-200D-1F9D1-1F3FE
+    73220, // "🧑🏻\u200d🤝\u200d🧑🏿" 1F9D1-1F3FB-200D-1F91D-200D-1F9D1-1F3FF
+    73247, // "🧑🏻\u200d🦯" 1F9D1-1F3FB-200D-1F9AF
+    73273, // "🧑🏻\u200d🦰" 1F9D1-1F3FB-200D-1F9B0
+    73297, // "🧑🏻\u200d🦱" 1F9D1-1F3FB-200D-1F9B1
+    73323, // "🧑🏻\u200d🦲" 1F9D1-1F3FB-200D-1F9B2
+    73342, // "🧑🏻\u200d🦳" 1F9D1-1F3FB-200D-1F9B3
+    73365, // "🧑🏻\u200d🦼" 1F9D1-1F3FB-200D-1F9BC
+    73394, // "🧑🏻\u200d🦽" 1F9D1-1F3FB-200D-1F9BD
+    73422, // "🧑🏻\u200d⚕️" 1F9D1-1F3FB-200D-2695-FE0F
+    73448, // "🧑🏻\u200d⚖️" 1F9D1-1F3FB-200D-2696-FE0F
+    73478, // "🧑🏻\u200d✈️" 1F9D1-1F3FB-200D-2708-FE0F
+    73502, // "🧑🏻" 1F9D1-1F3FB
+    73526, // "🧑🏼\u200d🌾" 1F9D1-1F3FC-200D-1F33E
+    73558, // "🧑🏼\u200d🍳" 1F9D1-1F3FC-200D-1F373
+    73587, // "🧑🏼\u200d🍼" 1F9D1-1F3FC-200D-1F37C
+    73607, // "🧑🏼\u200d🎄" 1F9D1-1F3FC-200D-1F384
+    73635, // "🧑🏼\u200d🎓" 1F9D1-1F3FC-200D-1F393
+    73660, // "🧑🏼\u200d🎤" 1F9D1-1F3FC-200D-1F3A4
+    73692, // "🧑🏼\u200d🎨" 1F9D1-1F3FC-200D-1F3A8
+    73754, // "🧑🏼\u200d🏭" 1F9D1-1F3FC-200D-1F3ED
+    73786, // "🧑🏼\u200d💻" 1F9D1-1F3FC-200D-1F4BB
+    73817, // "🧑🏼\u200d💼" 1F9D1-1F3FC-200D-1F4BC
+    73849, // "🧑🏼\u200d🔧" 1F9D1-1F3FC-200D-1F527
+    73881, // "🧑🏼\u200d🔬" 1F9D1-1F3FC-200D-1F52C
+    73941, // "🧑🏼\u200d🚒" 1F9D1-1F3FC-200D-1F692
+    73967, // "🧑🏼\u200d🤝\u200d🧑🏻" 1F9D1-1F3FC-200D-1F91D-200D-1F9D1-1F3FB
+    73994, // "🧑🏼\u200d🤝\u200d🧑🏼" 1F9D1-1F3FC-200D-1F91D-200D-1F9D1-1F3FC
+    74023, // "🧑🏼\u200d🤝\u200d🧑🏽" 1F9D1-1F3FC-200D-1F91D-200D-1F9D1-1F3FD
+    74051, // "🧑🏼\u200d🤝\u200d🧑🏾" 1F9D1-1F3FC-200D-1F91D-200D-1F9D1-1F3FE
+    74078, // "🧑🏼\u200d🤝\u200d🧑🏿" 1F9D1-1F3FC-200D-1F91D-200D-1F9D1-1F3FF
+    74107, // "🧑🏼\u200d🦯" 1F9D1-1F3FC-200D-1F9AF
+    74134, // "🧑🏼\u200d🦰" 1F9D1-1F3FC-200D-1F9B0
+    74159, // "🧑🏼\u200d🦱" 1F9D1-1F3FC-200D-1F9B1
+    74184, // "🧑🏼\u200d🦲" 1F9D1-1F3FC-200D-1F9B2
+    74204, // "🧑🏼\u200d🦳" 1F9D1-1F3FC-200D-1F9B3
+    74227, // "🧑🏼\u200d🦼" 1F9D1-1F3FC-200D-1F9BC
+    74254, // "🧑🏼\u200d🦽" 1F9D1-1F3FC-200D-1F9BD
+    74282, // "🧑🏼\u200d⚕️" 1F9D1-1F3FC-200D-2695-FE0F
+    74308, // "🧑🏼\u200d⚖️" 1F9D1-1F3FC-200D-2696-FE0F
+    74338, // "🧑🏼\u200d✈️" 1F9D1-1F3FC-200D-2708-FE0F
+    74362, // "🧑🏼" 1F9D1-1F3FC
+    74385, // "🧑🏽\u200d🌾" 1F9D1-1F3FD-200D-1F33E
+    74448, // "🧑🏽\u200d🍼" 1F9D1-1F3FD-200D-1F37C
+    74468, // "🧑🏽\u200d🎄" 1F9D1-1F3FD-200D-1F384
+    74499, // "🧑🏽\u200d🎓" 1F9D1-1F3FD-200D-1F393
+    74524, // "🧑🏽\u200d🎤" 1F9D1-1F3FD-200D-1F3A4
+    74556, // "🧑🏽\u200d🎨" 1F9D1-1F3FD-200D-1F3A8
+    74584, // "🧑🏽\u200d🏫" 1F9D1-1F3FD-200D-1F3EB
+    74616, // "🧑🏽\u200d🏭" 1F9D1-1F3FD-200D-1F3ED
+    74648, // "🧑🏽\u200d💻" 1F9D1-1F3FD-200D-1F4BB
+    74680, // "🧑🏽\u200d💼" 1F9D1-1F3FD-200D-1F4BC
+    74741, // "🧑🏽\u200d🔬" 1F9D1-1F3FD-200D-1F52C
+    74772, // "🧑🏽\u200d🚀" 1F9D1-1F3FD-200D-1F680
+    74803, // "🧑🏽\u200d🚒" 1F9D1-1F3FD-200D-1F692
+    74828, // "🧑🏽\u200d🤝\u200d🧑🏻" 1F9D1-1F3FD-200D-1F91D-200D-1F9D1-1F3FB
+    74855, // "🧑🏽\u200d🤝\u200d🧑🏼" 1F9D1-1F3FD-200D-1F91D-200D-1F9D1-1F3FC
+    74882, // "🧑🏽\u200d🤝\u200d🧑🏽" 1F9D1-1F3FD-200D-1F91D-200D-1F9D1-1F3FD
+    74911, // "🧑🏽\u200d🤝\u200d🧑🏾" 1F9D1-1F3FD-200D-1F91D-200D-1F9D1-1F3FE
+    74940, // "🧑🏽\u200d🤝\u200d🧑🏿" 1F9D1-1F3FD-200D-1F91D-200D-1F9D1-1F3FF
+    74967, // "🧑🏽\u200d🦯" 1F9D1-1F3FD-200D-1F9AF
+    74994, // "🧑🏽\u200d🦰" 1F9D1-1F3FD-200D-1F9B0
+    75019, // "🧑🏽\u200d🦱" 1F9D1-1F3FD-200D-1F9B1
+    75046, // "🧑🏽\u200d🦲" 1F9D1-1F3FD-200D-1F9B2
+    75067, // "🧑🏽\u200d🦳" 1F9D1-1F3FD-200D-1F9B3
+    75090, // "🧑🏽\u200d🦼" 1F9D1-1F3FD-200D-1F9BC
+    75119, // "🧑🏽\u200d🦽" 1F9D1-1F3FD-200D-1F9BD
+    75146, // "🧑🏽\u200d⚕️" 1F9D1-1F3FD-200D-2695-FE0F
+    75171, // "🧑🏽\u200d⚖️" 1F9D1-1F3FD-200D-2696-FE0F
+    75201, // "🧑🏽\u200d✈️" 1F9D1-1F3FD-200D-2708-FE0F
+    75226, // "🧑🏽" 1F9D1-1F3FD
+    75251, // "🧑🏾\u200d🌾" 1F9D1-1F3FE-200D-1F33E
+    75283, // "🧑🏾\u200d🍳" 1F9D1-1F3FE-200D-1F373
+    75315, // "🧑🏾\u200d🍼" 1F9D1-1F3FE-200D-1F37C
+    75337, // "🧑🏾\u200d🎄" 1F9D1-1F3FE-200D-1F384
+    75367, // "🧑🏾\u200d🎓" 1F9D1-1F3FE-200D-1F393
+    75393, // "🧑🏾\u200d🎤" 1F9D1-1F3FE-200D-1F3A4
+    75425, // "🧑🏾\u200d🎨" 1F9D1-1F3FE-200D-1F3A8
+    75457, // "🧑🏾\u200d🏫" 1F9D1-1F3FE-200D-1F3EB
+    75489, // "🧑🏾\u200d🏭" 1F9D1-1F3FE-200D-1F3ED
+    75552, // "🧑🏾\u200d💼" 1F9D1-1F3FE-200D-1F4BC
+    75584, // "🧑🏾\u200d🔧" 1F9D1-1F3FE-200D-1F527
+    75646, // "🧑🏾\u200d🚀" 1F9D1-1F3FE-200D-1F680
+    75678, // "🧑🏾\u200d🚒" 1F9D1-1F3FE-200D-1F692
+    75703, // "🧑🏾\u200d🤝\u200d🧑🏻" 1F9D1-1F3FE-200D-1F91D-200D-1F9D1-1F3FB
+    75732, // "🧑🏾\u200d🤝\u200d🧑🏼" 1F9D1-1F3FE-200D-1F91D-200D-1F9D1-1F3FC
+    75758, // "🧑🏾\u200d🤝\u200d🧑🏽" 1F9D1-1F3FE-200D-1F91D-200D-1F9D1-1F3FD
+    75785, // "🧑🏾\u200d🤝\u200d🧑🏾" 1F9D1-1F3FE-200D-1F91D-200D-1F9D1-1F3FE
+    75814, // "🧑🏾\u200d🤝\u200d🧑🏿" 1F9D1-1F3FE-200D-1F91D-200D-1F9D1-1F3FF
+    75842, // "🧑🏾\u200d🦯" 1F9D1-1F3FE-200D-1F9AF
+    75870, // "🧑🏾\u200d🦰" 1F9D1-1F3FE-200D-1F9B0
+    75896, // "🧑🏾\u200d🦱" 1F9D1-1F3FE-200D-1F9B1
+    75922, // "🧑🏾\u200d🦲" 1F9D1-1F3FE-200D-1F9B2
+    75943, // "🧑🏾\u200d🦳" 1F9D1-1F3FE-200D-1F9B3
+    75966, // "🧑🏾\u200d🦼" 1F9D1-1F3FE-200D-1F9BC
+    75995, // "🧑🏾\u200d🦽" 1F9D1-1F3FE-200D-1F9BD
+    76023, // "🧑🏾\u200d⚕️" 1F9D1-1F3FE-200D-2695-FE0F
+    76049, // "🧑🏾\u200d⚖️" 1F9D1-1F3FE-200D-2696-FE0F
+    76079, // "🧑🏾\u200d✈️" 1F9D1-1F3FE-200D-2708-FE0F
+    76103, // "🧑🏾" 1F9D1-1F3FE
+    76127, // "🧑🏿\u200d🌾" 1F9D1-1F3FF-200D-1F33E
+    76159, // "🧑🏿\u200d🍳" 1F9D1-1F3FF-200D-1F373
+    76188, // "🧑🏿\u200d🍼" 1F9D1-1F3FF-200D-1F37C
+    76208, // "🧑🏿\u200d🎄" 1F9D1-1F3FF-200D-1F384
+    76236, // "🧑🏿\u200d🎓" 1F9D1-1F3FF-200D-1F393
+    76262, // "🧑🏿\u200d🎤" 1F9D1-1F3FF-200D-1F3A4
+    76294, // "🧑🏿\u200d🎨" 1F9D1-1F3FF-200D-1F3A8
+    76354, // "🧑🏿\u200d🏭" 1F9D1-1F3FF-200D-1F3ED
+    76386, // "🧑🏿\u200d💻" 1F9D1-1F3FF-200D-1F4BB
+    76417, // "🧑🏿\u200d💼" 1F9D1-1F3FF-200D-1F4BC
+    76449, // "🧑🏿\u200d🔧" 1F9D1-1F3FF-200D-1F527
+    76481, // "🧑🏿\u200d🔬" 1F9D1-1F3FF-200D-1F52C
+    76541, // "🧑🏿\u200d🚒" 1F9D1-1F3FF-200D-1F692
+    76567, // "🧑🏿\u200d🤝\u200d🧑🏻" 1F9D1-1F3FF-200D-1F91D-200D-1F9D1-1F3FB
+    76594, // "🧑🏿\u200d🤝\u200d🧑🏼" 1F9D1-1F3FF-200D-1F91D-200D-1F9D1-1F3FC
+    76623, // "🧑🏿\u200d🤝\u200d🧑🏽" 1F9D1-1F3FF-200D-1F91D-200D-1F9D1-1F3FD
+    76652, // "🧑🏿\u200d🤝\u200d🧑🏾" 1F9D1-1F3FF-200D-1F91D-200D-1F9D1-1F3FE
+    76679, // "🧑🏿\u200d🤝\u200d🧑🏿" 1F9D1-1F3FF-200D-1F91D-200D-1F9D1-1F3FF
+    76708, // "🧑🏿\u200d🦯" 1F9D1-1F3FF-200D-1F9AF
+    76736, // "🧑🏿\u200d🦰" 1F9D1-1F3FF-200D-1F9B0
+    76761, // "🧑🏿\u200d🦱" 1F9D1-1F3FF-200D-1F9B1
+    76788, // "🧑🏿\u200d🦲" 1F9D1-1F3FF-200D-1F9B2
+    76809, // "🧑🏿\u200d🦳" 1F9D1-1F3FF-200D-1F9B3
+    76832, // "🧑🏿\u200d🦼" 1F9D1-1F3FF-200D-1F9BC
+    76859, // "🧑🏿\u200d🦽" 1F9D1-1F3FF-200D-1F9BD
+    76887, // "🧑🏿\u200d⚕️" 1F9D1-1F3FF-200D-2695-FE0F
+    76913, // "🧑🏿\u200d⚖️" 1F9D1-1F3FF-200D-2696-FE0F
+    76943, // "🧑🏿\u200d✈️" 1F9D1-1F3FF-200D-2708-FE0F
+    76967, // "🧑🏿" 1F9D1-1F3FF
+    76993, // "🧑\u200d🌾" 1F9D1-200D-1F33E
+    77056, // "🧑\u200d🍼" 1F9D1-200D-1F37C
+    77076, // "🧑\u200d🎄" 1F9D1-200D-1F384
+    77107, // "🧑\u200d🎓" 1F9D1-200D-1F393
+    77132, // "🧑\u200d🎤" 1F9D1-200D-1F3A4
+    77164, // "🧑\u200d🎨" 1F9D1-200D-1F3A8
+    77226, // "🧑\u200d🏭" 1F9D1-200D-1F3ED
+    77258, // "🧑\u200d💻" 1F9D1-200D-1F4BB
+    77289, // "🧑\u200d💼" 1F9D1-200D-1F4BC
+    77350, // "🧑\u200d🔬" 1F9D1-200D-1F52C
+    77381, // "🧑\u200d🚀" 1F9D1-200D-1F680
+    77412, // "🧑\u200d🚒" 1F9D1-200D-1F692
+    77437, // "🧑\u200d🤝\u200d🧑" 1F9D1-200D-1F91D-200D-1F9D1
+    77463, // "🧑\u200d🦯" 1F9D1-200D-1F9AF
+    77491, // "🧑\u200d🦰" 1F9D1-200D-1F9B0
+    77516, // "🧑\u200d🦱" 1F9D1-200D-1F9B1
+    77540, // "🧑\u200d🦲" 1F9D1-200D-1F9B2
+    77560, // "🧑\u200d🦳" 1F9D1-200D-1F9B3
+    77583, // "🧑\u200d🦼" 1F9D1-200D-1F9BC
+    77611, // "🧑\u200d🦽" 1F9D1-200D-1F9BD
+    77638, // "🧑\u200d⚕️" 1F9D1-200D-2695-FE0F
+    77662, // "🧑\u200d⚖️" 1F9D1-200D-2696-FE0F
+    77692, // "🧑\u200d✈️" 1F9D1-200D-2708-FE0F
+    77716, // "🧑"
+    77739, // "🧒🏻" 1F9D2-1F3FB
+    77758, // "🧒🏼" 1F9D2-1F3FC
+    77776, // "🧒🏽" 1F9D2-1F3FD
+    77796, // "🧒🏾" 1F9D2-1F3FE
+    77816, // "🧒🏿" 1F9D2-1F3FF
+    77836, // "🧒"
+    77855, // "🧓🏻" 1F9D3-1F3FB
+    77878, // "🧓🏼" 1F9D3-1F3FC
+    77901, // "🧓🏽" 1F9D3-1F3FD
+    77925, // "🧓🏾" 1F9D3-1F3FE
+    77948, // "🧓🏿" 1F9D3-1F3FF
+    77971, // "🧓"
+    77995, // "🧔🏻" 1F9D4-1F3FB
+    78022, // "🧔🏼" 1F9D4-1F3FC
+    78048, // "🧔🏽" 1F9D4-1F3FD
+    78075, // "🧔🏾" 1F9D4-1F3FE
+    78102, // "🧔🏿" 1F9D4-1F3FF
+    78129, // "🧔"
+    78155, // "🧕🏻" 1F9D5-1F3FB
+    78177, // "🧕🏼" 1F9D5-1F3FC
+    78198, // "🧕🏽" 1F9D5-1F3FD
+    78220, // "🧕🏾" 1F9D5-1F3FE
+    78242, // "🧕🏿" 1F9D5-1F3FF
+    78263, // "🧕"
+    78285, // "🧖🏻\u200d♀️" 1F9D6-1F3FB-200D-2640-FE0F
+    78346, // "🧖🏻" 1F9D6-1F3FB
+    78376, // "🧖🏼\u200d♀️" 1F9D6-1F3FC-200D-2640-FE0F
+    78459, // "🧖🏽\u200d♀️" 1F9D6-1F3FD-200D-2640-FE0F
+    78490, // "🧖🏽\u200d♂️" 1F9D6-1F3FD-200D-2642-FE0F
+    78547, // "🧖🏾\u200d♀️" 1F9D6-1F3FE-200D-2640-FE0F
+    78578, // "🧖🏾\u200d♂️" 1F9D6-1F3FE-200D-2642-FE0F
+    78636, // "🧖🏿\u200d♀️" 1F9D6-1F3FF-200D-2640-FE0F
+    78667, // "🧖🏿\u200d♂️" 1F9D6-1F3FF-200D-2642-FE0F
+    78726, // "🧖\u200d♀️" 1F9D6-200D-2640-FE0F
+    78757, // "🧖\u200d♂️" 1F9D6-200D-2642-FE0F
+    78813, // "🧗🏻\u200d♀️" 1F9D7-1F3FB-200D-2640-FE0F
+    78845, // "🧗🏻\u200d♂️" 1F9D7-1F3FB-200D-2642-FE0F
+    78878, // "🧗🏻" 1F9D7-1F3FB
+    78909, // "🧗🏼\u200d♀️" 1F9D7-1F3FC-200D-2640-FE0F
+    78940, // "🧗🏼\u200d♂️" 1F9D7-1F3FC-200D-2642-FE0F
+    78972, // "🧗🏼" 1F9D7-1F3FC
+    79004, // "🧗🏽\u200d♀️" 1F9D7-1F3FD-200D-2640-FE0F
+    79035, // "🧗🏽\u200d♂️" 1F9D7-1F3FD-200D-2642-FE0F
+    79067, // "🧗🏽" 1F9D7-1F3FD
+    79099, // "🧗🏾\u200d♀️" 1F9D7-1F3FE-200D-2640-FE0F
+    79130, // "🧗🏾\u200d♂️" 1F9D7-1F3FE-200D-2642-FE0F
+    79162, // "🧗🏾" 1F9D7-1F3FE
+    79194, // "🧗🏿\u200d♀️" 1F9D7-1F3FF-200D-2640-FE0F
+    79226, // "🧗🏿\u200d♂️" 1F9D7-1F3FF-200D-2642-FE0F
+    79258, // "🧗🏿" 1F9D7-1F3FF
+    79290, // "🧗\u200d♀️" 1F9D7-200D-2640-FE0F
+    79321, // "🧗\u200d♂️" 1F9D7-200D-2642-FE0F
+    79353, // "🧗"
+    79385, // "🧘🏻\u200d♀️" 1F9D8-1F3FB-200D-2640-FE0F
+    79408, // "🧘🏻\u200d♂️" 1F9D8-1F3FB-200D-2642-FE0F
+    79431, // "🧘🏻" 1F9D8-1F3FB
+    79451, // "🧘🏼\u200d♀️" 1F9D8-1F3FC-200D-2640-FE0F
+    79472, // "🧘🏼\u200d♂️" 1F9D8-1F3FC-200D-2642-FE0F
+    79495, // "🧘🏼" 1F9D8-1F3FC
+    79518, // "🧘🏽\u200d♀️" 1F9D8-1F3FD-200D-2640-FE0F
+    79539, // "🧘🏽\u200d♂️" 1F9D8-1F3FD-200D-2642-FE0F
+    79562, // "🧘🏽" 1F9D8-1F3FD
+    79585, // "🧘🏾\u200d♀️" 1F9D8-1F3FE-200D-2640-FE0F
+    79606, // "🧘🏾\u200d♂️" 1F9D8-1F3FE-200D-2642-FE0F
+    79629, // "🧘🏾" 1F9D8-1F3FE
+    79652, // "🧘🏿\u200d♀️" 1F9D8-1F3FF-200D-2640-FE0F
+    79673, // "🧘🏿\u200d♂️" 1F9D8-1F3FF-200D-2642-FE0F
+    79696, // "🧘🏿" 1F9D8-1F3FF
+    79719, // "🧘\u200d♀️" 1F9D8-200D-2640-FE0F
+    79740, // "🧘\u200d♂️" 1F9D8-200D-2642-FE0F
+    79763, // "🧘"
+    79786, // "🧙🏻\u200d♀️" 1F9D9-1F3FB-200D-2640-FE0F
+    79814, // "🧙🏻\u200d♂️" 1F9D9-1F3FB-200D-2642-FE0F
+    79843, // "🧙🏻" 1F9D9-1F3FB
+    79871, // "🧙🏼\u200d♀️" 1F9D9-1F3FC-200D-2640-FE0F
+    79899, // "🧙🏼\u200d♂️" 1F9D9-1F3FC-200D-2642-FE0F
+    79928, // "🧙🏼" 1F9D9-1F3FC
+    79956, // "🧙🏽\u200d♀️" 1F9D9-1F3FD-200D-2640-FE0F
+    79984, // "🧙🏽\u200d♂️" 1F9D9-1F3FD-200D-2642-FE0F
+    80013, // "🧙🏽" 1F9D9-1F3FD
+    80041, // "🧙🏾\u200d♀️" 1F9D9-1F3FE-200D-2640-FE0F
+    80069, // "🧙🏾\u200d♂️" 1F9D9-1F3FE-200D-2642-FE0F
+    80098, // "🧙🏾" 1F9D9-1F3FE
+    80126, // "🧙🏿\u200d♀️" 1F9D9-1F3FF-200D-2640-FE0F
+    80154, // "🧙🏿\u200d♂️" 1F9D9-1F3FF-200D-2642-FE0F
+    80183, // "🧙🏿" 1F9D9-1F3FF
+    80211, // "🧙\u200d♀️" 1F9D9-200D-2640-FE0F
+    80240, // "🧙\u200d♂️" 1F9D9-200D-2642-FE0F
+    80267, // "🧙"
+    80324, // "🧚🏻\u200d♂️" 1F9DA-1F3FB-200D-2642-FE0F
+    80353, // "🧚🏻" 1F9DA-1F3FB
+    80411, // "🧚🏼\u200d♂️" 1F9DA-1F3FC-200D-2642-FE0F
+    80439, // "🧚🏼" 1F9DA-1F3FC
+    80496, // "🧚🏽\u200d♂️" 1F9DA-1F3FD-200D-2642-FE0F
+    80525, // "🧚🏽" 1F9DA-1F3FD
+    80583, // "🧚🏾\u200d♂️" 1F9DA-1F3FE-200D-2642-FE0F
+    80612, // "🧚🏾" 1F9DA-1F3FE
+    80699, // "🧚🏿" 1F9DA-1F3FF
+    80728, // "🧚\u200d♀️" 1F9DA-200D-2640-FE0F
+    80786, // "🧚"
+    80815, // "🧛🏻\u200d♀️" 1F9DB-1F3FB-200D-2640-FE0F
+    80843, // "🧛🏻\u200d♂️" 1F9DB-1F3FB-200D-2642-FE0F
+    80868, // "🧛🏻" 1F9DB-1F3FB
+    80894, // "🧛🏼\u200d♀️" 1F9DB-1F3FC-200D-2640-FE0F
+    80922, // "🧛🏼\u200d♂️" 1F9DB-1F3FC-200D-2642-FE0F
+    80947, // "🧛🏼" 1F9DB-1F3FC
+    80973, // "🧛🏽\u200d♀️" 1F9DB-1F3FD-200D-2640-FE0F
+    81001, // "🧛🏽\u200d♂️" 1F9DB-1F3FD-200D-2642-FE0F
+    81026, // "🧛🏽" 1F9DB-1F3FD
+    81052, // "🧛🏾\u200d♀️" 1F9DB-1F3FE-200D-2640-FE0F
+    81080, // "🧛🏾\u200d♂️" 1F9DB-1F3FE-200D-2642-FE0F
+    81105, // "🧛🏾" 1F9DB-1F3FE
+    81131, // "🧛🏿\u200d♀️" 1F9DB-1F3FF-200D-2640-FE0F
+    81159, // "🧛🏿\u200d♂️" 1F9DB-1F3FF-200D-2642-FE0F
+    81184, // "🧛🏿" 1F9DB-1F3FF
+    81210, // "🧛\u200d♀️" 1F9DB-200D-2640-FE0F
+    81238, // "🧛\u200d♂️" 1F9DB-200D-2642-FE0F
+    81263, // "🧛"
+    81289, // "🧜🏻\u200d♀️" 1F9DC-1F3FB-200D-2640-FE0F
+    81349, // "🧜🏻" 1F9DC-1F3FB
+    81379, // "🧜🏼\u200d♀️" 1F9DC-1F3FC-200D-2640-FE0F
+    81439, // "🧜🏼" 1F9DC-1F3FC
+    81469, // "🧜🏽\u200d♀️" 1F9DC-1F3FD-200D-2640-FE0F
+    81529, // "🧜🏽" 1F9DC-1F3FD
+    81559, // "🧜🏾\u200d♀️" 1F9DC-1F3FE-200D-2640-FE0F
+    81588, // "🧜🏾\u200d♂️" 1F9DC-1F3FE-200D-2642-FE0F
+    81617, // "🧜🏾" 1F9DC-1F3FE
+    81678, // "🧜🏿\u200d♂️" 1F9DC-1F3FF-200D-2642-FE0F
+    81707, // "🧜🏿" 1F9DC-1F3FF
+    81738, // "🧜\u200d♀️" 1F9DC-200D-2640-FE0F
+    81767, // "🧜\u200d♂️" 1F9DC-200D-2642-FE0F
+    81796, // "🧜"
+    81827, // "🧝🏻\u200d♀️" 1F9DD-1F3FB-200D-2640-FE0F
+    81852, // "🧝🏻\u200d♂️" 1F9DD-1F3FB-200D-2642-FE0F
+    81876, // "🧝🏻" 1F9DD-1F3FB
+    81899, // "🧝🏼\u200d♀️" 1F9DD-1F3FC-200D-2640-FE0F
+    81924, // "🧝🏼\u200d♂️" 1F9DD-1F3FC-200D-2642-FE0F
+    81948, // "🧝🏼" 1F9DD-1F3FC
+    81971, // "🧝🏽\u200d♀️" 1F9DD-1F3FD-200D-2640-FE0F
+    81996, // "🧝🏽\u200d♂️" 1F9DD-1F3FD-200D-2642-FE0F
+    82018, // "🧝🏽" 1F9DD-1F3FD
+    82041, // "🧝🏾\u200d♀️" 1F9DD-1F3FE-200D-2640-FE0F
+    82066, // "🧝🏾\u200d♂️" 1F9DD-1F3FE-200D-2642-FE0F
+    82088, // "🧝🏾" 1F9DD-1F3FE
+    82111, // "🧝🏿\u200d♀️" 1F9DD-1F3FF-200D-2640-FE0F
+    82136, // "🧝🏿\u200d♂️" 1F9DD-1F3FF-200D-2642-FE0F
+    82158, // "🧝🏿" 1F9DD-1F3FF
+    82181, // "🧝\u200d♀️" 1F9DD-200D-2640-FE0F
+    82206, // "🧝\u200d♂️" 1F9DD-200D-2642-FE0F
+    82228, // "🧝"
+    82251, // "🧞\u200d♀️" 1F9DE-200D-2640-FE0F
+    82274, // "🧞\u200d♂️" 1F9DE-200D-2642-FE0F
+    82296, // "🧞"
+    82320, // "🧟\u200d♀️" 1F9DF-200D-2640-FE0F
+    82341, // "🧟\u200d♂️" 1F9DF-200D-2642-FE0F
+    82364, // "🧟"
+    82384, // "🧠"
+    82407, // "🧡"
+    82436, // "🧢"
+    82464, // "🧣"
+    82488, // "🧤"
+    82543, // "🧦"
+    82568, // "🧧"
+    82599, // "🧨"
+    82626, // "🧩"
+    82655, // "🧪"
+    82707, // "🧬"
+    82739, // "🧭"
+    82771, // "🧮"
+    82803, // "🧯"
+    82824, // "🧰"
+    82857, // "🧱"
+    82883, // "🧲"
+    82914, // "🧳"
+    82935, // "🧴"
+    82956, // "🧵"
+    82987, // "🧶"
+    83019, // "🧷"
+    83052, // "🧸"
+    83075, // "🧹"
+    83104, // "🧺"
+    83159, // "🧼"
+    83188, // "🧽"
+    83217, // "🧾"
+    83240, // "🧿"
+    83273, // "🩰"
+    83299, // "🩱"
+    83316, // "🩲"
+    83340, // "🩳"
+    83370, // "🩴"
+    83383, // "🩸"
+    83403, // "🩹"
+    83432, // "🩺"
+    83464, // "🪀"
+    83495, // "🪁"
+    83524, // "🪂"
+    83557, // "🪃"
+    83583, // "🪄"
+    83638, // "🪆"
+    83657, // "🪐"
+    83675, // "🪑"
+    83701, // "🪒"
+    83731, // "🪓"
+    83756, // "🪔"
+    83786, // "🪕"
+    83817, // "🪖"
+    83847, // "🪗"
+    83873, // "🪘"
+    83932, // "🪚"
+    83955, // "🪛"
+    83984, // "🪜"
+    84003, // "🪝"
+    84020, // "🪞"
+    84040, // "🪟"
+    84102, // "🪡"
+    84130, // "🪢"
+    84185, // "🪤"
+    84216, // "🪥"
+    84240, // "🪦"
+    84269, // "🪧"
+    84289, // "🪨"
+    84317, // "🪰"
+    84345, // "🪱"
+    84372, // "🪲"
+    84432, // "🪴"
+    84459, // "🪵"
+    84480, // "🪶"
+    84509, // "🫀"
+    84531, // "🫁"
+    84560, // "🫂"
+    84587, // "🫐"
+    84614, // "🫑"
+    84639, // "🫒"
+    84693, // "🫔"
+    84719, // "🫕"
+    84771, // "‼"
+    84789, // "⁉"
+    84814, // "™"
+    84831, // "ℹ"
+    84863, // "↔"
+    84896, // "↕"
+    84928, // "↖"
+    84960, // "↗"
+    84992, // "↘"
+    85025, // "↙"
+    85057, // "↩"
+    85089, // "↪"
+    85122, // "⌚"
+    85145, // "⌛"
+    85166, // "#⃣" 23-20E3
+    85199, // "⌨"
+    85218, // "⏏"
+    85250, // "⏩"
+    85283, // "⏪"
+    85315, // "⏫"
+    85347, // "⏬"
+    85380, // "⏭"
+    85412, // "⏮"
+    85444, // "⏯"
+    85476, // "⏰"
+    85508, // "⏱"
+    85532, // "⏲"
+    85562, // "⏳"
+    85583, // "⏸"
+    85615, // "⏹"
+    85647, // "⏺"
+    85680, // "Ⓜ"
+    85712, // "▪"
+    85719, // "▫"
+    85725, // "▶"
+    85757, // "◀"
+    85789, // "◻"
+    85812, // "◼"
+    85835, // "◽"
+    85847, // "◾"
+    85861, // "☀"
+    85917, // "☂"
+    85944, // "☃"
+    85971, // "☄"
+    86000, // "☎"
+    86026, // "☑"
+    86059, // "☔"
+    86090, // "☕"
+    86152, // "☝🏻" 261D-1F3FB
+    86170, // "☝🏼" 261D-1F3FC
+    86188, // "☝🏽" 261D-1F3FD
+    86207, // "☝🏾" 261D-1F3FE
+    86225, // "☝🏿" 261D-1F3FF
+    86243, // "☝"
+    86260, // "☠"
+    86321, // "☣"
+    86350, // "☦"
+    86383, // "☪"
+    86415, // "☮"
+    86447, // "☯"
+    86480, // "☸"
+    86512, // "☹"
+    86543, // "☺"
+    86574, // "♀"
+    86606, // "♂"
+    86638, // "♈"
+    86671, // "♉"
+    86703, // "♊"
+    86735, // "♋"
+    86767, // "♌"
+    86800, // "♍"
+    86832, // "♎"
+    86864, // "♏"
+    86897, // "♐"
+    86929, // "♑"
+    86961, // "♒"
+    86994, // "♓"
+    87026, // "♟"
+    87047, // "♠"
+    87074, // "♣"
+    87101, // "♥"
+    87124, // "♦"
+    87148, // "♨"
+    87207, // "♾"
+    87239, // "♿"
+    87272, // "⚒"
+    87303, // "⚓"
+    87332, // "⚔"
+    87364, // "⚕"
+    87396, // "⚖"
+    87421, // "⚗"
+    87451, // "⚙"
+    87483, // "⚛"
+    87515, // "⚜"
+    87574, // "⚡"
+    87600, // "⚧"
+    87663, // "⚫"
+    87725, // "⚱"
+    87747, // "⚽"
+    87779, // "⚾"
+    87809, // "⛄"
+    87865, // "⛈"
+    87895, // "⛎"
+    87957, // "⛑"
+    87983, // "⛓"
+    88007, // "⛔"
+    88040, // "⛩"
+    88072, // "⛪"
+    88099, // "⛰"
+    88129, // "⛱"
+    88160, // "⛲"
+    88190, // "⛳"
+    88223, // "⛴"
+    88250, // "⛵"
+    88282, // "⛷🏻" 26F7-1F3FB
+    88342, // "⛷🏽" 26F7-1F3FD
+    88373, // "⛷🏾" 26F7-1F3FE
+    88433, // "⛷"
+    88464, // "⛸"
+    88491, // "⛹🏻\u200d♀️" 26F9-1F3FB-200D-2640-FE0F
+    88520, // "⛹🏻\u200d♂️" 26F9-1F3FB-200D-2642-FE0F
+    88549, // "⛹🏻" 26F9-1F3FB
+    88578, // "⛹🏼\u200d♀️" 26F9-1F3FC-200D-2640-FE0F
+    88607, // "⛹🏼\u200d♂️" 26F9-1F3FC-200D-2642-FE0F
+    88636, // "⛹🏼" 26F9-1F3FC
+    88665, // "⛹🏽\u200d♀️" 26F9-1F3FD-200D-2640-FE0F
+    88693, // "⛹🏽\u200d♂️" 26F9-1F3FD-200D-2642-FE0F
+    88722, // "⛹🏽" 26F9-1F3FD
+    88751, // "⛹🏾\u200d♀️" 26F9-1F3FE-200D-2640-FE0F
+    88779, // "⛹🏾\u200d♂️" 26F9-1F3FE-200D-2642-FE0F
+    88808, // "⛹🏾" 26F9-1F3FE
+    88837, // "⛹🏿\u200d♀️" 26F9-1F3FF-200D-2640-FE0F
+    88865, // "⛹🏿\u200d♂️" 26F9-1F3FF-200D-2642-FE0F
+    88894, // "⛹🏿" 26F9-1F3FF
+    88923, // "⛹️\u200d♀️" 26F9-FE0F-200D-2640-FE0F
+    88951, // "⛹️\u200d♂️" 26F9-FE0F-200D-2642-FE0F
+    88980, // "⛹"
+    89009, // "⛺"
+    89029, // "⛽"
+    89056, // "✂"
+    89084, // "✅"
+    89116, // "✈"
+    89145, // "✉"
+    89168, // "✊🏻" 270A-1F3FB
+    89196, // "✊🏼" 270A-1F3FC
+    89253, // "✊🏾" 270A-1F3FE
+    89282, // "✊🏿" 270A-1F3FF
+    89341, // "✋🏻" 270B-1F3FB
+    89363, // "✋🏼" 270B-1F3FC
+    89386, // "✋🏽" 270B-1F3FD
+    89411, // "✋🏾" 270B-1F3FE
+    89437, // "✋🏿" 270B-1F3FF
+    89463, // "✋"
+    89485, // "✌🏻" 270C-1F3FB
+    89501, // "✌🏼" 270C-1F3FC
+    89519, // "✌🏽" 270C-1F3FD
+    89539, // "✌🏾" 270C-1F3FE
+    89558, // "✌🏿" 270C-1F3FF
+    89578, // "✌"
+    89596, // "✍🏻" 270D-1F3FB
+    89625, // "✍🏼" 270D-1F3FC
+    89655, // "✍🏽" 270D-1F3FD
+    89686, // "✍🏾" 270D-1F3FE
+    89715, // "✍🏿" 270D-1F3FF
+    89745, // "✍"
+    89776, // "✏"
+    89806, // "✒"
+    89838, // "✔"
+    89870, // "✖"
+    89893, // "✝"
+    89925, // "✡"
+    89957, // "✨"
+    89981, // "✳"
+    90013, // "✴"
+    90035, // "❄"
+    90064, // "❇"
+    90096, // "❌"
+    90124, // "❎"
+    90156, // "❓"
+    90175, // "❔"
+    90191, // "❕"
+    90196, // "❗"
+    90203, // "❣"
+    90226, // "❤"
+    90255, // "➕"
+    90281, // "➖"
+    90288, // "➗"
+    90312, // "➡"
+    90345, // "➰"
+    90370, // "➿"
+    90395, // "⤴"
+    90428, // "⤵"
+    90460, // "*⃣" 2A-20E3
+    90492, // "⬅"
+    90525, // "⬆"
+    90557, // "⬇"
+    90589, // "⬛"
+    90650, // "⭐"
+    90677, // "⭕"
+    90710, // "0⃣" 30-20E3
+    90742, // "〰"
+    90752, // "〽"
+    90774, // "1⃣" 31-20E3
+    90807, // "2⃣" 32-20E3
+    90839, // "㊗"
+    90871, // "㊙"
+    90904, // "3⃣" 33-20E3
+    90936, // "4⃣" 34-20E3
+    90968, // "5⃣" 35-20E3
+    91001, // "6⃣" 36-20E3
+    91033, // "7⃣" 37-20E3
+    91065, // "8⃣" 38-20E3
+    91098, // "9⃣" 39-20E3
+    91130, // "©"
+    91163, // "®"
+    91196, // "\ue50a"
+];
+
+/// Glyph metrics; sort matches METRICS_OFFSET.
+/// Record format: ((advance as u16) << 16) | (left bearing as i16 as u16)
+const METRICS: [u32; 3126] = [
+    0x00200004,  // "🀄"
+    0x00200005,  // "🃏"
+    0x00200000,  // "🅰"
+    0x00200001,  // "🅱"
+    0x00200000,  // "🅾"
+    0x00200000,  // "🅿"
+    0x00200001,  // "🆎"
+    0x00200000,  // "🆑"
+    0x00200000,  // "🆒"
+    0x00200001,  // "🆓"
+    0x00200000,  // "🆔"
+    0x00200000,  // "🆕"
+    0x00200001,  // "🆖"
+    0x00200000,  // "🆗"
+    0x00200000,  // "🆘"
+    0x00200001,  // "🆙"
+    0x00200001,  // "🆚"
+    0x00200000,  // "🇦🇨" 1F1E6-1F1E8
+    0x00200000,  // "🇦🇩" 1F1E6-1F1E9
+    0x00200000,  // "🇦🇪" 1F1E6-1F1EA
+    0x00200000,  // "🇦🇫" 1F1E6-1F1EB
+    0x00200000,  // "🇦🇬" 1F1E6-1F1EC
+    0x00200000,  // "🇦🇮" 1F1E6-1F1EE
+    0x00200000,  // "🇦🇱" 1F1E6-1F1F1
+    0x00200000,  // "🇦🇲" 1F1E6-1F1F2
+    0x00200000,  // "🇦🇴" 1F1E6-1F1F4
+    0x00200000,  // "🇦🇶" 1F1E6-1F1F6
+    0x00200000,  // "🇦🇷" 1F1E6-1F1F7
+    0x00200000,  // "🇦🇸" 1F1E6-1F1F8
+    0x00200000,  // "🇦🇹" 1F1E6-1F1F9
+    0x00200000,  // "🇦🇺" 1F1E6-1F1FA
+    0x00200001,  // "🇦🇼" 1F1E6-1F1FC
+    0x00200000,  // "🇦🇽" 1F1E6-1F1FD
+    0x00200000,  // "🇦🇿" 1F1E6-1F1FF
+    0x00200000,  // "🇦"
+    0x00200000,  // "🇧🇦" 1F1E7-1F1E6
+    0x00200000,  // "🇧🇧" 1F1E7-1F1E7
+    0x00200000,  // "🇧🇩" 1F1E7-1F1E9
+    0x00200000,  // "🇧🇪" 1F1E7-1F1EA
+    0x00200000,  // "🇧🇫" 1F1E7-1F1EB
+    0x00200000,  // "🇧🇬" 1F1E7-1F1EC
+    0x00200001,  // "🇧🇭" 1F1E7-1F1ED
+    0x00200000,  // "🇧🇮" 1F1E7-1F1EE
+    0x00200000,  // "🇧🇯" 1F1E7-1F1EF
+    0x00200000,  // "🇧🇲" 1F1E7-1F1F2
+    0x00200000,  // "🇧🇳" 1F1E7-1F1F3
+    0x00200000,  // "🇧🇴" 1F1E7-1F1F4
+    0x00200001,  // "🇧🇶" 1F1E7-1F1F6
+    0x00200000,  // "🇧🇷" 1F1E7-1F1F7
+    0x00200000,  // "🇧🇸" 1F1E7-1F1F8
+    0x00200001,  // "🇧🇹" 1F1E7-1F1F9
+    0x00200000,  // "🇧🇻" 1F1E7-1F1FB
+    0x00200000,  // "🇧🇼" 1F1E7-1F1FC
+    0x00200001,  // "🇧🇾" 1F1E7-1F1FE
+    0x00200000,  // "🇧🇿" 1F1E7-1F1FF
+    0x00200000,  // "🇧"
+    0x00200000,  // "🇨🇦" 1F1E8-1F1E6
+    0x00200000,  // "🇨🇨" 1F1E8-1F1E8
+    0x00200000,  // "🇨🇩" 1F1E8-1F1E9
+    0x00200000,  // "🇨🇫" 1F1E8-1F1EB
+    0x00200000,  // "🇨🇬" 1F1E8-1F1EC
+    0x00200004,  // "🇨🇭" 1F1E8-1F1ED
+    0x00200001,  // "🇨🇮" 1F1E8-1F1EE
+    0x00200000,  // "🇨🇰" 1F1E8-1F1F0
+    0x00200000,  // "🇨🇱" 1F1E8-1F1F1
+    0x00200000,  // "🇨🇲" 1F1E8-1F1F2
+    0x00200000,  // "🇨🇳" 1F1E8-1F1F3
+    0x00200000,  // "🇨🇴" 1F1E8-1F1F4
+    0x00200000,  // "🇨🇵" 1F1E8-1F1F5
+    0x00200000,  // "🇨🇷" 1F1E8-1F1F7
+    0x00200000,  // "🇨🇺" 1F1E8-1F1FA
+    0x00200000,  // "🇨🇻" 1F1E8-1F1FB
+    0x00200000,  // "🇨🇼" 1F1E8-1F1FC
+    0x00200000,  // "🇨🇽" 1F1E8-1F1FD
+    0x00200001,  // "🇨🇾" 1F1E8-1F1FE
+    0x00200000,  // "🇨🇿" 1F1E8-1F1FF
+    0x00200000,  // "🇨"
+    0x00200000,  // "🇩🇪" 1F1E9-1F1EA
+    0x00200000,  // "🇩🇬" 1F1E9-1F1EC
+    0x00200001,  // "🇩🇯" 1F1E9-1F1EF
+    0x00200000,  // "🇩🇰" 1F1E9-1F1F0
+    0x00200000,  // "🇩🇲" 1F1E9-1F1F2
+    0x00200000,  // "🇩🇴" 1F1E9-1F1F4
+    0x00200000,  // "🇩🇿" 1F1E9-1F1FF
+    0x00200000,  // "🇩"
+    0x00200000,  // "🇪🇦" 1F1EA-1F1E6
+    0x00200000,  // "🇪🇨" 1F1EA-1F1E8
+    0x00200000,  // "🇪🇪" 1F1EA-1F1EA
+    0x00200000,  // "🇪🇬" 1F1EA-1F1EC
+    0x00200000,  // "🇪🇭" 1F1EA-1F1ED
+    0x00200000,  // "🇪🇷" 1F1EA-1F1F7
+    0x00200000,  // "🇪🇸" 1F1EA-1F1F8
+    0x00200000,  // "🇪🇹" 1F1EA-1F1F9
+    0x00200000,  // "🇪🇺" 1F1EA-1F1FA
+    0x00200001,  // "🇪"
+    0x00200000,  // "🇫🇮" 1F1EB-1F1EE
+    0x00200000,  // "🇫🇯" 1F1EB-1F1EF
+    0x00200000,  // "🇫🇰" 1F1EB-1F1F0
+    0x00200001,  // "🇫🇲" 1F1EB-1F1F2
+    0x00200000,  // "🇫🇴" 1F1EB-1F1F4
+    0x00200000,  // "🇫🇷" 1F1EB-1F1F7
+    0x00200001,  // "🇫"
+    0x00200000,  // "🇬🇦" 1F1EC-1F1E6
+    0x00200000,  // "🇬🇧" 1F1EC-1F1E7
+    0x00200000,  // "🇬🇩" 1F1EC-1F1E9
+    0x00200000,  // "🇬🇪" 1F1EC-1F1EA
+    0x00200001,  // "🇬🇫" 1F1EC-1F1EB
+    0x00200000,  // "🇬🇬" 1F1EC-1F1EC
+    0x00200000,  // "🇬🇭" 1F1EC-1F1ED
+    0x00200000,  // "🇬🇮" 1F1EC-1F1EE
+    0x00200000,  // "🇬🇱" 1F1EC-1F1F1
+    0x00200000,  // "🇬🇲" 1F1EC-1F1F2
+    0x00200000,  // "🇬🇳" 1F1EC-1F1F3
+    0x00200000,  // "🇬🇵" 1F1EC-1F1F5
+    0x00200001,  // "🇬🇶" 1F1EC-1F1F6
+    0x00200000,  // "🇬🇷" 1F1EC-1F1F7
+    0x00200000,  // "🇬🇸" 1F1EC-1F1F8
+    0x00200001,  // "🇬🇹" 1F1EC-1F1F9
+    0x00200000,  // "🇬🇺" 1F1EC-1F1FA
+    0x00200000,  // "🇬🇼" 1F1EC-1F1FC
+    0x00200000,  // "🇬🇾" 1F1EC-1F1FE
+    0x00200000,  // "🇬"
+    0x00200000,  // "🇭🇰" 1F1ED-1F1F0
+    0x00200000,  // "🇭🇲" 1F1ED-1F1F2
+    0x00200000,  // "🇭🇳" 1F1ED-1F1F3
+    0x00200000,  // "🇭🇷" 1F1ED-1F1F7
+    0x00200000,  // "🇭🇹" 1F1ED-1F1F9
+    0x00200000,  // "🇭🇺" 1F1ED-1F1FA
+    0x00200000,  // "🇭"
+    0x00200001,  // "🇮🇨" 1F1EE-1F1E8
+    0x00200000,  // "🇮🇩" 1F1EE-1F1E9
+    0x00200000,  // "🇮🇪" 1F1EE-1F1EA
+    0x00200000,  // "🇮🇱" 1F1EE-1F1F1
+    0x00200000,  // "🇮🇲" 1F1EE-1F1F2
+    0x00200000,  // "🇮🇳" 1F1EE-1F1F3
+    0x00200000,  // "🇮🇴" 1F1EE-1F1F4
+    0x00200000,  // "🇮🇶" 1F1EE-1F1F6
+    0x00200000,  // "🇮🇷" 1F1EE-1F1F7
+    0x00200000,  // "🇮🇸" 1F1EE-1F1F8
+    0x00200001,  // "🇮🇹" 1F1EE-1F1F9
+    0x00200000,  // "🇮"
+    0x00200001,  // "🇯🇪" 1F1EF-1F1EA
+    0x00200000,  // "🇯🇲" 1F1EF-1F1F2
+    0x00200000,  // "🇯🇴" 1F1EF-1F1F4
+    0x00200000,  // "🇯🇵" 1F1EF-1F1F5
+    0x00200000,  // "🇯"
+    0x00200000,  // "🇰🇪" 1F1F0-1F1EA
+    0x00200000,  // "🇰🇬" 1F1F0-1F1EC
+    0x00200000,  // "🇰🇭" 1F1F0-1F1ED
+    0x00200000,  // "🇰🇮" 1F1F0-1F1EE
+    0x00200000,  // "🇰🇲" 1F1F0-1F1F2
+    0x00200000,  // "🇰🇳" 1F1F0-1F1F3
+    0x00200000,  // "🇰🇵" 1F1F0-1F1F5
+    0x00200000,  // "🇰🇷" 1F1F0-1F1F7
+    0x00200000,  // "🇰🇼" 1F1F0-1F1FC
+    0x00200000,  // "🇰🇾" 1F1F0-1F1FE
+    0x00200000,  // "🇰🇿" 1F1F0-1F1FF
+    0x00200000,  // "🇰"
+    0x00200000,  // "🇱🇦" 1F1F1-1F1E6
+    0x00200000,  // "🇱🇧" 1F1F1-1F1E7
+    0x00200000,  // "🇱🇨" 1F1F1-1F1E8
+    0x00200000,  // "🇱🇮" 1F1F1-1F1EE
+    0x00200001,  // "🇱🇰" 1F1F1-1F1F0
+    0x00200000,  // "🇱🇷" 1F1F1-1F1F7
+    0x00200000,  // "🇱🇸" 1F1F1-1F1F8
+    0x00200000,  // "🇱🇹" 1F1F1-1F1F9
+    0x00200000,  // "🇱🇺" 1F1F1-1F1FA
+    0x00200000,  // "🇱🇻" 1F1F1-1F1FB
+    0x00200000,  // "🇱🇾" 1F1F1-1F1FE
+    0x00200000,  // "🇱"
+    0x00200000,  // "🇲🇦" 1F1F2-1F1E6
+    0x00200000,  // "🇲🇨" 1F1F2-1F1E8
+    0x00200000,  // "🇲🇩" 1F1F2-1F1E9
+    0x00200001,  // "🇲🇪" 1F1F2-1F1EA
+    0x00200000,  // "🇲🇫" 1F1F2-1F1EB
+    0x00200001,  // "🇲🇬" 1F1F2-1F1EC
+    0x00200000,  // "🇲🇭" 1F1F2-1F1ED
+    0x00200000,  // "🇲🇰" 1F1F2-1F1F0
+    0x00200001,  // "🇲🇱" 1F1F2-1F1F1
+    0x00200000,  // "🇲🇲" 1F1F2-1F1F2
+    0x00200000,  // "🇲🇳" 1F1F2-1F1F3
+    0x00200001,  // "🇲🇴" 1F1F2-1F1F4
+    0x00200000,  // "🇲🇵" 1F1F2-1F1F5
+    0x00200000,  // "🇲🇶" 1F1F2-1F1F6
+    0x00200000,  // "🇲🇷" 1F1F2-1F1F7
+    0x00200000,  // "🇲🇸" 1F1F2-1F1F8
+    0x00200001,  // "🇲🇹" 1F1F2-1F1F9
+    0x00200000,  // "🇲🇺" 1F1F2-1F1FA
+    0x00200000,  // "🇲🇻" 1F1F2-1F1FB
+    0x00200000,  // "🇲🇼" 1F1F2-1F1FC
+    0x00200000,  // "🇲🇽" 1F1F2-1F1FD
+    0x00200000,  // "🇲🇾" 1F1F2-1F1FE
+    0x00200000,  // "🇲🇿" 1F1F2-1F1FF
+    0x00200000,  // "🇲"
+    0x00200000,  // "🇳🇦" 1F1F3-1F1E6
+    0x00200000,  // "🇳🇨" 1F1F3-1F1E8
+    0x00200000,  // "🇳🇪" 1F1F3-1F1EA
+    0x00200001,  // "🇳🇫" 1F1F3-1F1EB
+    0x00200000,  // "🇳🇬" 1F1F3-1F1EC
+    0x00200000,  // "🇳🇮" 1F1F3-1F1EE
+    0x00200000,  // "🇳🇱" 1F1F3-1F1F1
+    0x00200000,  // "🇳🇴" 1F1F3-1F1F4
+    0x00200007,  // "🇳🇵" 1F1F3-1F1F5
+    0x00200000,  // "🇳🇷" 1F1F3-1F1F7
+    0x00200000,  // "🇳🇺" 1F1F3-1F1FA
+    0x00200000,  // "🇳🇿" 1F1F3-1F1FF
+    0x00200001,  // "🇳"
+    0x00200000,  // "🇴🇲" 1F1F4-1F1F2
+    0x00200000,  // "🇴"
+    0x00200000,  // "🇵🇦" 1F1F5-1F1E6
+    0x00200000,  // "🇵🇪" 1F1F5-1F1EA
+    0x00200000,  // "🇵🇫" 1F1F5-1F1EB
+    0x00200000,  // "🇵🇬" 1F1F5-1F1EC
+    0x00200001,  // "🇵🇭" 1F1F5-1F1ED
+    0x00200000,  // "🇵🇰" 1F1F5-1F1F0
+    0x00200000,  // "🇵🇱" 1F1F5-1F1F1
+    0x00200000,  // "🇵🇲" 1F1F5-1F1F2
+    0x00200000,  // "🇵🇳" 1F1F5-1F1F3
+    0x00200000,  // "🇵🇷" 1F1F5-1F1F7
+    0x00200000,  // "🇵🇸" 1F1F5-1F1F8
+    0x00200000,  // "🇵🇹" 1F1F5-1F1F9
+    0x00200000,  // "🇵🇼" 1F1F5-1F1FC
+    0x00200000,  // "🇵🇾" 1F1F5-1F1FE
+    0x00200001,  // "🇵"
+    0x00200000,  // "🇶🇦" 1F1F6-1F1E6
+    0x00200000,  // "🇶"
+    0x00200001,  // "🇷🇪" 1F1F7-1F1EA
+    0x00200000,  // "🇷🇴" 1F1F7-1F1F4
+    0x00200000,  // "🇷🇸" 1F1F7-1F1F8
+    0x00200000,  // "🇷🇺" 1F1F7-1F1FA
+    0x00200000,  // "🇷🇼" 1F1F7-1F1FC
+    0x00200000,  // "🇷"
+    0x00200000,  // "🇸🇦" 1F1F8-1F1E6
+    0x00200000,  // "🇸🇧" 1F1F8-1F1E7
+    0x00200000,  // "🇸🇨" 1F1F8-1F1E8
+    0x00200001,  // "🇸🇩" 1F1F8-1F1E9
+    0x00200000,  // "🇸🇪" 1F1F8-1F1EA
+    0x00200000,  // "🇸🇬" 1F1F8-1F1EC
+    0x00200000,  // "🇸🇭" 1F1F8-1F1ED
+    0x00200000,  // "🇸🇮" 1F1F8-1F1EE
+    0x00200000,  // "🇸🇯" 1F1F8-1F1EF
+    0x00200000,  // "🇸🇰" 1F1F8-1F1F0
+    0x00200001,  // "🇸🇱" 1F1F8-1F1F1
+    0x00200000,  // "🇸🇲" 1F1F8-1F1F2
+    0x00200000,  // "🇸🇳" 1F1F8-1F1F3
+    0x00200001,  // "🇸🇴" 1F1F8-1F1F4
+    0x00200000,  // "🇸🇷" 1F1F8-1F1F7
+    0x00200000,  // "🇸🇸" 1F1F8-1F1F8
+    0x00200000,  // "🇸🇹" 1F1F8-1F1F9
+    0x00200000,  // "🇸🇻" 1F1F8-1F1FB
+    0x00200001,  // "🇸🇽" 1F1F8-1F1FD
+    0x00200000,  // "🇸🇾" 1F1F8-1F1FE
+    0x00200000,  // "🇸🇿" 1F1F8-1F1FF
+    0x00200000,  // "🇸"
+    0x00200000,  // "🇹🇦" 1F1F9-1F1E6
+    0x00200000,  // "🇹🇨" 1F1F9-1F1E8
+    0x00200000,  // "🇹🇩" 1F1F9-1F1E9
+    0x00200000,  // "🇹🇫" 1F1F9-1F1EB
+    0x00200000,  // "🇹🇬" 1F1F9-1F1EC
+    0x00200000,  // "🇹🇭" 1F1F9-1F1ED
+    0x00200000,  // "🇹🇯" 1F1F9-1F1EF
+    0x00200000,  // "🇹🇰" 1F1F9-1F1F0
+    0x00200000,  // "🇹🇱" 1F1F9-1F1F1
+    0x00200000,  // "🇹🇲" 1F1F9-1F1F2
+    0x00200000,  // "🇹🇳" 1F1F9-1F1F3
+    0x00200000,  // "🇹🇴" 1F1F9-1F1F4
+    0x00200000,  // "🇹🇷" 1F1F9-1F1F7
+    0x00200000,  // "🇹🇹" 1F1F9-1F1F9
+    0x00200000,  // "🇹🇻" 1F1F9-1F1FB
+    0x00200000,  // "🇹🇼" 1F1F9-1F1FC
+    0x00200001,  // "🇹🇿" 1F1F9-1F1FF
+    0x00200001,  // "🇹"
+    0x00200000,  // "🇺🇦" 1F1FA-1F1E6
+    0x00200000,  // "🇺🇬" 1F1FA-1F1EC
+    0x00200000,  // "🇺🇲" 1F1FA-1F1F2
+    0x00200000,  // "🇺🇳" 1F1FA-1F1F3
+    0x00200000,  // "🇺🇸" 1F1FA-1F1F8
+    0x00200000,  // "🇺🇾" 1F1FA-1F1FE
+    0x00200000,  // "🇺🇿" 1F1FA-1F1FF
+    0x00200000,  // "🇺"
+    0x00200005,  // "🇻🇦" 1F1FB-1F1E6
+    0x00200000,  // "🇻🇨" 1F1FB-1F1E8
+    0x00200000,  // "🇻🇪" 1F1FB-1F1EA
+    0x00200000,  // "🇻🇬" 1F1FB-1F1EC
+    0x00200000,  // "🇻🇮" 1F1FB-1F1EE
+    0x00200000,  // "🇻🇳" 1F1FB-1F1F3
+    0x00200000,  // "🇻🇺" 1F1FB-1F1FA
+    0x00200001,  // "🇻"
+    0x00200000,  // "🇼🇫" 1F1FC-1F1EB
+    0x00200000,  // "🇼🇸" 1F1FC-1F1F8
+    0x00200001,  // "🇼"
+    0x00200000,  // "🇽🇰" 1F1FD-1F1F0
+    0x00200000,  // "🇽"
+    0x00200000,  // "🇾🇪" 1F1FE-1F1EA
+    0x00200000,  // "🇾🇹" 1F1FE-1F1F9
+    0x00200000,  // "🇾"
+    0x00200000,  // "🇿🇦" 1F1FF-1F1E6
+    0x00200000,  // "🇿🇲" 1F1FF-1F1F2
+    0x00200001,  // "🇿🇼" 1F1FF-1F1FC
+    0x00200001,  // "🇿"
+    0x00200000,  // "🈁"
+    0x00200000,  // "🈂"
+    0x00200001,  // "🈚"
+    0x00200001,  // "🈯"
+    0x00200000,  // "🈲"
+    0x00200000,  // "🈳"
+    0x00200001,  // "🈴"
+    0x00200000,  // "🈵"
+    0x00200000,  // "🈶"
+    0x00200001,  // "🈷"
+    0x00200000,  // "🈸"
+    0x00200000,  // "🈹"
+    0x00200001,  // "🈺"
+    0x00200000,  // "🉐"
+    0x00200000,  // "🉑"
+    0x00200000,  // "🌁"
+    0x00200005,  // "🌂"
+    0x00200000,  // "🌃"
+    0x00200000,  // "🌄"
+    0x00200000,  // "🌅"
+    0x00200000,  // "🌆"
+    0x00200000,  // "🌇"
+    0x00200000,  // "🌈"
+    0x00200000,  // "🌉"
+    0x00200001,  // "🌊"
+    0x00200000,  // "🌋"
+    0x00200000,  // "🌌"
+    0x00200001,  // "🌍"
+    0x00200000,  // "🌎"
+    0x00200000,  // "🌏"
+    0x00200001,  // "🌐"
+    0x00200000,  // "🌑"
+    0x00200000,  // "🌒"
+    0x00200000,  // "🌕"
+    0x00200001,  // "🌖"
+    0x00200001,  // "🌗"
+    0x00200000,  // "🌘"
+    0x00200004,  // "🌙"
+    0x00200001,  // "🌚"
+    0x00200009,  // "🌛"
+    0x00200001,  // "🌜"
+    0x00200000,  // "🌞"
+    0x00200001,  // "🌟"
+    0x00200001,  // "🌠"
+    0x00200002,  // "🌡"
+    0x00200001,  // "🌤"
+    0x00200000,  // "🌧"
+    0x00200001,  // "🌨"
+    0x00200002,  // "🌪"
+    0x00200001,  // "🌫"
+    0x00200002,  // "🌭"
+    0x00200001,  // "🌮"
+    0x00200000,  // "🌰"
+    0x00200001,  // "🌱"
+    0x00200004,  // "🌲"
+    0x00200002,  // "🌳"
+    0x00200003,  // "🌴"
+    0x00200002,  // "🌷"
+    0x00200001,  // "🌸"
+    0x00200007,  // "🌹"
+    0x00200002,  // "🌺"
+    0x00200001,  // "🌻"
+    0x00200005,  // "🌼"
+    0x00200002,  // "🌽"
+    0x00200003,  // "🌾"
+    0x00200002,  // "🌿"
+    0x00200002,  // "🍀"
+    0x00200001,  // "🍁"
+    0x00200001,  // "🍂"
+    0x00200002,  // "🍃"
+    0x00200000,  // "🍄"
+    0x00200001,  // "🍅"
+    0x00200002,  // "🍆"
+    0x00200002,  // "🍇"
+    0x00200003,  // "🍈"
+    0x00200000,  // "🍊"
+    0x00200000,  // "🍋"
+    0x00200001,  // "🍌"
+    0x00200003,  // "🍍"
+    0x00200003,  // "🍎"
+    0x00200003,  // "🍏"
+    0x00200002,  // "🍐"
+    0x00200001,  // "🍑"
+    0x00200003,  // "🍒"
+    0x00200003,  // "🍓"
+    0x00200001,  // "🍔"
+    0x00200004,  // "🍕"
+    0x00200002,  // "🍗"
+    0x00200001,  // "🍘"
+    0x00200002,  // "🍙"
+    0x00200001,  // "🍚"
+    0x00200000,  // "🍛"
+    0x00200001,  // "🍜"
+    0x00200000,  // "🍝"
+    0x00200000,  // "🍞"
+    0x00200003,  // "🍟"
+    0x00200002,  // "🍠"
+    0x00200003,  // "🍡"
+    0x00200002,  // "🍢"
+    0x00200001,  // "🍣"
+    0x00200003,  // "🍤"
+    0x00200007,  // "🍦"
+    0x00200002,  // "🍧"
+    0x00200003,  // "🍨"
+    0x00200001,  // "🍩"
+    0x00200002,  // "🍪"
+    0x00200001,  // "🍫"
+    0x00200001,  // "🍬"
+    0x00200000,  // "🍭"
+    0x00200001,  // "🍮"
+    0x00200004,  // "🍯"
+    0x00200000,  // "🍰"
+    0x00200001,  // "🍱"
+    0x00200001,  // "🍲"
+    0x00200000,  // "🍳"
+    0x00200006,  // "🍴"
+    0x00200001,  // "🍵"
+    0x00200002,  // "🍶"
+    0x00200005,  // "🍷"
+    0x00200004,  // "🍸"
+    0x00200005,  // "🍹"
+    0x00200002,  // "🍺"
+    0x00200000,  // "🍻"
+    0x00200000,  // "🍽"
+    0x00200001,  // "🍾"
+    0x00200002,  // "🍿"
+    0x00200002,  // "🎀"
+    0x00200001,  // "🎁"
+    0x00200001,  // "🎂"
+    0x00200000,  // "🎃"
+    0x00200004,  // "🎄"
+    0x00200000,  // "🎅🏽" 1F385-1F3FD
+    0x00200000,  // "🎅🏾" 1F385-1F3FE
+    0x00200000,  // "🎅"
+    0x00200000,  // "🎆"
+    0x00200001,  // "🎇"
+    0x00200009,  // "🎈"
+    0x00200000,  // "🎉"
+    0x00200002,  // "🎋"
+    0x00200001,  // "🎌"
+    0x00200002,  // "🎍"
+    0x00200001,  // "🎎"
+    0x00200001,  // "🎏"
+    0x00200005,  // "🎐"
+    0x00200000,  // "🎑"
+    0x00200000,  // "🎒"
+    0x00200001,  // "🎓"
+    0x00200008,  // "🎖"
+    0x00200008,  // "🎗"
+    0x00200004,  // "🎙"
+    0x00200004,  // "🎚"
+    0x00200000,  // "🎛"
+    0x00200001,  // "🎞"
+    0x00200001,  // "🎟"
+    0x00200000,  // "🎠"
+    0x00200001,  // "🎡"
+    0x00200000,  // "🎢"
+    0x00200001,  // "🎣"
+    0x00200001,  // "🎤"
+    0x00200001,  // "🎥"
+    0x00200001,  // "🎦"
+    0x00200000,  // "🎧"
+    0x00200000,  // "🎨"
+    0x00200001,  // "🎩"
+    0x00200003,  // "🎪"
+    0x00200001,  // "🎫"
+    0x00200001,  // "🎬"
+    0x00200000,  // "🎭"
+    0x00200000,  // "🎮"
+    0x00200001,  // "🎯"
+    0x00200000,  // "🎰"
+    0x00200000,  // "🎱"
+    0x00200004,  // "🎲"
+    0x00200000,  // "🎳"
+    0x00200004,  // "🎴"
+    0x00200001,  // "🎵"
+    0x00200001,  // "🎶"
+    0x00200000,  // "🎷"
+    0x00200000,  // "🎸"
+    0x00200000,  // "🎹"
+    0x00200002,  // "🎺"
+    0x00200001,  // "🎻"
+    0x00200001,  // "🎼"
+    0x00200002,  // "🎽"
+    0x00200001,  // "🎾"
+    0x00200001,  // "🎿"
+    0x00200000,  // "🏀"
+    0x00200003,  // "🏁"
+    0x00200000,  // "🏂🏼" 1F3C2-1F3FC
+    0x00200001,  // "🏂🏽" 1F3C2-1F3FD
+    0x00200000,  // "🏂"
+    0x00200006,  // "🏃🏻\u200d♀️" 1F3C3-1F3FB-200D-2640-FE0F
+    0x00200008,  // "🏃🏻\u200d♂️" 1F3C3-1F3FB-200D-2642-FE0F
+    0x00200006,  // "🏃🏻" 1F3C3-1F3FB
+    0x00200006,  // "🏃🏼\u200d♀️" 1F3C3-1F3FC-200D-2640-FE0F
+    0x00200007,  // "🏃🏼\u200d♂️" 1F3C3-1F3FC-200D-2642-FE0F
+    0x00200006,  // "🏃🏼" 1F3C3-1F3FC
+    0x00200006,  // "🏃🏽\u200d♀️" 1F3C3-1F3FD-200D-2640-FE0F
+    0x00200007,  // "🏃🏽\u200d♂️" 1F3C3-1F3FD-200D-2642-FE0F
+    0x00200006,  // "🏃🏽" 1F3C3-1F3FD
+    0x00200006,  // "🏃🏾\u200d♀️" 1F3C3-1F3FE-200D-2640-FE0F
+    0x00200005,  // "🏃🏾\u200d♂️" 1F3C3-1F3FE-200D-2642-FE0F
+    0x00200006,  // "🏃🏾" 1F3C3-1F3FE
+    0x00200006,  // "🏃🏿\u200d♀️" 1F3C3-1F3FF-200D-2640-FE0F
+    0x00200005,  // "🏃🏿\u200d♂️" 1F3C3-1F3FF-200D-2642-FE0F
+    0x00200007,  // "🏃🏿" 1F3C3-1F3FF
+    0x00200006,  // "🏃\u200d♀️" 1F3C3-200D-2640-FE0F
+    0x00200007,  // "🏃\u200d♂️" 1F3C3-200D-2642-FE0F
+    0x00200007,  // "🏃"
+    0x00200000,  // "🏄🏻\u200d♀️" 1F3C4-1F3FB-200D-2640-FE0F
+    0x00200000,  // "🏄🏻\u200d♂️" 1F3C4-1F3FB-200D-2642-FE0F
+    0x00200001,  // "🏄🏻" 1F3C4-1F3FB
+    0x00200000,  // "🏄🏼\u200d♀️" 1F3C4-1F3FC-200D-2640-FE0F
+    0x00200000,  // "🏄🏼\u200d♂️" 1F3C4-1F3FC-200D-2642-FE0F
+    0x00200001,  // "🏄🏼" 1F3C4-1F3FC
+    0x00200000,  // "🏄🏽\u200d♀️" 1F3C4-1F3FD-200D-2640-FE0F
+    0x00200000,  // "🏄🏽\u200d♂️" 1F3C4-1F3FD-200D-2642-FE0F
+    0x00200001,  // "🏄🏽" 1F3C4-1F3FD
+    0x00200000,  // "🏄🏾\u200d♀️" 1F3C4-1F3FE-200D-2640-FE0F
+    0x00200000,  // "🏄🏾\u200d♂️" 1F3C4-1F3FE-200D-2642-FE0F
+    0x00200001,  // "🏄🏾" 1F3C4-1F3FE
+    0x00200001,  // "🏄🏿\u200d♀️" 1F3C4-1F3FF-200D-2640-FE0F
+    0x00200000,  // "🏄🏿\u200d♂️" 1F3C4-1F3FF-200D-2642-FE0F
+    0x00200000,  // "🏄🏿" 1F3C4-1F3FF
+    0x00200001,  // "🏄\u200d♀️" 1F3C4-200D-2640-FE0F
+    0x00200000,  // "🏄\u200d♂️" 1F3C4-200D-2642-FE0F
+    0x00200000,  // "🏄"
+    0x00200000,  // "🏆"
+    0x00200001,  // "🏇🏻" 1F3C7-1F3FB
+    0x00200001,  // "🏇🏼" 1F3C7-1F3FC
+    0x00200000,  // "🏇🏽" 1F3C7-1F3FD
+    0x00200001,  // "🏇🏾" 1F3C7-1F3FE
+    0x00200001,  // "🏇🏿" 1F3C7-1F3FF
+    0x00200000,  // "🏇"
+    0x00200001,  // "🏈"
+    0x00200001,  // "🏉"
+    0x00200001,  // "🏊🏻\u200d♀️" 1F3CA-1F3FB-200D-2640-FE0F
+    0x00200000,  // "🏊🏻\u200d♂️" 1F3CA-1F3FB-200D-2642-FE0F
+    0x00200000,  // "🏊🏻" 1F3CA-1F3FB
+    0x00200001,  // "🏊🏼\u200d♀️" 1F3CA-1F3FC-200D-2640-FE0F
+    0x00200000,  // "🏊🏼\u200d♂️" 1F3CA-1F3FC-200D-2642-FE0F
+    0x00200000,  // "🏊🏼" 1F3CA-1F3FC
+    0x00200001,  // "🏊🏽\u200d♀️" 1F3CA-1F3FD-200D-2640-FE0F
+    0x00200000,  // "🏊🏽\u200d♂️" 1F3CA-1F3FD-200D-2642-FE0F
+    0x00200000,  // "🏊🏽" 1F3CA-1F3FD
+    0x00200001,  // "🏊🏾\u200d♀️" 1F3CA-1F3FE-200D-2640-FE0F
+    0x00200000,  // "🏊🏾\u200d♂️" 1F3CA-1F3FE-200D-2642-FE0F
+    0x00200000,  // "🏊🏾" 1F3CA-1F3FE
+    0x00200001,  // "🏊🏿\u200d♀️" 1F3CA-1F3FF-200D-2640-FE0F
+    0x00200000,  // "🏊🏿\u200d♂️" 1F3CA-1F3FF-200D-2642-FE0F
+    0x00200000,  // "🏊🏿" 1F3CA-1F3FF
+    0x00200001,  // "🏊\u200d♀️" 1F3CA-200D-2640-FE0F
+    0x00200001,  // "🏊\u200d♂️" 1F3CA-200D-2642-FE0F
+    0x00200000,  // "🏊"
+    0x00200001,  // "🏋🏻\u200d♀️" 1F3CB-1F3FB-200D-2640-FE0F
+    0x00200001,  // "🏋🏻\u200d♂️" 1F3CB-1F3FB-200D-2642-FE0F
+    0x00200001,  // "🏋🏻" 1F3CB-1F3FB
+    0x00200001,  // "🏋🏼\u200d♀️" 1F3CB-1F3FC-200D-2640-FE0F
+    0x00200001,  // "🏋🏼\u200d♂️" 1F3CB-1F3FC-200D-2642-FE0F
+    0x00200001,  // "🏋🏼" 1F3CB-1F3FC
+    0x00200001,  // "🏋🏽\u200d♀️" 1F3CB-1F3FD-200D-2640-FE0F
+    0x00200001,  // "🏋🏽\u200d♂️" 1F3CB-1F3FD-200D-2642-FE0F
+    0x00200001,  // "🏋🏽" 1F3CB-1F3FD
+    0x00200001,  // "🏋🏾\u200d♀️" 1F3CB-1F3FE-200D-2640-FE0F
+    0x00200001,  // "🏋🏾\u200d♂️" 1F3CB-1F3FE-200D-2642-FE0F
+    0x00200001,  // "🏋🏾" 1F3CB-1F3FE
+    0x00200001,  // "🏋🏿\u200d♀️" 1F3CB-1F3FF-200D-2640-FE0F
+    0x00200001,  // "🏋🏿\u200d♂️" 1F3CB-1F3FF-200D-2642-FE0F
+    0x00200001,  // "🏋🏿" 1F3CB-1F3FF
+    0x00200001,  // "🏋️\u200d♀️" 1F3CB-FE0F-200D-2640-FE0F
+    0x00200001,  // "🏋️\u200d♂️" 1F3CB-FE0F-200D-2642-FE0F
+    0x00200001,  // "🏋"
+    0x00200009,  // "🏌🏻\u200d♀️" 1F3CC-1F3FB-200D-2640-FE0F
+    0x0020000A,  // "🏌🏻\u200d♂️" 1F3CC-1F3FB-200D-2642-FE0F
+    0x00200008,  // "🏌🏻" 1F3CC-1F3FB
+    0x00200009,  // "🏌🏼\u200d♀️" 1F3CC-1F3FC-200D-2640-FE0F
+    0x0020000A,  // "🏌🏼\u200d♂️" 1F3CC-1F3FC-200D-2642-FE0F
+    0x00200008,  // "🏌🏼" 1F3CC-1F3FC
+    0x00200009,  // "🏌🏽\u200d♀️" 1F3CC-1F3FD-200D-2640-FE0F
+    0x00200009,  // "🏌🏽\u200d♂️" 1F3CC-1F3FD-200D-2642-FE0F
+    0x00200008,  // "🏌🏽" 1F3CC-1F3FD
+    0x00200009,  // "🏌🏾\u200d♀️" 1F3CC-1F3FE-200D-2640-FE0F
+    0x00200009,  // "🏌🏾\u200d♂️" 1F3CC-1F3FE-200D-2642-FE0F
+    0x00200008,  // "🏌🏾" 1F3CC-1F3FE
+    0x0020000A,  // "🏌🏿\u200d♀️" 1F3CC-1F3FF-200D-2640-FE0F
+    0x00200008,  // "🏌🏿\u200d♂️" 1F3CC-1F3FF-200D-2642-FE0F
+    0x00200009,  // "🏌🏿" 1F3CC-1F3FF
+    0x0020000A,  // "🏌️\u200d♀️" 1F3CC-FE0F-200D-2640-FE0F
+    0x00200008,  // "🏌️\u200d♂️" 1F3CC-FE0F-200D-2642-FE0F
+    0x00200009,  // "🏌"
+    0x00200001,  // "🏍"
+    0x00200000,  // "🏎"
+    0x00200001,  // "🏏"
+    0x00200002,  // "🏑"
+    0x00200001,  // "🏒"
+    0x00200000,  // "🏓"
+    0x00200000,  // "🏔"
+    0x00200000,  // "🏕"
+    0x00200001,  // "🏖"
+    0x00200000,  // "🏘"
+    0x00200000,  // "🏙"
+    0x00200004,  // "🏚"
+    0x00200002,  // "🏛"
+    0x00200001,  // "🏜"
+    0x00200001,  // "🏝"
+    0x00200000,  // "🏞"
+    0x00200001,  // "🏟"
+    0x00200002,  // "🏠"
+    0x00200000,  // "🏡"
+    0x00200001,  // "🏢"
+    0x00200001,  // "🏣"
+    0x00200001,  // "🏤"
+    0x00200001,  // "🏥"
+    0x00200001,  // "🏧"
+    0x00200000,  // "🏨"
+    0x00200000,  // "🏩"
+    0x00200001,  // "🏪"
+    0x00200000,  // "🏫"
+    0x00200001,  // "🏬"
+    0x00200001,  // "🏭"
+    0x00200001,  // "🏮"
+    0x00200001,  // "🏯"
+    0x00200000,  // "🏳️\u200d🌈" 1F3F3-FE0F-200D-1F308
+    0x00200000,  // "🏳️\u200d⚧️" 1F3F3-FE0F-200D-26A7-FE0F
+    0x00200004,  // "🏳"
+    0x00200000,  // "🏴\u200d☠️" 1F3F4-200D-2620-FE0F
+    0x00200000,  // "🏴\U000e0067\U000e0062\U000e0065\U000e006e\U000e0067\U000e007f" 1F3F4-E0067-E0062-E0065-E006E-E0067-E007F
+    0x00200001,  // "🏴\U000e0067\U000e0062\U000e0073\U000e0063\U000e0074\U000e007f" 1F3F4-E0067-E0062-E0073-E0063-E0074-E007F
+    0x00200001,  // "🏴\U000e0067\U000e0062\U000e0077\U000e006c\U000e0073\U000e007f" 1F3F4-E0067-E0062-E0077-E006C-E0073-E007F
+    0x00200003,  // "🏴"
+    0x00200001,  // "🏵"
+    0x00200002,  // "🏷"
+    0x00200000,  // "🏸"
+    0x00200001,  // "🏹"
+    0x00200005,  // "🏺"
+    0x00200003,  // "🏻"
+    0x00200003,  // "🏼"
+    0x00200004,  // "🏽"
+    0x00200003,  // "🏾"
+    0x00200003,  // "🏿"
+    0x00200001,  // "🐀"
+    0x00200003,  // "🐁"
+    0x00200001,  // "🐂"
+    0x00200001,  // "🐃"
+    0x00200000,  // "🐄"
+    0x00200000,  // "🐅"
+    0x00200000,  // "🐆"
+    0x00200002,  // "🐈\u200d⬛" 1F408-200D-2B1B
+    0x00200003,  // "🐈"
+    0x00200000,  // "🐊"
+    0x00200000,  // "🐋"
+    0x00200000,  // "🐍"
+    0x00200000,  // "🐎"
+    0x00200000,  // "🐐"
+    0x00200001,  // "🐑"
+    0x00200001,  // "🐒"
+    0x00200002,  // "🐓"
+    0x00200008,  // "🐔"
+    0x00200000,  // "🐕\u200d🦺" 1F415-200D-1F9BA
+    0x00200001,  // "🐕"
+    0x00200000,  // "🐖"
+    0x00200001,  // "🐗"
+    0x00200001,  // "🐘"
+    0x00200000,  // "🐙"
+    0x00200003,  // "🐚"
+    0x00200001,  // "🐛"
+    0x00200000,  // "🐜"
+    0x00200001,  // "🐝"
+    0x00200001,  // "🐞"
+    0x00200000,  // "🐟"
+    0x00200001,  // "🐠"
+    0x00200001,  // "🐢"
+    0x00200003,  // "🐣"
+    0x00200003,  // "🐤"
+    0x00200002,  // "🐥"
+    0x00200002,  // "🐦"
+    0x00200001,  // "🐨"
+    0x00200001,  // "🐩"
+    0x00200001,  // "🐪"
+    0x00200002,  // "🐫"
+    0x00200002,  // "🐬"
+    0x00200000,  // "🐭"
+    0x00200001,  // "🐮"
+    0x00200002,  // "🐯"
+    0x00200003,  // "🐰"
+    0x00200002,  // "🐱"
+    0x00200001,  // "🐲"
+    0x00200000,  // "🐳"
+    0x00200001,  // "🐴"
+    0x00200000,  // "🐶"
+    0x00200000,  // "🐷"
+    0x00200001,  // "🐸"
+    0x00200000,  // "🐹"
+    0x00200001,  // "🐻"
+    0x00200001,  // "🐼"
+    0x00200002,  // "🐾"
+    0x00200001,  // "🐿"
+    0x00200000,  // "👁\u200d🗨" 1F441-200D-1F5E8
+    0x00200002,  // "👁"
+    0x00200006,  // "👂🏻" 1F442-1F3FB
+    0x00200005,  // "👂🏼" 1F442-1F3FC
+    0x00200005,  // "👂🏽" 1F442-1F3FD
+    0x00200005,  // "👂🏾" 1F442-1F3FE
+    0x00200005,  // "👂🏿" 1F442-1F3FF
+    0x00200005,  // "👂"
+    0x00200004,  // "👃🏻" 1F443-1F3FB
+    0x00200004,  // "👃🏼" 1F443-1F3FC
+    0x00200003,  // "👃🏽" 1F443-1F3FD
+    0x00200003,  // "👃🏾" 1F443-1F3FE
+    0x00200003,  // "👃🏿" 1F443-1F3FF
+    0x00200003,  // "👃"
+    0x00200000,  // "👄"
+    0x00200001,  // "👅"
+    0x00200004,  // "👆🏻" 1F446-1F3FB
+    0x00200003,  // "👆🏼" 1F446-1F3FC
+    0x00200003,  // "👆🏽" 1F446-1F3FD
+    0x00200003,  // "👆🏾" 1F446-1F3FE
+    0x00200003,  // "👆🏿" 1F446-1F3FF
+    0x00200003,  // "👆"
+    0x00200004,  // "👇🏻" 1F447-1F3FB
+    0x00200005,  // "👇🏼" 1F447-1F3FC
+    0x00200004,  // "👇🏽" 1F447-1F3FD
+    0x00200004,  // "👇🏾" 1F447-1F3FE
+    0x00200003,  // "👇🏿" 1F447-1F3FF
+    0x00200004,  // "👇"
+    0x00200000,  // "👈🏼" 1F448-1F3FC
+    0x00200000,  // "👈🏽" 1F448-1F3FD
+    0x00200001,  // "👈🏾" 1F448-1F3FE
+    0x00200000,  // "👈🏿" 1F448-1F3FF
+    0x00200000,  // "👈"
+    0x00200001,  // "👉🏻" 1F449-1F3FB
+    0x00200000,  // "👉🏽" 1F449-1F3FD
+    0x00200000,  // "👉🏾" 1F449-1F3FE
+    0x00200001,  // "👉🏿" 1F449-1F3FF
+    0x00200000,  // "👉"
+    0x00200001,  // "👊🏻" 1F44A-1F3FB
+    0x00200002,  // "👊🏽" 1F44A-1F3FD
+    0x00200001,  // "👊🏾" 1F44A-1F3FE
+    0x00200002,  // "👊"
+    0x00200000,  // "👋🏻" 1F44B-1F3FB
+    0x00200001,  // "👋🏼" 1F44B-1F3FC
+    0x00200001,  // "👋🏽" 1F44B-1F3FD
+    0x00200000,  // "👋🏾" 1F44B-1F3FE
+    0x00200001,  // "👋🏿" 1F44B-1F3FF
+    0x00200001,  // "👋"
+    0x00200005,  // "👌🏻" 1F44C-1F3FB
+    0x00200004,  // "👌🏼" 1F44C-1F3FC
+    0x00200004,  // "👌🏽" 1F44C-1F3FD
+    0x00200004,  // "👌🏾" 1F44C-1F3FE
+    0x00200004,  // "👌🏿" 1F44C-1F3FF
+    0x00200004,  // "👌"
+    0x00200003,  // "👍🏻" 1F44D-1F3FB
+    0x00200003,  // "👍🏼" 1F44D-1F3FC
+    0x00200004,  // "👍🏽" 1F44D-1F3FD
+    0x00200003,  // "👍🏾" 1F44D-1F3FE
+    0x00200003,  // "👍🏿" 1F44D-1F3FF
+    0x00200004,  // "👍"
+    0x00200004,  // "👎🏻" 1F44E-1F3FB
+    0x00200003,  // "👎🏼" 1F44E-1F3FC
+    0x00200003,  // "👎🏽" 1F44E-1F3FD
+    0x00200003,  // "👎🏾" 1F44E-1F3FE
+    0x00200003,  // "👎🏿" 1F44E-1F3FF
+    0x00200003,  // "👎"
+    0x00200001,  // "👏🏻" 1F44F-1F3FB
+    0x00200000,  // "👏🏼" 1F44F-1F3FC
+    0x00200000,  // "👏🏽" 1F44F-1F3FD
+    0x00200001,  // "👏🏾" 1F44F-1F3FE
+    0x00200000,  // "👏🏿" 1F44F-1F3FF
+    0x00200000,  // "👏"
+    0x00200002,  // "👐🏼" 1F450-1F3FC
+    0x00200000,  // "👐🏽" 1F450-1F3FD
+    0x00200001,  // "👐🏾" 1F450-1F3FE
+    0x00200000,  // "👐🏿" 1F450-1F3FF
+    0x00200000,  // "👐"
+    0x00200001,  // "👑"
+    0x00200000,  // "👓"
+    0x00200000,  // "👔"
+    0x00200001,  // "👕"
+    0x00200003,  // "👖"
+    0x00200002,  // "👘"
+    0x00200005,  // "👙"
+    0x00200001,  // "👚"
+    0x00200001,  // "👛"
+    0x00200000,  // "👜"
+    0x00200000,  // "👝"
+    0x00200001,  // "👞"
+    0x00200001,  // "👟"
+    0x00200000,  // "👠"
+    0x00200000,  // "👡"
+    0x00200001,  // "👢"
+    0x00200002,  // "👣"
+    0x00200001,  // "👤"
+    0x00200002,  // "👦🏻" 1F466-1F3FB
+    0x00200001,  // "👦🏼" 1F466-1F3FC
+    0x00200002,  // "👦🏾" 1F466-1F3FE
+    0x00200001,  // "👦🏿" 1F466-1F3FF
+    0x00200002,  // "👦"
+    0x00200002,  // "👧🏻" 1F467-1F3FB
+    0x00200003,  // "👧🏼" 1F467-1F3FC
+    0x00200002,  // "👧🏽" 1F467-1F3FD
+    0x00200002,  // "👧🏾" 1F467-1F3FE
+    0x00200002,  // "👧🏿" 1F467-1F3FF
+    0x00200003,  // "👧"
+    0x00200001,  // "👨🏻\u200d🌾" 1F468-1F3FB-200D-1F33E
+    0x00200001,  // "👨🏻\u200d🍳" 1F468-1F3FB-200D-1F373
+    0x00200006,  // "👨🏻\u200d🍼" 1F468-1F3FB-200D-1F37C
+    0x00200003,  // "👨🏻\u200d🎓" 1F468-1F3FB-200D-1F393
+    0x00200000,  // "👨🏻\u200d🎤" 1F468-1F3FB-200D-1F3A4
+    0x00200002,  // "👨🏻\u200d🎨" 1F468-1F3FB-200D-1F3A8
+    0x00200000,  // "👨🏻\u200d🏫" 1F468-1F3FB-200D-1F3EB
+    0x00200000,  // "👨🏻\u200d🏭" 1F468-1F3FB-200D-1F3ED
+    0x00200000,  // "👨🏻\u200d💼" 1F468-1F3FB-200D-1F4BC
+    0x00200000,  // "👨🏻\u200d🔧" 1F468-1F3FB-200D-1F527
+    0x00200002,  // "👨🏻\u200d🚀" 1F468-1F3FB-200D-1F680
+    0x00200004,  // "👨🏻\u200d🚒" 1F468-1F3FB-200D-1F692
+    0x00200003,  // "👨🏻\u200d🤝\u200d👨🏼" 1F468-1F3FB-200D-1F91D-200D-1F468-1F3FC
+    0x00200002,  // "👨🏻\u200d🤝\u200d👨🏽" 1F468-1F3FB-200D-1F91D-200D-1F468-1F3FD
+    0x00200003,  // "👨🏻\u200d🤝\u200d👨🏾" 1F468-1F3FB-200D-1F91D-200D-1F468-1F3FE
+    0x00200003,  // "👨🏻\u200d🤝\u200d👨🏿" 1F468-1F3FB-200D-1F91D-200D-1F468-1F3FF
+    0x00200001,  // "👨🏻\u200d🦯" 1F468-1F3FB-200D-1F9AF
+    0x00200005,  // "👨🏻\u200d🦰" 1F468-1F3FB-200D-1F9B0
+    0x00200003,  // "👨🏻\u200d🦱" 1F468-1F3FB-200D-1F9B1
+    0x00200004,  // "👨🏻\u200d🦲" 1F468-1F3FB-200D-1F9B2
+    0x00200005,  // "👨🏻\u200d🦳" 1F468-1F3FB-200D-1F9B3
+    0x00200003,  // "👨🏻\u200d🦼" 1F468-1F3FB-200D-1F9BC
+    0x00200002,  // "👨🏻\u200d🦽" 1F468-1F3FB-200D-1F9BD
+    0x00200003,  // "👨🏻\u200d⚕️" 1F468-1F3FB-200D-2695-FE0F
+    0x00200001,  // "👨🏻\u200d⚖️" 1F468-1F3FB-200D-2696-FE0F
+    0x00200004,  // "👨🏻\u200d✈️" 1F468-1F3FB-200D-2708-FE0F
+    0x00200004,  // "👨🏻" 1F468-1F3FB
+    0x00200000,  // "👨🏼\u200d🌾" 1F468-1F3FC-200D-1F33E
+    0x00200001,  // "👨🏼\u200d🍳" 1F468-1F3FC-200D-1F373
+    0x00200006,  // "👨🏼\u200d🍼" 1F468-1F3FC-200D-1F37C
+    0x00200000,  // "👨🏼\u200d🎄" 1F468-1F3FC-200D-1F384
+    0x00200004,  // "👨🏼\u200d🎓" 1F468-1F3FC-200D-1F393
+    0x00200001,  // "👨🏼\u200d🎤" 1F468-1F3FC-200D-1F3A4
+    0x00200002,  // "👨🏼\u200d🎨" 1F468-1F3FC-200D-1F3A8
+    0x00200000,  // "👨🏼\u200d🏫" 1F468-1F3FC-200D-1F3EB
+    0x00200001,  // "👨🏼\u200d🏭" 1F468-1F3FC-200D-1F3ED
+    0x00200000,  // "👨🏼\u200d💻" 1F468-1F3FC-200D-1F4BB
+    0x00200001,  // "👨🏼\u200d💼" 1F468-1F3FC-200D-1F4BC
+    0x00200000,  // "👨🏼\u200d🔬" 1F468-1F3FC-200D-1F52C
+    0x00200001,  // "👨🏼\u200d🚀" 1F468-1F3FC-200D-1F680
+    0x00200004,  // "👨🏼\u200d🚒" 1F468-1F3FC-200D-1F692
+    0x00200002,  // "👨🏼\u200d🤝\u200d👨🏻" 1F468-1F3FC-200D-1F91D-200D-1F468-1F3FB
+    0x00200003,  // "👨🏼\u200d🤝\u200d👨🏽" 1F468-1F3FC-200D-1F91D-200D-1F468-1F3FD
+    0x00200003,  // "👨🏼\u200d🤝\u200d👨🏾" 1F468-1F3FC-200D-1F91D-200D-1F468-1F3FE
+    0x00200002,  // "👨🏼\u200d🤝\u200d👨🏿" 1F468-1F3FC-200D-1F91D-200D-1F468-1F3FF
+    0x00200001,  // "👨🏼\u200d🦯" 1F468-1F3FC-200D-1F9AF
+    0x00200004,  // "👨🏼\u200d🦰" 1F468-1F3FC-200D-1F9B0
+    0x00200004,  // "👨🏼\u200d🦱" 1F468-1F3FC-200D-1F9B1
+    0x00200005,  // "👨🏼\u200d🦲" 1F468-1F3FC-200D-1F9B2
+    0x00200004,  // "👨🏼\u200d🦳" 1F468-1F3FC-200D-1F9B3
+    0x00200002,  // "👨🏼\u200d🦼" 1F468-1F3FC-200D-1F9BC
+    0x00200002,  // "👨🏼\u200d🦽" 1F468-1F3FC-200D-1F9BD
+    0x00200004,  // "👨🏼\u200d⚕️" 1F468-1F3FC-200D-2695-FE0F
+    0x00200001,  // "👨🏼\u200d⚖️" 1F468-1F3FC-200D-2696-FE0F
+    0x00200005,  // "👨🏼\u200d✈️" 1F468-1F3FC-200D-2708-FE0F
+    0x00200003,  // "👨🏼" 1F468-1F3FC
+    0x00200001,  // "👨🏽\u200d🌾" 1F468-1F3FD-200D-1F33E
+    0x00200006,  // "👨🏽\u200d🍼" 1F468-1F3FD-200D-1F37C
+    0x00200000,  // "👨🏽\u200d🎄" 1F468-1F3FD-200D-1F384
+    0x00200004,  // "👨🏽\u200d🎓" 1F468-1F3FD-200D-1F393
+    0x00200000,  // "👨🏽\u200d🎤" 1F468-1F3FD-200D-1F3A4
+    0x00200001,  // "👨🏽\u200d🎨" 1F468-1F3FD-200D-1F3A8
+    0x00200000,  // "👨🏽\u200d🏭" 1F468-1F3FD-200D-1F3ED
+    0x00200001,  // "👨🏽\u200d💻" 1F468-1F3FD-200D-1F4BB
+    0x00200001,  // "👨🏽\u200d💼" 1F468-1F3FD-200D-1F4BC
+    0x00200000,  // "👨🏽\u200d🔧" 1F468-1F3FD-200D-1F527
+    0x00200001,  // "👨🏽\u200d🔬" 1F468-1F3FD-200D-1F52C
+    0x00200002,  // "👨🏽\u200d🚀" 1F468-1F3FD-200D-1F680
+    0x00200004,  // "👨🏽\u200d🚒" 1F468-1F3FD-200D-1F692
+    0x00200003,  // "👨🏽\u200d🤝\u200d👨🏻" 1F468-1F3FD-200D-1F91D-200D-1F468-1F3FB
+    0x00200002,  // "👨🏽\u200d🤝\u200d👨🏼" 1F468-1F3FD-200D-1F91D-200D-1F468-1F3FC
+    0x00200002,  // "👨🏽\u200d🤝\u200d👨🏾" 1F468-1F3FD-200D-1F91D-200D-1F468-1F3FE
+    0x00200002,  // "👨🏽\u200d🤝\u200d👨🏿" 1F468-1F3FD-200D-1F91D-200D-1F468-1F3FF
+    0x00200001,  // "👨🏽\u200d🦯" 1F468-1F3FD-200D-1F9AF
+    0x00200004,  // "👨🏽\u200d🦰" 1F468-1F3FD-200D-1F9B0
+    0x00200003,  // "👨🏽\u200d🦱" 1F468-1F3FD-200D-1F9B1
+    0x00200004,  // "👨🏽\u200d🦲" 1F468-1F3FD-200D-1F9B2
+    0x00200004,  // "👨🏽\u200d🦳" 1F468-1F3FD-200D-1F9B3
+    0x00200003,  // "👨🏽\u200d🦼" 1F468-1F3FD-200D-1F9BC
+    0x00200003,  // "👨🏽\u200d🦽" 1F468-1F3FD-200D-1F9BD
+    0x00200004,  // "👨🏽\u200d⚕️" 1F468-1F3FD-200D-2695-FE0F
+    0x00200000,  // "👨🏽\u200d⚖️" 1F468-1F3FD-200D-2696-FE0F
+    0x00200004,  // "👨🏽\u200d✈️" 1F468-1F3FD-200D-2708-FE0F
+    0x00200004,  // "👨🏽" 1F468-1F3FD
+    0x00200000,  // "👨🏾\u200d🌾" 1F468-1F3FE-200D-1F33E
+    0x00200001,  // "👨🏾\u200d🍳" 1F468-1F3FE-200D-1F373
+    0x00200006,  // "👨🏾\u200d🍼" 1F468-1F3FE-200D-1F37C
+    0x00200003,  // "👨🏾\u200d🎓" 1F468-1F3FE-200D-1F393
+    0x00200000,  // "👨🏾\u200d🎤" 1F468-1F3FE-200D-1F3A4
+    0x00200001,  // "👨🏾\u200d🎨" 1F468-1F3FE-200D-1F3A8
+    0x00200000,  // "👨🏾\u200d🏫" 1F468-1F3FE-200D-1F3EB
+    0x00200000,  // "👨🏾\u200d🏭" 1F468-1F3FE-200D-1F3ED
+    0x00200000,  // "👨🏾\u200d💼" 1F468-1F3FE-200D-1F4BC
+    0x00200000,  // "👨🏾\u200d🔧" 1F468-1F3FE-200D-1F527
+    0x00200002,  // "👨🏾\u200d🚀" 1F468-1F3FE-200D-1F680
+    0x00200004,  // "👨🏾\u200d🚒" 1F468-1F3FE-200D-1F692
+    0x00200002,  // "👨🏾\u200d🤝\u200d👨🏻" 1F468-1F3FE-200D-1F91D-200D-1F468-1F3FB
+    0x00200002,  // "👨🏾\u200d🤝\u200d👨🏼" 1F468-1F3FE-200D-1F91D-200D-1F468-1F3FC
+    0x00200003,  // "👨🏾\u200d🤝\u200d👨🏽" 1F468-1F3FE-200D-1F91D-200D-1F468-1F3FD
+    0x00200002,  // "👨🏾\u200d🤝\u200d👨🏿" 1F468-1F3FE-200D-1F91D-200D-1F468-1F3FF
+    0x00200001,  // "👨🏾\u200d🦯" 1F468-1F3FE-200D-1F9AF
+    0x00200004,  // "👨🏾\u200d🦰" 1F468-1F3FE-200D-1F9B0
+    0x00200003,  // "👨🏾\u200d🦱" 1F468-1F3FE-200D-1F9B1
+    0x00200004,  // "👨🏾\u200d🦲" 1F468-1F3FE-200D-1F9B2
+    0x00200004,  // "👨🏾\u200d🦳" 1F468-1F3FE-200D-1F9B3
+    0x00200003,  // "👨🏾\u200d🦼" 1F468-1F3FE-200D-1F9BC
+    0x00200002,  // "👨🏾\u200d🦽" 1F468-1F3FE-200D-1F9BD
+    0x00200003,  // "👨🏾\u200d⚕️" 1F468-1F3FE-200D-2695-FE0F
+    0x00200001,  // "👨🏾\u200d⚖️" 1F468-1F3FE-200D-2696-FE0F
+    0x00200004,  // "👨🏾\u200d✈️" 1F468-1F3FE-200D-2708-FE0F
+    0x00200003,  // "👨🏾" 1F468-1F3FE
+    0x00200000,  // "👨🏿\u200d🌾" 1F468-1F3FF-200D-1F33E
+    0x00200006,  // "👨🏿\u200d🍼" 1F468-1F3FF-200D-1F37C
+    0x00200000,  // "👨🏿\u200d🎄" 1F468-1F3FF-200D-1F384
+    0x00200004,  // "👨🏿\u200d🎓" 1F468-1F3FF-200D-1F393
+    0x00200001,  // "👨🏿\u200d🎤" 1F468-1F3FF-200D-1F3A4
+    0x00200001,  // "👨🏿\u200d🎨" 1F468-1F3FF-200D-1F3A8
+    0x00200000,  // "👨🏿\u200d🏫" 1F468-1F3FF-200D-1F3EB
+    0x00200001,  // "👨🏿\u200d🏭" 1F468-1F3FF-200D-1F3ED
+    0x00200000,  // "👨🏿\u200d💻" 1F468-1F3FF-200D-1F4BB
+    0x00200001,  // "👨🏿\u200d💼" 1F468-1F3FF-200D-1F4BC
+    0x00200000,  // "👨🏿\u200d🔬" 1F468-1F3FF-200D-1F52C
+    0x00200001,  // "👨🏿\u200d🚀" 1F468-1F3FF-200D-1F680
+    0x00200004,  // "👨🏿\u200d🚒" 1F468-1F3FF-200D-1F692
+    0x00200002,  // "👨🏿\u200d🤝\u200d👨🏻" 1F468-1F3FF-200D-1F91D-200D-1F468-1F3FB
+    0x00200003,  // "👨🏿\u200d🤝\u200d👨🏼" 1F468-1F3FF-200D-1F91D-200D-1F468-1F3FC
+    0x00200002,  // "👨🏿\u200d🤝\u200d👨🏽" 1F468-1F3FF-200D-1F91D-200D-1F468-1F3FD
+    0x00200002,  // "👨🏿\u200d🤝\u200d👨🏾" 1F468-1F3FF-200D-1F91D-200D-1F468-1F3FE
+    0x00200001,  // "👨🏿\u200d🦯" 1F468-1F3FF-200D-1F9AF
+    0x00200004,  // "👨🏿\u200d🦰" 1F468-1F3FF-200D-1F9B0
+    0x00200003,  // "👨🏿\u200d🦱" 1F468-1F3FF-200D-1F9B1
+    0x00200003,  // "👨🏿\u200d🦲" 1F468-1F3FF-200D-1F9B2
+    0x00200004,  // "👨🏿\u200d🦳" 1F468-1F3FF-200D-1F9B3
+    0x00200002,  // "👨🏿\u200d🦼" 1F468-1F3FF-200D-1F9BC
+    0x00200002,  // "👨🏿\u200d🦽" 1F468-1F3FF-200D-1F9BD
+    0x00200004,  // "👨🏿\u200d⚕️" 1F468-1F3FF-200D-2695-FE0F
+    0x00200001,  // "👨🏿\u200d⚖️" 1F468-1F3FF-200D-2696-FE0F
+    0x00200005,  // "👨🏿\u200d✈️" 1F468-1F3FF-200D-2708-FE0F
+    0x00200003,  // "👨🏿" 1F468-1F3FF
+    0x00200001,  // "👨\u200d🌾" 1F468-200D-1F33E
+    0x00200001,  // "👨\u200d🍳" 1F468-200D-1F373
+    0x00200006,  // "👨\u200d🍼" 1F468-200D-1F37C
+    0x00200003,  // "👨\u200d🎓" 1F468-200D-1F393
+    0x00200000,  // "👨\u200d🎤" 1F468-200D-1F3A4
+    0x00200002,  // "👨\u200d🎨" 1F468-200D-1F3A8
+    0x00200000,  // "👨\u200d🏭" 1F468-200D-1F3ED
+    0x00200001,  // "👨\u200d👦\u200d👦" 1F468-200D-1F466-200D-1F466
+    0x00200000,  // "👨\u200d👧\u200d👦" 1F468-200D-1F467-200D-1F466
+    0x00200001,  // "👨\u200d👧\u200d👧" 1F468-200D-1F467-200D-1F467
+    0x00200001,  // "👨\u200d👧" 1F468-200D-1F467
+    0x00200000,  // "👨\u200d👨\u200d👦\u200d👦" 1F468-200D-1F468-200D-1F466-200D-1F466
+    0x00200000,  // "👨\u200d👨\u200d👦" 1F468-200D-1F468-200D-1F466
+    0x00200001,  // "👨\u200d👨\u200d👧\u200d👦" 1F468-200D-1F468-200D-1F467-200D-1F466
+    0x00200000,  // "👨\u200d👨\u200d👧\u200d👧" 1F468-200D-1F468-200D-1F467-200D-1F467
+    0x00200000,  // "👨\u200d👨\u200d👧" 1F468-200D-1F468-200D-1F467
+    0x00200001,  // "👨\u200d👩\u200d👦\u200d👦" 1F468-200D-1F469-200D-1F466-200D-1F466
+    0x00200000,  // "👨\u200d👩\u200d👦" 1F468-200D-1F469-200D-1F466
+    0x00200000,  // "👨\u200d👩\u200d👧\u200d👦" 1F468-200D-1F469-200D-1F467-200D-1F466
+    0x00200001,  // "👨\u200d👩\u200d👧\u200d👧" 1F468-200D-1F469-200D-1F467-200D-1F467
+    0x00200001,  // "👨\u200d👩\u200d👧" 1F468-200D-1F469-200D-1F467
+    0x00200000,  // "👨\u200d💻" 1F468-200D-1F4BB
+    0x00200001,  // "👨\u200d💼" 1F468-200D-1F4BC
+    0x00200000,  // "👨\u200d🔬" 1F468-200D-1F52C
+    0x00200001,  // "👨\u200d🚀" 1F468-200D-1F680
+    0x00200004,  // "👨\u200d🚒" 1F468-200D-1F692
+    0x00200002,  // "👨\u200d🦯" 1F468-200D-1F9AF
+    0x00200004,  // "👨\u200d🦰" 1F468-200D-1F9B0
+    0x00200004,  // "👨\u200d🦱" 1F468-200D-1F9B1
+    0x00200005,  // "👨\u200d🦲" 1F468-200D-1F9B2
+    0x00200004,  // "👨\u200d🦳" 1F468-200D-1F9B3
+    0x00200002,  // "👨\u200d🦼" 1F468-200D-1F9BC
+    0x00200002,  // "👨\u200d🦽" 1F468-200D-1F9BD
+    0x00200004,  // "👨\u200d⚕️" 1F468-200D-2695-FE0F
+    0x00200001,  // "👨\u200d⚖️" 1F468-200D-2696-FE0F
+    0x00200004,  // "👨\u200d✈️" 1F468-200D-2708-FE0F
+    0x00200000,  // "👨\u200d❤️\u200d👨" 1F468-200D-2764-FE0F-200D-1F468
+    0x00200000,  // "👨\u200d❤️\u200d💋\u200d👨" 1F468-200D-2764-FE0F-200D-1F48B-200D-1F468
+    0x00200004,  // "👨"
+    0x00200000,  // "👩🏻\u200d🌾" 1F469-1F3FB-200D-1F33E
+    0x00200001,  // "👩🏻\u200d🍳" 1F469-1F3FB-200D-1F373
+    0x00200005,  // "👩🏻\u200d🍼" 1F469-1F3FB-200D-1F37C
+    0x00200003,  // "👩🏻\u200d🎄" 1F469-1F3FB-200D-1F384
+    0x00200004,  // "👩🏻\u200d🎓" 1F469-1F3FB-200D-1F393
+    0x00200001,  // "👩🏻\u200d🎤" 1F469-1F3FB-200D-1F3A4
+    0x00200002,  // "👩🏻\u200d🎨" 1F469-1F3FB-200D-1F3A8
+    0x00200000,  // "👩🏻\u200d🏫" 1F469-1F3FB-200D-1F3EB
+    0x00200000,  // "👩🏻\u200d💻" 1F469-1F3FB-200D-1F4BB
+    0x00200001,  // "👩🏻\u200d💼" 1F469-1F3FB-200D-1F4BC
+    0x00200000,  // "👩🏻\u200d🚀" 1F469-1F3FB-200D-1F680
+    0x00200004,  // "👩🏻\u200d🚒" 1F469-1F3FB-200D-1F692
+    0x00200002,  // "👩🏻\u200d🤝\u200d👨🏼" 1F469-1F3FB-200D-1F91D-200D-1F468-1F3FC
+    0x00200003,  // "👩🏻\u200d🤝\u200d👨🏽" 1F469-1F3FB-200D-1F91D-200D-1F468-1F3FD
+    0x00200003,  // "👩🏻\u200d🤝\u200d👨🏾" 1F469-1F3FB-200D-1F91D-200D-1F468-1F3FE
+    0x00200002,  // "👩🏻\u200d🤝\u200d👨🏿" 1F469-1F3FB-200D-1F91D-200D-1F468-1F3FF
+    0x00200003,  // "👩🏻\u200d🤝\u200d👩🏼" 1F469-1F3FB-200D-1F91D-200D-1F469-1F3FC
+    0x00200003,  // "👩🏻\u200d🤝\u200d👩🏽" 1F469-1F3FB-200D-1F91D-200D-1F469-1F3FD
+    0x00200002,  // "👩🏻\u200d🤝\u200d👩🏾" 1F469-1F3FB-200D-1F91D-200D-1F469-1F3FE
+    0x00200003,  // "👩🏻\u200d🤝\u200d👩🏿" 1F469-1F3FB-200D-1F91D-200D-1F469-1F3FF
+    0x00200001,  // "👩🏻\u200d🦯" 1F469-1F3FB-200D-1F9AF
+    0x00200002,  // "👩🏻\u200d🦰" 1F469-1F3FB-200D-1F9B0
+    0x00200000,  // "👩🏻\u200d🦱" 1F469-1F3FB-200D-1F9B1
+    0x00200004,  // "👩🏻\u200d🦲" 1F469-1F3FB-200D-1F9B2
+    0x00200002,  // "👩🏻\u200d🦳" 1F469-1F3FB-200D-1F9B3
+    0x00200002,  // "👩🏻\u200d🦼" 1F469-1F3FB-200D-1F9BC
+    0x00200002,  // "👩🏻\u200d🦽" 1F469-1F3FB-200D-1F9BD
+    0x00200004,  // "👩🏻\u200d⚕️" 1F469-1F3FB-200D-2695-FE0F
+    0x00200001,  // "👩🏻\u200d⚖️" 1F469-1F3FB-200D-2696-FE0F
+    0x00200005,  // "👩🏻\u200d✈️" 1F469-1F3FB-200D-2708-FE0F
+    0x00200002,  // "👩🏻" 1F469-1F3FB
+    0x00200001,  // "👩🏼\u200d🌾" 1F469-1F3FC-200D-1F33E
+    0x00200002,  // "👩🏼\u200d🍳" 1F469-1F3FC-200D-1F373
+    0x00200006,  // "👩🏼\u200d🍼" 1F469-1F3FC-200D-1F37C
+    0x00200004,  // "👩🏼\u200d🎄" 1F469-1F3FC-200D-1F384
+    0x00200005,  // "👩🏼\u200d🎓" 1F469-1F3FC-200D-1F393
+    0x00200000,  // "👩🏼\u200d🎤" 1F469-1F3FC-200D-1F3A4
+    0x00200001,  // "👩🏼\u200d🎨" 1F469-1F3FC-200D-1F3A8
+    0x00200000,  // "👩🏼\u200d🏫" 1F469-1F3FC-200D-1F3EB
+    0x00200000,  // "👩🏼\u200d🏭" 1F469-1F3FC-200D-1F3ED
+    0x00200001,  // "👩🏼\u200d💻" 1F469-1F3FC-200D-1F4BB
+    0x00200001,  // "👩🏼\u200d💼" 1F469-1F3FC-200D-1F4BC
+    0x00200000,  // "👩🏼\u200d🔧" 1F469-1F3FC-200D-1F527
+    0x00200001,  // "👩🏼\u200d🔬" 1F469-1F3FC-200D-1F52C
+    0x00200003,  // "👩🏼\u200d🚒" 1F469-1F3FC-200D-1F692
+    0x00200003,  // "👩🏼\u200d🤝\u200d👨🏻" 1F469-1F3FC-200D-1F91D-200D-1F468-1F3FB
+    0x00200002,  // "👩🏼\u200d🤝\u200d👨🏽" 1F469-1F3FC-200D-1F91D-200D-1F468-1F3FD
+    0x00200003,  // "👩🏼\u200d🤝\u200d👨🏾" 1F469-1F3FC-200D-1F91D-200D-1F468-1F3FE
+    0x00200003,  // "👩🏼\u200d🤝\u200d👨🏿" 1F469-1F3FC-200D-1F91D-200D-1F468-1F3FF
+    0x00200002,  // "👩🏼\u200d🤝\u200d👩🏻" 1F469-1F3FC-200D-1F91D-200D-1F469-1F3FB
+    0x00200003,  // "👩🏼\u200d🤝\u200d👩🏽" 1F469-1F3FC-200D-1F91D-200D-1F469-1F3FD
+    0x00200003,  // "👩🏼\u200d🤝\u200d👩🏾" 1F469-1F3FC-200D-1F91D-200D-1F469-1F3FE
+    0x00200002,  // "👩🏼\u200d🤝\u200d👩🏿" 1F469-1F3FC-200D-1F91D-200D-1F469-1F3FF
+    0x00200001,  // "👩🏼\u200d🦯" 1F469-1F3FC-200D-1F9AF
+    0x00200002,  // "👩🏼\u200d🦰" 1F469-1F3FC-200D-1F9B0
+    0x00200004,  // "👩🏼\u200d🦲" 1F469-1F3FC-200D-1F9B2
+    0x00200003,  // "👩🏼\u200d🦳" 1F469-1F3FC-200D-1F9B3
+    0x00200003,  // "👩🏼\u200d🦼" 1F469-1F3FC-200D-1F9BC
+    0x00200002,  // "👩🏼\u200d🦽" 1F469-1F3FC-200D-1F9BD
+    0x00200005,  // "👩🏼\u200d⚕️" 1F469-1F3FC-200D-2695-FE0F
+    0x00200001,  // "👩🏼\u200d⚖️" 1F469-1F3FC-200D-2696-FE0F
+    0x00200005,  // "👩🏼\u200d✈️" 1F469-1F3FC-200D-2708-FE0F
+    0x00200002,  // "👩🏼" 1F469-1F3FC
+    0x00200000,  // "👩🏽\u200d🌾" 1F469-1F3FD-200D-1F33E
+    0x00200006,  // "👩🏽\u200d🍼" 1F469-1F3FD-200D-1F37C
+    0x00200003,  // "👩🏽\u200d🎄" 1F469-1F3FD-200D-1F384
+    0x00200004,  // "👩🏽\u200d🎓" 1F469-1F3FD-200D-1F393
+    0x00200000,  // "👩🏽\u200d🎤" 1F469-1F3FD-200D-1F3A4
+    0x00200001,  // "👩🏽\u200d🎨" 1F469-1F3FD-200D-1F3A8
+    0x00200001,  // "👩🏽\u200d🏫" 1F469-1F3FD-200D-1F3EB
+    0x00200000,  // "👩🏽\u200d💻" 1F469-1F3FD-200D-1F4BB
+    0x00200001,  // "👩🏽\u200d💼" 1F469-1F3FD-200D-1F4BC
+    0x00200000,  // "👩🏽\u200d🔬" 1F469-1F3FD-200D-1F52C
+    0x00200001,  // "👩🏽\u200d🚀" 1F469-1F3FD-200D-1F680
+    0x00200004,  // "👩🏽\u200d🚒" 1F469-1F3FD-200D-1F692
+    0x00200002,  // "👩🏽\u200d🤝\u200d👨🏻" 1F469-1F3FD-200D-1F91D-200D-1F468-1F3FB
+    0x00200003,  // "👩🏽\u200d🤝\u200d👨🏼" 1F469-1F3FD-200D-1F91D-200D-1F468-1F3FC
+    0x00200002,  // "👩🏽\u200d🤝\u200d👨🏾" 1F469-1F3FD-200D-1F91D-200D-1F468-1F3FE
+    0x00200002,  // "👩🏽\u200d🤝\u200d👨🏿" 1F469-1F3FD-200D-1F91D-200D-1F468-1F3FF
+    0x00200003,  // "👩🏽\u200d🤝\u200d👩🏻" 1F469-1F3FD-200D-1F91D-200D-1F469-1F3FB
+    0x00200002,  // "👩🏽\u200d🤝\u200d👩🏼" 1F469-1F3FD-200D-1F91D-200D-1F469-1F3FC
+    0x00200002,  // "👩🏽\u200d🤝\u200d👩🏾" 1F469-1F3FD-200D-1F91D-200D-1F469-1F3FE
+    0x00200003,  // "👩🏽\u200d🤝\u200d👩🏿" 1F469-1F3FD-200D-1F91D-200D-1F469-1F3FF
+    0x00200001,  // "👩🏽\u200d🦯" 1F469-1F3FD-200D-1F9AF
+    0x00200002,  // "👩🏽\u200d🦰" 1F469-1F3FD-200D-1F9B0
+    0x00200000,  // "👩🏽\u200d🦱" 1F469-1F3FD-200D-1F9B1
+    0x00200004,  // "👩🏽\u200d🦲" 1F469-1F3FD-200D-1F9B2
+    0x00200002,  // "👩🏽\u200d🦳" 1F469-1F3FD-200D-1F9B3
+    0x00200002,  // "👩🏽\u200d🦼" 1F469-1F3FD-200D-1F9BC
+    0x00200003,  // "👩🏽\u200d🦽" 1F469-1F3FD-200D-1F9BD
+    0x00200005,  // "👩🏽\u200d⚕️" 1F469-1F3FD-200D-2695-FE0F
+    0x00200000,  // "👩🏽\u200d⚖️" 1F469-1F3FD-200D-2696-FE0F
+    0x00200004,  // "👩🏽\u200d✈️" 1F469-1F3FD-200D-2708-FE0F
+    0x00200002,  // "👩🏽" 1F469-1F3FD
+    0x00200000,  // "👩🏾\u200d🌾" 1F469-1F3FE-200D-1F33E
+    0x00200001,  // "👩🏾\u200d🍳" 1F469-1F3FE-200D-1F373
+    0x00200005,  // "👩🏾\u200d🍼" 1F469-1F3FE-200D-1F37C
+    0x00200003,  // "👩🏾\u200d🎄" 1F469-1F3FE-200D-1F384
+    0x00200004,  // "👩🏾\u200d🎓" 1F469-1F3FE-200D-1F393
+    0x00200001,  // "👩🏾\u200d🎤" 1F469-1F3FE-200D-1F3A4
+    0x00200001,  // "👩🏾\u200d🎨" 1F469-1F3FE-200D-1F3A8
+    0x00200000,  // "👩🏾\u200d🏫" 1F469-1F3FE-200D-1F3EB
+    0x00200000,  // "👩🏾\u200d🏭" 1F469-1F3FE-200D-1F3ED
+    0x00200001,  // "👩🏾\u200d💻" 1F469-1F3FE-200D-1F4BB
+    0x00200000,  // "👩🏾\u200d💼" 1F469-1F3FE-200D-1F4BC
+    0x00200000,  // "👩🏾\u200d🔧" 1F469-1F3FE-200D-1F527
+    0x00200000,  // "👩🏾\u200d🚀" 1F469-1F3FE-200D-1F680
+    0x00200004,  // "👩🏾\u200d🚒" 1F469-1F3FE-200D-1F692
+    0x00200002,  // "👩🏾\u200d🤝\u200d👨🏻" 1F469-1F3FE-200D-1F91D-200D-1F468-1F3FB
+    0x00200002,  // "👩🏾\u200d🤝\u200d👨🏼" 1F469-1F3FE-200D-1F91D-200D-1F468-1F3FC
+    0x00200003,  // "👩🏾\u200d🤝\u200d👨🏽" 1F469-1F3FE-200D-1F91D-200D-1F468-1F3FD
+    0x00200002,  // "👩🏾\u200d🤝\u200d👨🏿" 1F469-1F3FE-200D-1F91D-200D-1F468-1F3FF
+    0x00200002,  // "👩🏾\u200d🤝\u200d👩🏻" 1F469-1F3FE-200D-1F91D-200D-1F469-1F3FB
+    0x00200003,  // "👩🏾\u200d🤝\u200d👩🏼" 1F469-1F3FE-200D-1F91D-200D-1F469-1F3FC
+    0x00200002,  // "👩🏾\u200d🤝\u200d👩🏽" 1F469-1F3FE-200D-1F91D-200D-1F469-1F3FD
+    0x00200002,  // "👩🏾\u200d🤝\u200d👩🏿" 1F469-1F3FE-200D-1F91D-200D-1F469-1F3FF
+    0x00200002,  // "👩🏾\u200d🦯" 1F469-1F3FE-200D-1F9AF
+    0x00200002,  // "👩🏾\u200d🦰" 1F469-1F3FE-200D-1F9B0
+    0x00200001,  // "👩🏾\u200d🦱" 1F469-1F3FE-200D-1F9B1
+    0x00200004,  // "👩🏾\u200d🦲" 1F469-1F3FE-200D-1F9B2
+    0x00200003,  // "👩🏾\u200d🦳" 1F469-1F3FE-200D-1F9B3
+    0x00200002,  // "👩🏾\u200d🦼" 1F469-1F3FE-200D-1F9BC
+    0x00200002,  // "👩🏾\u200d🦽" 1F469-1F3FE-200D-1F9BD
+    0x00200004,  // "👩🏾\u200d⚕️" 1F469-1F3FE-200D-2695-FE0F
+    0x00200001,  // "👩🏾\u200d⚖️" 1F469-1F3FE-200D-2696-FE0F
+    0x00200005,  // "👩🏾\u200d✈️" 1F469-1F3FE-200D-2708-FE0F
+    0x00200002,  // "👩🏾" 1F469-1F3FE
+    0x00200001,  // "👩🏿\u200d🌾" 1F469-1F3FF-200D-1F33E
+    0x00200002,  // "👩🏿\u200d🍳" 1F469-1F3FF-200D-1F373
+    0x00200006,  // "👩🏿\u200d🍼" 1F469-1F3FF-200D-1F37C
+    0x00200003,  // "👩🏿\u200d🎄" 1F469-1F3FF-200D-1F384
+    0x00200004,  // "👩🏿\u200d🎓" 1F469-1F3FF-200D-1F393
+    0x00200000,  // "👩🏿\u200d🎤" 1F469-1F3FF-200D-1F3A4
+    0x00200001,  // "👩🏿\u200d🎨" 1F469-1F3FF-200D-1F3A8
+    0x00200001,  // "👩🏿\u200d🏫" 1F469-1F3FF-200D-1F3EB
+    0x00200000,  // "👩🏿\u200d🏭" 1F469-1F3FF-200D-1F3ED
+    0x00200001,  // "👩🏿\u200d💻" 1F469-1F3FF-200D-1F4BB
+    0x00200001,  // "👩🏿\u200d💼" 1F469-1F3FF-200D-1F4BC
+    0x00200000,  // "👩🏿\u200d🔧" 1F469-1F3FF-200D-1F527
+    0x00200001,  // "👩🏿\u200d🔬" 1F469-1F3FF-200D-1F52C
+    0x00200003,  // "👩🏿\u200d🚒" 1F469-1F3FF-200D-1F692
+    0x00200003,  // "👩🏿\u200d🤝\u200d👨🏻" 1F469-1F3FF-200D-1F91D-200D-1F468-1F3FB
+    0x00200002,  // "👩🏿\u200d🤝\u200d👨🏼" 1F469-1F3FF-200D-1F91D-200D-1F468-1F3FC
+    0x00200002,  // "👩🏿\u200d🤝\u200d👨🏽" 1F469-1F3FF-200D-1F91D-200D-1F468-1F3FD
+    0x00200003,  // "👩🏿\u200d🤝\u200d👨🏾" 1F469-1F3FF-200D-1F91D-200D-1F468-1F3FE
+    0x00200002,  // "👩🏿\u200d🤝\u200d👩🏻" 1F469-1F3FF-200D-1F91D-200D-1F469-1F3FB
+    0x00200002,  // "👩🏿\u200d🤝\u200d👩🏼" 1F469-1F3FF-200D-1F91D-200D-1F469-1F3FC
+    0x00200002,  // "👩🏿\u200d🤝\u200d👩🏽" 1F469-1F3FF-200D-1F91D-200D-1F469-1F3FD
+    0x00200002,  // "👩🏿\u200d🤝\u200d👩🏾" 1F469-1F3FF-200D-1F91D-200D-1F469-1F3FE
+    0x00200001,  // "👩🏿\u200d🦯" 1F469-1F3FF-200D-1F9AF
+    0x00200002,  // "👩🏿\u200d🦰" 1F469-1F3FF-200D-1F9B0
+    0x00200000,  // "👩🏿\u200d🦱" 1F469-1F3FF-200D-1F9B1
+    0x00200004,  // "👩🏿\u200d🦲" 1F469-1F3FF-200D-1F9B2
+    0x00200003,  // "👩🏿\u200d🦳" 1F469-1F3FF-200D-1F9B3
+    0x00200003,  // "👩🏿\u200d🦼" 1F469-1F3FF-200D-1F9BC
+    0x00200002,  // "👩🏿\u200d🦽" 1F469-1F3FF-200D-1F9BD
+    0x00200005,  // "👩🏿\u200d⚕️" 1F469-1F3FF-200D-2695-FE0F
+    0x00200001,  // "👩🏿\u200d⚖️" 1F469-1F3FF-200D-2696-FE0F
+    0x00200005,  // "👩🏿\u200d✈️" 1F469-1F3FF-200D-2708-FE0F
+    0x00200002,  // "👩🏿" 1F469-1F3FF
+    0x00200000,  // "👩\u200d🌾" 1F469-200D-1F33E
+    0x00200006,  // "👩\u200d🍼" 1F469-200D-1F37C
+    0x00200003,  // "👩\u200d🎄" 1F469-200D-1F384
+    0x00200004,  // "👩\u200d🎓" 1F469-200D-1F393
+    0x00200001,  // "👩\u200d🎤" 1F469-200D-1F3A4
+    0x00200002,  // "👩\u200d🎨" 1F469-200D-1F3A8
+    0x00200000,  // "👩\u200d🏫" 1F469-200D-1F3EB
+    0x00200002,  // "👩\u200d👦\u200d👦" 1F469-200D-1F466-200D-1F466
+    0x00200002,  // "👩\u200d👧\u200d👧" 1F469-200D-1F467-200D-1F467
+    0x00200001,  // "👩\u200d👧" 1F469-200D-1F467
+    0x00200001,  // "👩\u200d👩\u200d👦\u200d👦" 1F469-200D-1F469-200D-1F466-200D-1F466
+    0x00200000,  // "👩\u200d👩\u200d👦" 1F469-200D-1F469-200D-1F466
+    0x00200000,  // "👩\u200d👩\u200d👧\u200d👦" 1F469-200D-1F469-200D-1F467-200D-1F466
+    0x00200001,  // "👩\u200d👩\u200d👧\u200d👧" 1F469-200D-1F469-200D-1F467-200D-1F467
+    0x00200001,  // "👩\u200d👩\u200d👧" 1F469-200D-1F469-200D-1F467
+    0x00200000,  // "👩\u200d💻" 1F469-200D-1F4BB
+    0x00200001,  // "👩\u200d💼" 1F469-200D-1F4BC
+    0x00200000,  // "👩\u200d🔬" 1F469-200D-1F52C
+    0x00200001,  // "👩\u200d🚀" 1F469-200D-1F680
+    0x00200004,  // "👩\u200d🚒" 1F469-200D-1F692
+    0x00200001,  // "👩\u200d🦯" 1F469-200D-1F9AF
+    0x00200002,  // "👩\u200d🦰" 1F469-200D-1F9B0
+    0x00200001,  // "👩\u200d🦱" 1F469-200D-1F9B1
+    0x00200005,  // "👩\u200d🦲" 1F469-200D-1F9B2
+    0x00200003,  // "👩\u200d🦳" 1F469-200D-1F9B3
+    0x00200002,  // "👩\u200d🦼" 1F469-200D-1F9BC
+    0x00200002,  // "👩\u200d🦽" 1F469-200D-1F9BD
+    0x00200004,  // "👩\u200d⚕️" 1F469-200D-2695-FE0F
+    0x00200001,  // "👩\u200d⚖️" 1F469-200D-2696-FE0F
+    0x00200005,  // "👩\u200d✈️" 1F469-200D-2708-FE0F
+    0x00200000,  // "👩\u200d❤️\u200d👨" 1F469-200D-2764-FE0F-200D-1F468
+    0x00200000,  // "👩\u200d❤️\u200d👩" 1F469-200D-2764-FE0F-200D-1F469
+    0x00200001,  // "👩\u200d❤️\u200d💋\u200d👨" 1F469-200D-2764-FE0F-200D-1F48B-200D-1F468
+    0x00200000,  // "👩\u200d❤️\u200d💋\u200d👩" 1F469-200D-2764-FE0F-200D-1F48B-200D-1F469
+    0x00200003,  // "👩"
+    0x00200001,  // "👪"
+    0x00200003,  // "👫🏻" 1F46B-1F3FB
+    0x00200003,  // "👫🏼" 1F46B-1F3FC
+    0x00200002,  // "👫🏽" 1F46B-1F3FD
+    0x00200002,  // "👫🏾" 1F46B-1F3FE
+    0x00200002,  // "👫🏿" 1F46B-1F3FF
+    0x00200002,  // "👫"
+    0x00200003,  // "👬🏻" 1F46C-1F3FB
+    0x00200003,  // "👬🏼" 1F46C-1F3FC
+    0x00200002,  // "👬🏽" 1F46C-1F3FD
+    0x00200002,  // "👬🏾" 1F46C-1F3FE
+    0x00200002,  // "👬🏿" 1F46C-1F3FF
+    0x00200003,  // "👬"
+    0x00200003,  // "👭🏻" 1F46D-1F3FB
+    0x00200003,  // "👭🏼" 1F46D-1F3FC
+    0x00200003,  // "👭🏽" 1F46D-1F3FD
+    0x00200002,  // "👭🏾" 1F46D-1F3FE
+    0x00200002,  // "👭🏿" 1F46D-1F3FF
+    0x00200003,  // "👭"
+    0x00200004,  // "👮🏻\u200d♀️" 1F46E-1F3FB-200D-2640-FE0F
+    0x00200003,  // "👮🏻\u200d♂️" 1F46E-1F3FB-200D-2642-FE0F
+    0x00200004,  // "👮🏻" 1F46E-1F3FB
+    0x00200004,  // "👮🏼\u200d♀️" 1F46E-1F3FC-200D-2640-FE0F
+    0x00200003,  // "👮🏼\u200d♂️" 1F46E-1F3FC-200D-2642-FE0F
+    0x00200004,  // "👮🏼" 1F46E-1F3FC
+    0x00200004,  // "👮🏽\u200d♀️" 1F46E-1F3FD-200D-2640-FE0F
+    0x00200004,  // "👮🏽\u200d♂️" 1F46E-1F3FD-200D-2642-FE0F
+    0x00200003,  // "👮🏽" 1F46E-1F3FD
+    0x00200004,  // "👮🏾\u200d♀️" 1F46E-1F3FE-200D-2640-FE0F
+    0x00200004,  // "👮🏾\u200d♂️" 1F46E-1F3FE-200D-2642-FE0F
+    0x00200003,  // "👮🏾" 1F46E-1F3FE
+    0x00200004,  // "👮🏿\u200d♀️" 1F46E-1F3FF-200D-2640-FE0F
+    0x00200004,  // "👮🏿\u200d♂️" 1F46E-1F3FF-200D-2642-FE0F
+    0x00200003,  // "👮🏿" 1F46E-1F3FF
+    0x00200004,  // "👮\u200d♀️" 1F46E-200D-2640-FE0F
+    0x00200004,  // "👮\u200d♂️" 1F46E-200D-2642-FE0F
+    0x00200003,  // "👮"
+    0x00200001,  // "👯\u200d♀️" 1F46F-200D-2640-FE0F
+    0x00200002,  // "👯"
+    0x00200001,  // "👰🏻\u200d♀️" 1F470-1F3FB-200D-2640-FE0F
+    0x00200002,  // "👰🏼\u200d♀️" 1F470-1F3FC-200D-2640-FE0F
+    0x00200001,  // "👰🏼\u200d♂️" 1F470-1F3FC-200D-2642-FE0F
+    0x00200002,  // "👰🏽\u200d♀️" 1F470-1F3FD-200D-2640-FE0F
+    0x00200001,  // "👰🏽\u200d♂️" 1F470-1F3FD-200D-2642-FE0F
+    0x00200002,  // "👰🏾\u200d♀️" 1F470-1F3FE-200D-2640-FE0F
+    0x00200001,  // "👰🏾\u200d♂️" 1F470-1F3FE-200D-2642-FE0F
+    0x00200002,  // "👰🏿\u200d♀️" 1F470-1F3FF-200D-2640-FE0F
+    0x00200001,  // "👰🏿\u200d♂️" 1F470-1F3FF-200D-2642-FE0F
+    0x00200002,  // "👰\u200d♀️" 1F470-200D-2640-FE0F
+    0x00200001,  // "👰\u200d♂️" 1F470-200D-2642-FE0F
+    0x00200002,  // "👱🏻\u200d♀️" 1F471-1F3FB-200D-2640-FE0F
+    0x00200005,  // "👱🏻\u200d♂️" 1F471-1F3FB-200D-2642-FE0F
+    0x00200003,  // "👱🏻" 1F471-1F3FB
+    0x00200002,  // "👱🏼\u200d♀️" 1F471-1F3FC-200D-2640-FE0F
+    0x00200005,  // "👱🏼\u200d♂️" 1F471-1F3FC-200D-2642-FE0F
+    0x00200003,  // "👱🏼" 1F471-1F3FC
+    0x00200002,  // "👱🏽\u200d♀️" 1F471-1F3FD-200D-2640-FE0F
+    0x00200005,  // "👱🏽\u200d♂️" 1F471-1F3FD-200D-2642-FE0F
+    0x00200003,  // "👱🏽" 1F471-1F3FD
+    0x00200002,  // "👱🏾\u200d♀️" 1F471-1F3FE-200D-2640-FE0F
+    0x00200003,  // "👱🏾\u200d♂️" 1F471-1F3FE-200D-2642-FE0F
+    0x00200003,  // "👱🏾" 1F471-1F3FE
+    0x00200002,  // "👱🏿\u200d♀️" 1F471-1F3FF-200D-2640-FE0F
+    0x00200003,  // "👱🏿\u200d♂️" 1F471-1F3FF-200D-2642-FE0F
+    0x00200003,  // "👱🏿" 1F471-1F3FF
+    0x00200002,  // "👱\u200d♀️" 1F471-200D-2640-FE0F
+    0x00200004,  // "👱\u200d♂️" 1F471-200D-2642-FE0F
+    0x00200003,  // "👱"
+    0x00200003,  // "👲🏻" 1F472-1F3FB
+    0x00200004,  // "👲🏼" 1F472-1F3FC
+    0x00200003,  // "👲🏽" 1F472-1F3FD
+    0x00200003,  // "👲🏾" 1F472-1F3FE
+    0x00200003,  // "👲🏿" 1F472-1F3FF
+    0x00200003,  // "👲"
+    0x00200004,  // "👳🏻\u200d♀️" 1F473-1F3FB-200D-2640-FE0F
+    0x00200004,  // "👳🏻\u200d♂️" 1F473-1F3FB-200D-2642-FE0F
+    0x00200003,  // "👳🏻" 1F473-1F3FB
+    0x00200004,  // "👳🏼\u200d♀️" 1F473-1F3FC-200D-2640-FE0F
+    0x00200004,  // "👳🏼\u200d♂️" 1F473-1F3FC-200D-2642-FE0F
+    0x00200003,  // "👳🏼" 1F473-1F3FC
+    0x00200004,  // "👳🏽\u200d♀️" 1F473-1F3FD-200D-2640-FE0F
+    0x00200004,  // "👳🏽\u200d♂️" 1F473-1F3FD-200D-2642-FE0F
+    0x00200004,  // "👳🏽" 1F473-1F3FD
+    0x00200005,  // "👳🏾\u200d♀️" 1F473-1F3FE-200D-2640-FE0F
+    0x00200004,  // "👳🏾\u200d♂️" 1F473-1F3FE-200D-2642-FE0F
+    0x00200004,  // "👳🏾" 1F473-1F3FE
+    0x00200005,  // "👳🏿\u200d♀️" 1F473-1F3FF-200D-2640-FE0F
+    0x00200004,  // "👳🏿\u200d♂️" 1F473-1F3FF-200D-2642-FE0F
+    0x00200004,  // "👳🏿" 1F473-1F3FF
+    0x00200005,  // "👳\u200d♀️" 1F473-200D-2640-FE0F
+    0x00200004,  // "👳\u200d♂️" 1F473-200D-2642-FE0F
+    0x00200004,  // "👳"
+    0x00200002,  // "👴🏻" 1F474-1F3FB
+    0x00200001,  // "👴🏼" 1F474-1F3FC
+    0x00200002,  // "👴🏾" 1F474-1F3FE
+    0x00200001,  // "👴🏿" 1F474-1F3FF
+    0x00200002,  // "👴"
+    0x00200002,  // "👵🏻" 1F475-1F3FB
+    0x00200002,  // "👵🏼" 1F475-1F3FC
+    0x00200003,  // "👵🏽" 1F475-1F3FD
+    0x00200002,  // "👵🏾" 1F475-1F3FE
+    0x00200002,  // "👵🏿" 1F475-1F3FF
+    0x00200003,  // "👵"
+    0x00200000,  // "👶🏼" 1F476-1F3FC
+    0x00200001,  // "👶🏽" 1F476-1F3FD
+    0x00200001,  // "👶🏾" 1F476-1F3FE
+    0x00200000,  // "👶🏿" 1F476-1F3FF
+    0x00200001,  // "👶"
+    0x00200005,  // "👷🏻\u200d♀️" 1F477-1F3FB-200D-2640-FE0F
+    0x00200004,  // "👷🏻\u200d♂️" 1F477-1F3FB-200D-2642-FE0F
+    0x00200004,  // "👷🏻" 1F477-1F3FB
+    0x00200005,  // "👷🏼\u200d♀️" 1F477-1F3FC-200D-2640-FE0F
+    0x00200004,  // "👷🏼\u200d♂️" 1F477-1F3FC-200D-2642-FE0F
+    0x00200003,  // "👷🏼" 1F477-1F3FC
+    0x00200004,  // "👷🏽\u200d♀️" 1F477-1F3FD-200D-2640-FE0F
+    0x00200004,  // "👷🏽\u200d♂️" 1F477-1F3FD-200D-2642-FE0F
+    0x00200003,  // "👷🏽" 1F477-1F3FD
+    0x00200004,  // "👷🏾\u200d♀️" 1F477-1F3FE-200D-2640-FE0F
+    0x00200004,  // "👷🏾\u200d♂️" 1F477-1F3FE-200D-2642-FE0F
+    0x00200003,  // "👷🏾" 1F477-1F3FE
+    0x00200004,  // "👷🏿\u200d♀️" 1F477-1F3FF-200D-2640-FE0F
+    0x00200004,  // "👷🏿\u200d♂️" 1F477-1F3FF-200D-2642-FE0F
+    0x00200003,  // "👷🏿" 1F477-1F3FF
+    0x00200004,  // "👷\u200d♀️" 1F477-200D-2640-FE0F
+    0x00200004,  // "👷\u200d♂️" 1F477-200D-2642-FE0F
+    0x00200003,  // "👷"
+    0x00200002,  // "👸🏻" 1F478-1F3FB
+    0x00200002,  // "👸🏼" 1F478-1F3FC
+    0x00200002,  // "👸🏽" 1F478-1F3FD
+    0x00200002,  // "👸🏾" 1F478-1F3FE
+    0x00200002,  // "👸"
+    0x00200000,  // "👹"
+    0x00200002,  // "👺"
+    0x00200002,  // "👼🏻" 1F47C-1F3FB
+    0x00200001,  // "👼🏼" 1F47C-1F3FC
+    0x00200002,  // "👼🏾" 1F47C-1F3FE
+    0x00200001,  // "👼🏿" 1F47C-1F3FF
+    0x00200002,  // "👽"
+    0x00200000,  // "👾"
+    0x00200001,  // "👿"
+    0x00200002,  // "💀"
+    0x00200000,  // "💁🏻\u200d♀️" 1F481-1F3FB-200D-2640-FE0F
+    0x00200000,  // "💁🏻\u200d♂️" 1F481-1F3FB-200D-2642-FE0F
+    0x00200000,  // "💁🏼\u200d♀️" 1F481-1F3FC-200D-2640-FE0F
+    0x00200000,  // "💁🏼\u200d♂️" 1F481-1F3FC-200D-2642-FE0F
+    0x00200000,  // "💁🏽\u200d♀️" 1F481-1F3FD-200D-2640-FE0F
+    0x00200000,  // "💁🏽\u200d♂️" 1F481-1F3FD-200D-2642-FE0F
+    0x00200001,  // "💁🏽" 1F481-1F3FD
+    0x00200000,  // "💁🏾\u200d♀️" 1F481-1F3FE-200D-2640-FE0F
+    0x00200000,  // "💁🏾\u200d♂️" 1F481-1F3FE-200D-2642-FE0F
+    0x00200001,  // "💁🏾" 1F481-1F3FE
+    0x00200000,  // "💁🏿\u200d♀️" 1F481-1F3FF-200D-2640-FE0F
+    0x00200000,  // "💁🏿\u200d♂️" 1F481-1F3FF-200D-2642-FE0F
+    0x00200001,  // "💁🏿" 1F481-1F3FF
+    0x00200000,  // "💁\u200d♂️" 1F481-200D-2642-FE0F
+    0x00200000,  // "💁"
+    0x00200007,  // "💂🏻\u200d♀️" 1F482-1F3FB-200D-2640-FE0F
+    0x00200006,  // "💂🏻\u200d♂️" 1F482-1F3FB-200D-2642-FE0F
+    0x00200006,  // "💂🏻" 1F482-1F3FB
+    0x00200007,  // "💂🏼\u200d♀️" 1F482-1F3FC-200D-2640-FE0F
+    0x00200006,  // "💂🏼\u200d♂️" 1F482-1F3FC-200D-2642-FE0F
+    0x00200006,  // "💂🏼" 1F482-1F3FC
+    0x00200007,  // "💂🏽\u200d♀️" 1F482-1F3FD-200D-2640-FE0F
+    0x00200006,  // "💂🏽\u200d♂️" 1F482-1F3FD-200D-2642-FE0F
+    0x00200006,  // "💂🏽" 1F482-1F3FD
+    0x00200007,  // "💂🏾\u200d♀️" 1F482-1F3FE-200D-2640-FE0F
+    0x00200006,  // "💂🏾\u200d♂️" 1F482-1F3FE-200D-2642-FE0F
+    0x00200006,  // "💂🏾" 1F482-1F3FE
+    0x00200007,  // "💂🏿\u200d♀️" 1F482-1F3FF-200D-2640-FE0F
+    0x00200005,  // "💂🏿\u200d♂️" 1F482-1F3FF-200D-2642-FE0F
+    0x00200006,  // "💂🏿" 1F482-1F3FF
+    0x00200007,  // "💂\u200d♀️" 1F482-200D-2640-FE0F
+    0x00200005,  // "💂\u200d♂️" 1F482-200D-2642-FE0F
+    0x00200006,  // "💂"
+    0x00200006,  // "💃🏻" 1F483-1F3FB
+    0x00200005,  // "💃🏼" 1F483-1F3FC
+    0x00200002,  // "💃🏽" 1F483-1F3FD
+    0x00200003,  // "💃🏾" 1F483-1F3FE
+    0x00200001,  // "💃🏿" 1F483-1F3FF
+    0x00200005,  // "💃"
+    0x00200006,  // "💄"
+    0x00200001,  // "💅🏻" 1F485-1F3FB
+    0x00200000,  // "💅🏼" 1F485-1F3FC
+    0x00200000,  // "💅🏽" 1F485-1F3FD
+    0x00200001,  // "💅🏾" 1F485-1F3FE
+    0x00200000,  // "💅🏿" 1F485-1F3FF
+    0x00200000,  // "💅"
+    0x00200004,  // "💆🏻\u200d♀️" 1F486-1F3FB-200D-2640-FE0F
+    0x00200005,  // "💆🏻\u200d♂️" 1F486-1F3FB-200D-2642-FE0F
+    0x00200005,  // "💆🏻" 1F486-1F3FB
+    0x00200004,  // "💆🏼\u200d♀️" 1F486-1F3FC-200D-2640-FE0F
+    0x00200005,  // "💆🏼\u200d♂️" 1F486-1F3FC-200D-2642-FE0F
+    0x00200005,  // "💆🏼" 1F486-1F3FC
+    0x00200004,  // "💆🏽\u200d♀️" 1F486-1F3FD-200D-2640-FE0F
+    0x00200005,  // "💆🏽\u200d♂️" 1F486-1F3FD-200D-2642-FE0F
+    0x00200003,  // "💆🏽" 1F486-1F3FD
+    0x00200004,  // "💆🏾\u200d♀️" 1F486-1F3FE-200D-2640-FE0F
+    0x00200005,  // "💆🏾\u200d♂️" 1F486-1F3FE-200D-2642-FE0F
+    0x00200003,  // "💆🏾" 1F486-1F3FE
+    0x00200004,  // "💆🏿\u200d♀️" 1F486-1F3FF-200D-2640-FE0F
+    0x00200005,  // "💆🏿\u200d♂️" 1F486-1F3FF-200D-2642-FE0F
+    0x00200004,  // "💆🏿" 1F486-1F3FF
+    0x00200005,  // "💆\u200d♀️" 1F486-200D-2640-FE0F
+    0x00200004,  // "💆\u200d♂️" 1F486-200D-2642-FE0F
+    0x00200004,  // "💆"
+    0x00200000,  // "💇🏻\u200d♀️" 1F487-1F3FB-200D-2640-FE0F
+    0x00200000,  // "💇🏻\u200d♂️" 1F487-1F3FB-200D-2642-FE0F
+    0x00200000,  // "💇🏻" 1F487-1F3FB
+    0x00200000,  // "💇🏼\u200d♀️" 1F487-1F3FC-200D-2640-FE0F
+    0x00200000,  // "💇🏼\u200d♂️" 1F487-1F3FC-200D-2642-FE0F
+    0x00200001,  // "💇🏼" 1F487-1F3FC
+    0x00200000,  // "💇🏽\u200d♀️" 1F487-1F3FD-200D-2640-FE0F
+    0x00200000,  // "💇🏽\u200d♂️" 1F487-1F3FD-200D-2642-FE0F
+    0x00200000,  // "💇🏽" 1F487-1F3FD
+    0x00200000,  // "💇🏾\u200d♀️" 1F487-1F3FE-200D-2640-FE0F
+    0x00200000,  // "💇🏾\u200d♂️" 1F487-1F3FE-200D-2642-FE0F
+    0x00200000,  // "💇🏾" 1F487-1F3FE
+    0x00200000,  // "💇🏿\u200d♀️" 1F487-1F3FF-200D-2640-FE0F
+    0x00200000,  // "💇🏿\u200d♂️" 1F487-1F3FF-200D-2642-FE0F
+    0x00200000,  // "💇🏿" 1F487-1F3FF
+    0x00200000,  // "💇\u200d♂️" 1F487-200D-2642-FE0F
+    0x00200000,  // "💇"
+    0x00200008,  // "💈"
+    0x00200000,  // "💉"
+    0x00200001,  // "💊"
+    0x00200001,  // "💋"
+    0x00200000,  // "💌"
+    0x00200006,  // "💍"
+    0x00200001,  // "💎"
+    0x00200000,  // "💏"
+    0x00200000,  // "💐"
+    0x00200001,  // "💑"
+    0x00200001,  // "💒"
+    0x00200002,  // "💓"
+    0x00200000,  // "💔"
+    0x00200001,  // "💕"
+    0x00200000,  // "💖"
+    0x00200001,  // "💗"
+    0x00200000,  // "💙"
+    0x00200000,  // "💚"
+    0x00200000,  // "💜"
+    0x00200001,  // "💝"
+    0x00200001,  // "💞"
+    0x00200000,  // "💟"
+    0x00200001,  // "💠"
+    0x00200007,  // "💡"
+    0x00200000,  // "💣"
+    0x00200001,  // "💤"
+    0x00200000,  // "💦"
+    0x00200006,  // "💧"
+    0x00200002,  // "💨"
+    0x00200000,  // "💩"
+    0x00200001,  // "💪🏻" 1F4AA-1F3FB
+    0x00200002,  // "💪🏽" 1F4AA-1F3FD
+    0x00200001,  // "💪🏾" 1F4AA-1F3FE
+    0x00200001,  // "💪🏿" 1F4AA-1F3FF
+    0x00200002,  // "💪"
+    0x00200003,  // "💫"
+    0x00200002,  // "💮"
+    0x00200000,  // "💯"
+    0x00200002,  // "💰"
+    0x00200003,  // "💱"
+    0x00200008,  // "💲"
+    0x00200000,  // "💳"
+    0x00200000,  // "💴"
+    0x00200000,  // "💵"
+    0x00200001,  // "💶"
+    0x00200000,  // "💷"
+    0x00200000,  // "💸"
+    0x00200001,  // "💹"
+    0x00200003,  // "💺"
+    0x00200001,  // "💻"
+    0x00200000,  // "💼"
+    0x00200000,  // "💽"
+    0x00200000,  // "💾"
+    0x00200000,  // "💿"
+    0x00200000,  // "📁"
+    0x00200000,  // "📂"
+    0x00200003,  // "📄"
+    0x00200000,  // "📅"
+    0x00200001,  // "📆"
+    0x00200000,  // "📇"
+    0x00200001,  // "📈"
+    0x00200002,  // "📉"
+    0x00200002,  // "📊"
+    0x00200004,  // "📋"
+    0x00200001,  // "📌"
+    0x00200009,  // "📍"
+    0x00200002,  // "📎"
+    0x00200001,  // "📏"
+    0x00200002,  // "📑"
+    0x00200000,  // "📒"
+    0x00200001,  // "📓"
+    0x00200001,  // "📔"
+    0x00200001,  // "📕"
+    0x00200001,  // "📖"
+    0x00200001,  // "📗"
+    0x00200001,  // "📘"
+    0x00200001,  // "📚"
+    0x00200000,  // "📛"
+    0x00200002,  // "📝"
+    0x00200000,  // "📞"
+    0x00200000,  // "📟"
+    0x00200000,  // "📠"
+    0x00200003,  // "📡"
+    0x00200000,  // "📢"
+    0x00200001,  // "📣"
+    0x00200000,  // "📤"
+    0x00200000,  // "📥"
+    0x00200004,  // "📦"
+    0x00200000,  // "📧"
+    0x00200001,  // "📨"
+    0x00200001,  // "📩"
+    0x00200002,  // "📪"
+    0x00200003,  // "📫"
+    0x00200002,  // "📭"
+    0x00200005,  // "📮"
+    0x00200001,  // "📯"
+    0x00200001,  // "📰"
+    0x00200006,  // "📱"
+    0x00200000,  // "📲"
+    0x00200001,  // "📳"
+    0x00200000,  // "📴"
+    0x00200000,  // "📵"
+    0x00200001,  // "📶"
+    0x00200000,  // "📷"
+    0x00200000,  // "📸"
+    0x00200000,  // "📹"
+    0x00200001,  // "📺"
+    0x00200000,  // "📻"
+    0x00200000,  // "📼"
+    0x00200001,  // "📽"
+    0x00200000,  // "📿"
+    0x00200000,  // "🔀"
+    0x00200001,  // "🔁"
+    0x00200000,  // "🔂"
+    0x00200000,  // "🔃"
+    0x00200001,  // "🔄"
+    0x00200003,  // "🔅"
+    0x00200001,  // "🔆"
+    0x00200004,  // "🔇"
+    0x00200006,  // "🔈"
+    0x00200004,  // "🔉"
+    0x00200001,  // "🔊"
+    0x00200006,  // "🔋"
+    0x00200003,  // "🔌"
+    0x00200001,  // "🔍"
+    0x00200001,  // "🔎"
+    0x00200000,  // "🔏"
+    0x00200000,  // "🔐"
+    0x00200001,  // "🔑"
+    0x00200005,  // "🔒"
+    0x00200004,  // "🔓"
+    0x00200004,  // "🔔"
+    0x00200003,  // "🔕"
+    0x00200001,  // "🔖"
+    0x00200001,  // "🔗"
+    0x00200000,  // "🔘"
+    0x00200000,  // "🔙"
+    0x00200000,  // "🔚"
+    0x00200000,  // "🔛"
+    0x00200000,  // "🔜"
+    0x00200000,  // "🔝"
+    0x00200000,  // "🔞"
+    0x00200000,  // "🔟"
+    0x00200000,  // "🔠"
+    0x00200001,  // "🔡"
+    0x00200000,  // "🔢"
+    0x00200000,  // "🔣"
+    0x00200001,  // "🔤"
+    0x00200002,  // "🔥"
+    0x00200001,  // "🔦"
+    0x00200001,  // "🔧"
+    0x00200002,  // "🔨"
+    0x00200001,  // "🔩"
+    0x00200000,  // "🔫"
+    0x00200003,  // "🔬"
+    0x00200001,  // "🔭"
+    0x00200002,  // "🔮"
+    0x00200000,  // "🔯"
+    0x00200004,  // "🔰"
+    0x00200000,  // "🔲"
+    0x00200001,  // "🔳"
+    0x00200001,  // "🔴"
+    0x00200000,  // "🔵"
+    0x00200001,  // "🔶"
+    0x00200008,  // "🔸"
+    0x00200007,  // "🔹"
+    0x00200005,  // "🔺"
+    0x00200006,  // "🔻"
+    0x00200000,  // "🔼"
+    0x00200001,  // "🔽"
+    0x00200001,  // "🕉"
+    0x00200002,  // "🕊"
+    0x00200000,  // "🕋"
+    0x00200002,  // "🕌"
+    0x00200001,  // "🕍"
+    0x00200000,  // "🕎"
+    0x00200001,  // "🕐"
+    0x00200000,  // "🕑"
+    0x00200000,  // "🕒"
+    0x00200001,  // "🕓"
+    0x00200000,  // "🕔"
+    0x00200000,  // "🕕"
+    0x00200001,  // "🕖"
+    0x00200000,  // "🕗"
+    0x00200000,  // "🕘"
+    0x00200001,  // "🕙"
+    0x00200001,  // "🕚"
+    0x00200000,  // "🕛"
+    0x00200000,  // "🕜"
+    0x00200001,  // "🕝"
+    0x00200000,  // "🕞"
+    0x00200000,  // "🕟"
+    0x00200001,  // "🕠"
+    0x00200000,  // "🕡"
+    0x00200000,  // "🕢"
+    0x00200001,  // "🕣"
+    0x00200000,  // "🕤"
+    0x00200000,  // "🕥"
+    0x00200001,  // "🕦"
+    0x00200000,  // "🕧"
+    0x00200009,  // "🕯"
+    0x00200001,  // "🕰"
+    0x00200001,  // "🕳"
+    0x0020000B,  // "🕴🏻\u200d♀️" 1F574-1F3FB-200D-2640-FE0F
+    0x0020000A,  // "🕴🏻\u200d♂️" 1F574-1F3FB-200D-2642-FE0F
+    0x0020000B,  // "🕴🏻" 1F574-1F3FB
+    0x0020000B,  // "🕴🏼\u200d♀️" 1F574-1F3FC-200D-2640-FE0F
+    0x0020000A,  // "🕴🏼\u200d♂️" 1F574-1F3FC-200D-2642-FE0F
+    0x0020000B,  // "🕴🏼" 1F574-1F3FC
+    0x0020000B,  // "🕴🏽\u200d♀️" 1F574-1F3FD-200D-2640-FE0F
+    0x0020000A,  // "🕴🏽\u200d♂️" 1F574-1F3FD-200D-2642-FE0F
+    0x0020000B,  // "🕴🏽" 1F574-1F3FD
+    0x0020000B,  // "🕴🏾\u200d♀️" 1F574-1F3FE-200D-2640-FE0F
+    0x0020000A,  // "🕴🏾\u200d♂️" 1F574-1F3FE-200D-2642-FE0F
+    0x0020000B,  // "🕴🏾" 1F574-1F3FE
+    0x0020000B,  // "🕴🏿\u200d♀️" 1F574-1F3FF-200D-2640-FE0F
+    0x0020000A,  // "🕴🏿\u200d♂️" 1F574-1F3FF-200D-2642-FE0F
+    0x0020000B,  // "🕴🏿" 1F574-1F3FF
+    0x0020000A,  // "🕴️\u200d♀️" 1F574-FE0F-200D-2640-FE0F
+    0x0020000B,  // "🕴️\u200d♂️" 1F574-FE0F-200D-2642-FE0F
+    0x0020000A,  // "🕴"
+    0x00200004,  // "🕵🏻\u200d♀️" 1F575-1F3FB-200D-2640-FE0F
+    0x00200003,  // "🕵🏻\u200d♂️" 1F575-1F3FB-200D-2642-FE0F
+    0x00200003,  // "🕵🏻" 1F575-1F3FB
+    0x00200004,  // "🕵🏼\u200d♀️" 1F575-1F3FC-200D-2640-FE0F
+    0x00200003,  // "🕵🏼\u200d♂️" 1F575-1F3FC-200D-2642-FE0F
+    0x00200003,  // "🕵🏼" 1F575-1F3FC
+    0x00200004,  // "🕵🏽\u200d♀️" 1F575-1F3FD-200D-2640-FE0F
+    0x00200003,  // "🕵🏽\u200d♂️" 1F575-1F3FD-200D-2642-FE0F
+    0x00200003,  // "🕵🏽" 1F575-1F3FD
+    0x00200004,  // "🕵🏾\u200d♀️" 1F575-1F3FE-200D-2640-FE0F
+    0x00200003,  // "🕵🏾\u200d♂️" 1F575-1F3FE-200D-2642-FE0F
+    0x00200003,  // "🕵🏾" 1F575-1F3FE
+    0x00200004,  // "🕵🏿\u200d♀️" 1F575-1F3FF-200D-2640-FE0F
+    0x00200003,  // "🕵🏿\u200d♂️" 1F575-1F3FF-200D-2642-FE0F
+    0x00200003,  // "🕵🏿" 1F575-1F3FF
+    0x00200003,  // "🕵️\u200d♀️" 1F575-FE0F-200D-2640-FE0F
+    0x00200003,  // "🕵️\u200d♂️" 1F575-FE0F-200D-2642-FE0F
+    0x00200003,  // "🕵"
+    0x00200002,  // "🕶"
+    0x00200003,  // "🕷"
+    0x00200002,  // "🕸"
+    0x00200002,  // "🕹"
+    0x00200007,  // "🕺🏻" 1F57A-1F3FB
+    0x00200006,  // "🕺🏼" 1F57A-1F3FC
+    0x00200007,  // "🕺🏽" 1F57A-1F3FD
+    0x00200007,  // "🕺🏾" 1F57A-1F3FE
+    0x00200006,  // "🕺🏿" 1F57A-1F3FF
+    0x00200007,  // "🕺"
+    0x00200002,  // "🖇"
+    0x00200000,  // "🖋"
+    0x00200001,  // "🖌"
+    0x00200001,  // "🖍"
+    0x00200003,  // "🖐🏻" 1F590-1F3FB
+    0x00200004,  // "🖐🏼" 1F590-1F3FC
+    0x00200004,  // "🖐🏽" 1F590-1F3FD
+    0x00200003,  // "🖐🏾" 1F590-1F3FE
+    0x00200003,  // "🖐🏿" 1F590-1F3FF
+    0x00200004,  // "🖐"
+    0x00200006,  // "🖕🏻" 1F595-1F3FB
+    0x00200006,  // "🖕🏼" 1F595-1F3FC
+    0x00200005,  // "🖕🏽" 1F595-1F3FD
+    0x00200006,  // "🖕🏾" 1F595-1F3FE
+    0x00200006,  // "🖕🏿" 1F595-1F3FF
+    0x00200007,  // "🖕"
+    0x00200005,  // "🖖🏻" 1F596-1F3FB
+    0x00200005,  // "🖖🏼" 1F596-1F3FC
+    0x00200004,  // "🖖🏽" 1F596-1F3FD
+    0x00200004,  // "🖖🏾" 1F596-1F3FE
+    0x00200003,  // "🖖🏿" 1F596-1F3FF
+    0x00200004,  // "🖖"
+    0x00200000,  // "🖤"
+    0x00200000,  // "🖥"
+    0x00200003,  // "🖨"
+    0x00200005,  // "🖱"
+    0x00200003,  // "🖲"
+    0x00200001,  // "🖼"
+    0x00200002,  // "🗂"
+    0x00200002,  // "🗃"
+    0x00200006,  // "🗄"
+    0x00200001,  // "🗑"
+    0x00200004,  // "🗒"
+    0x00200002,  // "🗓"
+    0x00200003,  // "🗜"
+    0x00200001,  // "🗝"
+    0x00200000,  // "🗞"
+    0x00200001,  // "🗡"
+    0x00200001,  // "🗣"
+    0x00200000,  // "🗨"
+    0x00200002,  // "🗯"
+    0x00200002,  // "🗳"
+    0x00200000,  // "🗺"
+    0x00200000,  // "🗻"
+    0x00200004,  // "🗼"
+    0x00200000,  // "🗽"
+    0x00200001,  // "🗾"
+    0x00200004,  // "🗿"
+    0x00200000,  // "😁"
+    0x00200001,  // "😂"
+    0x00200000,  // "😄"
+    0x00200001,  // "😅"
+    0x00200000,  // "😇"
+    0x00200000,  // "😈"
+    0x00200000,  // "😊"
+    0x00200001,  // "😋"
+    0x00200000,  // "😍"
+    0x00200001,  // "😎"
+    0x00200000,  // "😑"
+    0x00200001,  // "😒"
+    0x00200000,  // "😔"
+    0x00200001,  // "😕"
+    0x00200000,  // "😗"
+    0x00200001,  // "😘"
+    0x00200000,  // "😚"
+    0x00200001,  // "😛"
+    0x00200000,  // "😝"
+    0x00200001,  // "😞"
+    0x00200000,  // "😡"
+    0x00200001,  // "😢"
+    0x00200000,  // "😤"
+    0x00200000,  // "😥"
+    0x00200000,  // "😧"
+    0x00200001,  // "😨"
+    0x00200000,  // "😪"
+    0x00200001,  // "😫"
+    0x00200000,  // "😭"
+    0x00200001,  // "😮"
+    0x00200000,  // "😱"
+    0x00200001,  // "😲"
+    0x00200000,  // "😴"
+    0x00200001,  // "😵"
+    0x00200000,  // "😷"
+    0x00200001,  // "😸"
+    0x00200001,  // "😹"
+    0x00200000,  // "😺"
+    0x00200000,  // "😻"
+    0x00200000,  // "😽"
+    0x00200001,  // "😾"
+    0x00200000,  // "🙁"
+    0x00200001,  // "🙂"
+    0x00200000,  // "🙄"
+    0x00200001,  // "🙅🏻\u200d♀️" 1F645-1F3FB-200D-2640-FE0F
+    0x00200002,  // "🙅🏻" 1F645-1F3FB
+    0x00200001,  // "🙅🏼\u200d♀️" 1F645-1F3FC-200D-2640-FE0F
+    0x00200002,  // "🙅🏼" 1F645-1F3FC
+    0x00200001,  // "🙅🏽\u200d♀️" 1F645-1F3FD-200D-2640-FE0F
+    0x00200000,  // "🙅🏽" 1F645-1F3FD
+    0x00200001,  // "🙅🏾\u200d♀️" 1F645-1F3FE-200D-2640-FE0F
+    0x00200001,  // "🙅🏾\u200d♂️" 1F645-1F3FE-200D-2642-FE0F
+    0x00200001,  // "🙅🏾" 1F645-1F3FE
+    0x00200000,  // "🙅🏿\u200d♀️" 1F645-1F3FF-200D-2640-FE0F
+    0x00200001,  // "🙅🏿\u200d♂️" 1F645-1F3FF-200D-2642-FE0F
+    0x00200001,  // "🙅🏿" 1F645-1F3FF
+    0x00200003,  // "🙅\u200d♀️" 1F645-200D-2640-FE0F
+    0x00200001,  // "🙅\u200d♂️" 1F645-200D-2642-FE0F
+    0x00200002,  // "🙅"
+    0x00200000,  // "🙆🏻\u200d♀️" 1F646-1F3FB-200D-2640-FE0F
+    0x00200000,  // "🙆🏻\u200d♂️" 1F646-1F3FB-200D-2642-FE0F
+    0x00200001,  // "🙆🏻" 1F646-1F3FB
+    0x00200000,  // "🙆🏼\u200d♀️" 1F646-1F3FC-200D-2640-FE0F
+    0x00200000,  // "🙆🏼\u200d♂️" 1F646-1F3FC-200D-2642-FE0F
+    0x00200001,  // "🙆🏼" 1F646-1F3FC
+    0x00200000,  // "🙆🏽\u200d♀️" 1F646-1F3FD-200D-2640-FE0F
+    0x00200000,  // "🙆🏽\u200d♂️" 1F646-1F3FD-200D-2642-FE0F
+    0x00200001,  // "🙆🏽" 1F646-1F3FD
+    0x00200001,  // "🙆🏾\u200d♀️" 1F646-1F3FE-200D-2640-FE0F
+    0x00200000,  // "🙆🏾\u200d♂️" 1F646-1F3FE-200D-2642-FE0F
+    0x00200000,  // "🙆🏾" 1F646-1F3FE
+    0x00200001,  // "🙆🏿\u200d♀️" 1F646-1F3FF-200D-2640-FE0F
+    0x00200000,  // "🙆🏿\u200d♂️" 1F646-1F3FF-200D-2642-FE0F
+    0x00200000,  // "🙆🏿" 1F646-1F3FF
+    0x00200001,  // "🙆\u200d♀️" 1F646-200D-2640-FE0F
+    0x00200000,  // "🙆\u200d♂️" 1F646-200D-2642-FE0F
+    0x00200000,  // "🙆"
+    0x00200001,  // "🙇🏻\u200d♀️" 1F647-1F3FB-200D-2640-FE0F
+    0x00200000,  // "🙇🏻\u200d♂️" 1F647-1F3FB-200D-2642-FE0F
+    0x00200000,  // "🙇🏻" 1F647-1F3FB
+    0x00200001,  // "🙇🏼\u200d♀️" 1F647-1F3FC-200D-2640-FE0F
+    0x00200000,  // "🙇🏼\u200d♂️" 1F647-1F3FC-200D-2642-FE0F
+    0x00200000,  // "🙇🏼" 1F647-1F3FC
+    0x00200001,  // "🙇🏽\u200d♀️" 1F647-1F3FD-200D-2640-FE0F
+    0x00200001,  // "🙇🏽\u200d♂️" 1F647-1F3FD-200D-2642-FE0F
+    0x00200000,  // "🙇🏽" 1F647-1F3FD
+    0x00200000,  // "🙇🏾\u200d♀️" 1F647-1F3FE-200D-2640-FE0F
+    0x00200001,  // "🙇🏾\u200d♂️" 1F647-1F3FE-200D-2642-FE0F
+    0x00200000,  // "🙇🏾" 1F647-1F3FE
+    0x00200000,  // "🙇🏿\u200d♀️" 1F647-1F3FF-200D-2640-FE0F
+    0x00200001,  // "🙇🏿\u200d♂️" 1F647-1F3FF-200D-2642-FE0F
+    0x00200000,  // "🙇🏿" 1F647-1F3FF
+    0x00200000,  // "🙇\u200d♀️" 1F647-200D-2640-FE0F
+    0x00200001,  // "🙇\u200d♂️" 1F647-200D-2642-FE0F
+    0x00200000,  // "🙇"
+    0x00200001,  // "🙈"
+    0x00200001,  // "🙉"
+    0x00200000,  // "🙊"
+    0x00200002,  // "🙋🏻\u200d♀️" 1F64B-1F3FB-200D-2640-FE0F
+    0x00200002,  // "🙋🏻\u200d♂️" 1F64B-1F3FB-200D-2642-FE0F
+    0x00200002,  // "🙋🏻" 1F64B-1F3FB
+    0x00200002,  // "🙋🏼\u200d♀️" 1F64B-1F3FC-200D-2640-FE0F
+    0x00200002,  // "🙋🏼\u200d♂️" 1F64B-1F3FC-200D-2642-FE0F
+    0x00200002,  // "🙋🏼" 1F64B-1F3FC
+    0x00200002,  // "🙋🏽\u200d♀️" 1F64B-1F3FD-200D-2640-FE0F
+    0x00200002,  // "🙋🏽\u200d♂️" 1F64B-1F3FD-200D-2642-FE0F
+    0x00200002,  // "🙋🏽" 1F64B-1F3FD
+    0x00200002,  // "🙋🏾\u200d♀️" 1F64B-1F3FE-200D-2640-FE0F
+    0x00200002,  // "🙋🏾\u200d♂️" 1F64B-1F3FE-200D-2642-FE0F
+    0x00200002,  // "🙋🏾" 1F64B-1F3FE
+    0x00200002,  // "🙋🏿\u200d♀️" 1F64B-1F3FF-200D-2640-FE0F
+    0x00200002,  // "🙋🏿\u200d♂️" 1F64B-1F3FF-200D-2642-FE0F
+    0x00200002,  // "🙋🏿" 1F64B-1F3FF
+    0x00200002,  // "🙋\u200d♀️" 1F64B-200D-2640-FE0F
+    0x00200002,  // "🙋\u200d♂️" 1F64B-200D-2642-FE0F
+    0x00200002,  // "🙋"
+    0x00200000,  // "🙌🏼" 1F64C-1F3FC
+    0x00200001,  // "🙌🏽" 1F64C-1F3FD
+    0x00200001,  // "🙌🏾" 1F64C-1F3FE
+    0x00200000,  // "🙌🏿" 1F64C-1F3FF
+    0x00200001,  // "🙌"
+    0x00200005,  // "🙍🏻\u200d♀️" 1F64D-1F3FB-200D-2640-FE0F
+    0x00200005,  // "🙍🏻\u200d♂️" 1F64D-1F3FB-200D-2642-FE0F
+    0x00200004,  // "🙍🏻" 1F64D-1F3FB
+    0x00200005,  // "🙍🏼\u200d♀️" 1F64D-1F3FC-200D-2640-FE0F
+    0x00200005,  // "🙍🏼\u200d♂️" 1F64D-1F3FC-200D-2642-FE0F
+    0x00200004,  // "🙍🏼" 1F64D-1F3FC
+    0x00200005,  // "🙍🏽\u200d♀️" 1F64D-1F3FD-200D-2640-FE0F
+    0x00200005,  // "🙍🏽\u200d♂️" 1F64D-1F3FD-200D-2642-FE0F
+    0x00200004,  // "🙍🏽" 1F64D-1F3FD
+    0x00200005,  // "🙍🏾\u200d♀️" 1F64D-1F3FE-200D-2640-FE0F
+    0x00200005,  // "🙍🏾\u200d♂️" 1F64D-1F3FE-200D-2642-FE0F
+    0x00200005,  // "🙍🏾" 1F64D-1F3FE
+    0x00200004,  // "🙍🏿\u200d♀️" 1F64D-1F3FF-200D-2640-FE0F
+    0x00200005,  // "🙍🏿\u200d♂️" 1F64D-1F3FF-200D-2642-FE0F
+    0x00200005,  // "🙍🏿" 1F64D-1F3FF
+    0x00200004,  // "🙍\u200d♀️" 1F64D-200D-2640-FE0F
+    0x00200005,  // "🙍\u200d♂️" 1F64D-200D-2642-FE0F
+    0x00200005,  // "🙍"
+    0x00200004,  // "🙎🏻\u200d♀️" 1F64E-1F3FB-200D-2640-FE0F
+    0x00200005,  // "🙎🏻\u200d♂️" 1F64E-1F3FB-200D-2642-FE0F
+    0x00200005,  // "🙎🏻" 1F64E-1F3FB
+    0x00200004,  // "🙎🏼\u200d♀️" 1F64E-1F3FC-200D-2640-FE0F
+    0x00200005,  // "🙎🏼\u200d♂️" 1F64E-1F3FC-200D-2642-FE0F
+    0x00200005,  // "🙎🏼" 1F64E-1F3FC
+    0x00200004,  // "🙎🏽\u200d♀️" 1F64E-1F3FD-200D-2640-FE0F
+    0x00200005,  // "🙎🏽\u200d♂️" 1F64E-1F3FD-200D-2642-FE0F
+    0x00200005,  // "🙎🏽" 1F64E-1F3FD
+    0x00200005,  // "🙎🏾\u200d♀️" 1F64E-1F3FE-200D-2640-FE0F
+    0x00200004,  // "🙎🏾\u200d♂️" 1F64E-1F3FE-200D-2642-FE0F
+    0x00200005,  // "🙎🏾" 1F64E-1F3FE
+    0x00200005,  // "🙎🏿\u200d♀️" 1F64E-1F3FF-200D-2640-FE0F
+    0x00200004,  // "🙎🏿\u200d♂️" 1F64E-1F3FF-200D-2642-FE0F
+    0x00200005,  // "🙎🏿" 1F64E-1F3FF
+    0x00200005,  // "🙎\u200d♀️" 1F64E-200D-2640-FE0F
+    0x00200004,  // "🙎\u200d♂️" 1F64E-200D-2642-FE0F
+    0x00200005,  // "🙎"
+    0x00200000,  // "🙏🏻" 1F64F-1F3FB
+    0x00200000,  // "🙏🏼" 1F64F-1F3FC
+    0x00200001,  // "🙏🏽" 1F64F-1F3FD
+    0x00200000,  // "🙏🏾" 1F64F-1F3FE
+    0x00200000,  // "🙏🏿" 1F64F-1F3FF
+    0x00200001,  // "🙏"
+    0x00200001,  // "🚀"
+    0x00200002,  // "🚁"
+    0x00200000,  // "🚂"
+    0x00200001,  // "🚃"
+    0x00200000,  // "🚄"
+    0x00200000,  // "🚅"
+    0x00200002,  // "🚆"
+    0x00200000,  // "🚇"
+    0x00200001,  // "🚈"
+    0x00200001,  // "🚉"
+    0x00200003,  // "🚊"
+    0x00200000,  // "🚋"
+    0x00200000,  // "🚌"
+    0x00200000,  // "🚍"
+    0x00200000,  // "🚎"
+    0x00200007,  // "🚏"
+    0x00200001,  // "🚐"
+    0x00200000,  // "🚑"
+    0x00200000,  // "🚒"
+    0x00200001,  // "🚓"
+    0x00200000,  // "🚔"
+    0x00200001,  // "🚕"
+    0x00200000,  // "🚗"
+    0x00200000,  // "🚘"
+    0x00200001,  // "🚙"
+    0x00200000,  // "🚚"
+    0x00200000,  // "🚛"
+    0x00200001,  // "🚜"
+    0x00200000,  // "🚝"
+    0x00200001,  // "🚞"
+    0x00200000,  // "🚟"
+    0x00200002,  // "🚠"
+    0x00200003,  // "🚡"
+    0x00200000,  // "🚢"
+    0x00200001,  // "🚣🏻\u200d♀️" 1F6A3-1F3FB-200D-2640-FE0F
+    0x00200000,  // "🚣🏻\u200d♂️" 1F6A3-1F3FB-200D-2642-FE0F
+    0x00200000,  // "🚣🏻" 1F6A3-1F3FB
+    0x00200001,  // "🚣🏼\u200d♀️" 1F6A3-1F3FC-200D-2640-FE0F
+    0x00200000,  // "🚣🏼\u200d♂️" 1F6A3-1F3FC-200D-2642-FE0F
+    0x00200000,  // "🚣🏼" 1F6A3-1F3FC
+    0x00200001,  // "🚣🏽\u200d♀️" 1F6A3-1F3FD-200D-2640-FE0F
+    0x00200000,  // "🚣🏽\u200d♂️" 1F6A3-1F3FD-200D-2642-FE0F
+    0x00200000,  // "🚣🏽" 1F6A3-1F3FD
+    0x00200001,  // "🚣🏾\u200d♀️" 1F6A3-1F3FE-200D-2640-FE0F
+    0x00200000,  // "🚣🏾\u200d♂️" 1F6A3-1F3FE-200D-2642-FE0F
+    0x00200000,  // "🚣🏾" 1F6A3-1F3FE
+    0x00200001,  // "🚣🏿\u200d♀️" 1F6A3-1F3FF-200D-2640-FE0F
+    0x00200001,  // "🚣🏿\u200d♂️" 1F6A3-1F3FF-200D-2642-FE0F
+    0x00200000,  // "🚣🏿" 1F6A3-1F3FF
+    0x00200000,  // "🚣\u200d♀️" 1F6A3-200D-2640-FE0F
+    0x00200001,  // "🚣\u200d♂️" 1F6A3-200D-2642-FE0F
+    0x00200000,  // "🚣"
+    0x00200000,  // "🚤"
+    0x00200000,  // "🚥"
+    0x00200008,  // "🚦"
+    0x00200000,  // "🚧"
+    0x00200001,  // "🚨"
+    0x00200008,  // "🚩"
+    0x00200006,  // "🚪"
+    0x00200000,  // "🚫"
+    0x00200000,  // "🚬"
+    0x00200000,  // "🚭"
+    0x00200001,  // "🚮"
+    0x00200000,  // "🚯"
+    0x00200000,  // "🚰"
+    0x00200000,  // "🚱"
+    0x00200000,  // "🚲"
+    0x00200000,  // "🚳"
+    0x00200000,  // "🚴🏻\u200d♀️" 1F6B4-1F3FB-200D-2640-FE0F
+    0x00200000,  // "🚴🏻\u200d♂️" 1F6B4-1F3FB-200D-2642-FE0F
+    0x00200000,  // "🚴🏻" 1F6B4-1F3FB
+    0x00200000,  // "🚴🏼\u200d♀️" 1F6B4-1F3FC-200D-2640-FE0F
+    0x00200000,  // "🚴🏼\u200d♂️" 1F6B4-1F3FC-200D-2642-FE0F
+    0x00200000,  // "🚴🏼" 1F6B4-1F3FC
+    0x00200000,  // "🚴🏽\u200d♀️" 1F6B4-1F3FD-200D-2640-FE0F
+    0x00200000,  // "🚴🏽\u200d♂️" 1F6B4-1F3FD-200D-2642-FE0F
+    0x00200000,  // "🚴🏽" 1F6B4-1F3FD
+    0x00200000,  // "🚴🏾\u200d♀️" 1F6B4-1F3FE-200D-2640-FE0F
+    0x00200000,  // "🚴🏾\u200d♂️" 1F6B4-1F3FE-200D-2642-FE0F
+    0x00200000,  // "🚴🏾" 1F6B4-1F3FE
+    0x00200000,  // "🚴🏿\u200d♀️" 1F6B4-1F3FF-200D-2640-FE0F
+    0x00200000,  // "🚴🏿\u200d♂️" 1F6B4-1F3FF-200D-2642-FE0F
+    0x00200000,  // "🚴🏿" 1F6B4-1F3FF
+    0x00200000,  // "🚴\u200d♀️" 1F6B4-200D-2640-FE0F
+    0x00200000,  // "🚴\u200d♂️" 1F6B4-200D-2642-FE0F
+    0x00200000,  // "🚴"
+    0x00200000,  // "🚵🏻\u200d♀️" 1F6B5-1F3FB-200D-2640-FE0F
+    0x00200000,  // "🚵🏻\u200d♂️" 1F6B5-1F3FB-200D-2642-FE0F
+    0x00200000,  // "🚵🏻" 1F6B5-1F3FB
+    0x00200000,  // "🚵🏼\u200d♀️" 1F6B5-1F3FC-200D-2640-FE0F
+    0x00200000,  // "🚵🏼\u200d♂️" 1F6B5-1F3FC-200D-2642-FE0F
+    0x00200000,  // "🚵🏼" 1F6B5-1F3FC
+    0x00200000,  // "🚵🏽\u200d♀️" 1F6B5-1F3FD-200D-2640-FE0F
+    0x00200000,  // "🚵🏽\u200d♂️" 1F6B5-1F3FD-200D-2642-FE0F
+    0x00200000,  // "🚵🏽" 1F6B5-1F3FD
+    0x00200000,  // "🚵🏾\u200d♀️" 1F6B5-1F3FE-200D-2640-FE0F
+    0x00200000,  // "🚵🏾\u200d♂️" 1F6B5-1F3FE-200D-2642-FE0F
+    0x00200000,  // "🚵🏾" 1F6B5-1F3FE
+    0x00200000,  // "🚵🏿\u200d♀️" 1F6B5-1F3FF-200D-2640-FE0F
+    0x00200000,  // "🚵🏿\u200d♂️" 1F6B5-1F3FF-200D-2642-FE0F
+    0x00200000,  // "🚵🏿" 1F6B5-1F3FF
+    0x00200000,  // "🚵\u200d♀️" 1F6B5-200D-2640-FE0F
+    0x00200000,  // "🚵\u200d♂️" 1F6B5-200D-2642-FE0F
+    0x00200000,  // "🚵"
+    0x0020000B,  // "🚶🏻\u200d♀️" 1F6B6-1F3FB-200D-2640-FE0F
+    0x0020000B,  // "🚶🏻\u200d♂️" 1F6B6-1F3FB-200D-2642-FE0F
+    0x0020000A,  // "🚶🏻" 1F6B6-1F3FB
+    0x0020000B,  // "🚶🏼\u200d♀️" 1F6B6-1F3FC-200D-2640-FE0F
+    0x0020000B,  // "🚶🏼\u200d♂️" 1F6B6-1F3FC-200D-2642-FE0F
+    0x0020000A,  // "🚶🏼" 1F6B6-1F3FC
+    0x0020000B,  // "🚶🏽\u200d♀️" 1F6B6-1F3FD-200D-2640-FE0F
+    0x0020000B,  // "🚶🏽\u200d♂️" 1F6B6-1F3FD-200D-2642-FE0F
+    0x0020000B,  // "🚶🏽" 1F6B6-1F3FD
+    0x0020000A,  // "🚶🏾\u200d♀️" 1F6B6-1F3FE-200D-2640-FE0F
+    0x0020000B,  // "🚶🏾\u200d♂️" 1F6B6-1F3FE-200D-2642-FE0F
+    0x0020000B,  // "🚶🏾" 1F6B6-1F3FE
+    0x0020000A,  // "🚶🏿\u200d♀️" 1F6B6-1F3FF-200D-2640-FE0F
+    0x0020000B,  // "🚶🏿\u200d♂️" 1F6B6-1F3FF-200D-2642-FE0F
+    0x0020000B,  // "🚶🏿" 1F6B6-1F3FF
+    0x0020000A,  // "🚶\u200d♀️" 1F6B6-200D-2640-FE0F
+    0x0020000B,  // "🚶\u200d♂️" 1F6B6-200D-2642-FE0F
+    0x0020000B,  // "🚶"
+    0x00200000,  // "🚷"
+    0x00200000,  // "🚹"
+    0x00200000,  // "🚺"
+    0x00200001,  // "🚻"
+    0x00200001,  // "🚼"
+    0x00200002,  // "🚽"
+    0x00200000,  // "🚾"
+    0x00200001,  // "🚿"
+    0x00200000,  // "🛀🏻" 1F6C0-1F3FB
+    0x00200001,  // "🛀🏼" 1F6C0-1F3FC
+    0x00200001,  // "🛀🏽" 1F6C0-1F3FD
+    0x00200000,  // "🛀🏾" 1F6C0-1F3FE
+    0x00200001,  // "🛀🏿" 1F6C0-1F3FF
+    0x00200001,  // "🛀"
+    0x00200000,  // "🛁"
+    0x00200000,  // "🛂"
+    0x00200001,  // "🛃"
+    0x00200000,  // "🛄"
+    0x00200000,  // "🛅"
+    0x00200001,  // "🛌🏻" 1F6CC-1F3FB
+    0x00200000,  // "🛌🏼" 1F6CC-1F3FC
+    0x00200000,  // "🛌🏽" 1F6CC-1F3FD
+    0x00200001,  // "🛌🏾" 1F6CC-1F3FE
+    0x00200000,  // "🛌🏿" 1F6CC-1F3FF
+    0x00200000,  // "🛌"
+    0x00200001,  // "🛍"
+    0x00200002,  // "🛎"
+    0x00200000,  // "🛏"
+    0x00200001,  // "🛐"
+    0x00200002,  // "🛑"
+    0x00200003,  // "🛒"
+    0x00200002,  // "🛖"
+    0x00200000,  // "🛗"
+    0x00200001,  // "🛠"
+    0x00200002,  // "🛡"
+    0x00200006,  // "🛢"
+    0x00200000,  // "🛣"
+    0x00200001,  // "🛤"
+    0x00200000,  // "🛥"
+    0x00200001,  // "🛩"
+    0x00200001,  // "🛫"
+    0x00200000,  // "🛬"
+    0x00200000,  // "🛰"
+    0x00200001,  // "🛳"
+    0x00200000,  // "🛴"
+    0x00200001,  // "🛵"
+    0x00200001,  // "🛶"
+    0x00200000,  // "🛷"
+    0x00200001,  // "🛸"
+    0x00200001,  // "🛹"
+    0x00200001,  // "🛺"
+    0x00200000,  // "🛻"
+    0x00200001,  // "🛼"
+    0x00200001,  // "🟠"
+    0x00200000,  // "🟡"
+    0x00200000,  // "🟢"
+    0x00200001,  // "🟣"
+    0x00200000,  // "🟤"
+    0x00200000,  // "🟥"
+    0x00200001,  // "🟦"
+    0x00200000,  // "🟧"
+    0x00200001,  // "🟨"
+    0x00200001,  // "🟩"
+    0x00200000,  // "🟪"
+    0x00200000,  // "🟫"
+    0x00200004,  // "🤌🏻" 1F90C-1F3FB
+    0x00200002,  // "🤌🏼" 1F90C-1F3FC
+    0x00200003,  // "🤌🏽" 1F90C-1F3FD
+    0x00200003,  // "🤌🏾" 1F90C-1F3FE
+    0x00200002,  // "🤌🏿" 1F90C-1F3FF
+    0x00200003,  // "🤌"
+    0x00200001,  // "🤍"
+    0x00200001,  // "🤎"
+    0x00200002,  // "🤏🏻" 1F90F-1F3FB
+    0x00200001,  // "🤏🏼" 1F90F-1F3FC
+    0x00200000,  // "🤏🏾" 1F90F-1F3FE
+    0x00200001,  // "🤏🏿" 1F90F-1F3FF
+    0x00200000,  // "🤐"
+    0x00200001,  // "🤑"
+    0x00200001,  // "🤒"
+    0x00200002,  // "🤔"
+    0x00200001,  // "🤕"
+    0x00200001,  // "🤖"
+    0x00200000,  // "🤗"
+    0x00200007,  // "🤘🏻" 1F918-1F3FB
+    0x00200007,  // "🤘🏼" 1F918-1F3FC
+    0x00200006,  // "🤘🏽" 1F918-1F3FD
+    0x00200006,  // "🤘🏾" 1F918-1F3FE
+    0x00200007,  // "🤘🏿" 1F918-1F3FF
+    0x00200006,  // "🤘"
+    0x00200001,  // "🤙🏻" 1F919-1F3FB
+    0x00200000,  // "🤙🏽" 1F919-1F3FD
+    0x00200000,  // "🤙🏾" 1F919-1F3FE
+    0x00200005,  // "🤚🏻" 1F91A-1F3FB
+    0x00200004,  // "🤚🏼" 1F91A-1F3FC
+    0x00200004,  // "🤚🏽" 1F91A-1F3FD
+    0x00200005,  // "🤚🏾" 1F91A-1F3FE
+    0x00200003,  // "🤚🏿" 1F91A-1F3FF
+    0x00200004,  // "🤚"
+    0x00200000,  // "🤛🏻" 1F91B-1F3FB
+    0x00200001,  // "🤛🏼" 1F91B-1F3FC
+    0x00200001,  // "🤛🏽" 1F91B-1F3FD
+    0x00200000,  // "🤛🏾" 1F91B-1F3FE
+    0x00200000,  // "🤛🏿" 1F91B-1F3FF
+    0x00200000,  // "🤜🏻" 1F91C-1F3FB
+    0x00200001,  // "🤜🏼" 1F91C-1F3FC
+    0x00200001,  // "🤜🏽" 1F91C-1F3FD
+    0x00200001,  // "🤜🏾" 1F91C-1F3FE
+    0x00200000,  // "🤜🏿" 1F91C-1F3FF
+    0x00200001,  // "🤜"
+    0x00200002,  // "🤝"
+    0x00200006,  // "🤞🏻" 1F91E-1F3FB
+    0x00200007,  // "🤞🏼" 1F91E-1F3FC
+    0x00200007,  // "🤞🏽" 1F91E-1F3FD
+    0x00200006,  // "🤞🏾" 1F91E-1F3FE
+    0x00200006,  // "🤞🏿" 1F91E-1F3FF
+    0x00200007,  // "🤞"
+    0x00200002,  // "🤟🏻" 1F91F-1F3FB
+    0x00200001,  // "🤟🏼" 1F91F-1F3FC
+    0x00200002,  // "🤟🏽" 1F91F-1F3FD
+    0x00200002,  // "🤟🏾" 1F91F-1F3FE
+    0x00200002,  // "🤟"
+    0x00200001,  // "🤠"
+    0x00200000,  // "🤡"
+    0x00200000,  // "🤢"
+    0x00200001,  // "🤣"
+    0x00200000,  // "🤤"
+    0x00200001,  // "🤥"
+    0x00200005,  // "🤦🏻\u200d♀️" 1F926-1F3FB-200D-2640-FE0F
+    0x00200005,  // "🤦🏻\u200d♂️" 1F926-1F3FB-200D-2642-FE0F
+    0x00200004,  // "🤦🏻" 1F926-1F3FB
+    0x00200005,  // "🤦🏼\u200d♀️" 1F926-1F3FC-200D-2640-FE0F
+    0x00200005,  // "🤦🏼\u200d♂️" 1F926-1F3FC-200D-2642-FE0F
+    0x00200004,  // "🤦🏼" 1F926-1F3FC
+    0x00200005,  // "🤦🏽\u200d♀️" 1F926-1F3FD-200D-2640-FE0F
+    0x00200005,  // "🤦🏽\u200d♂️" 1F926-1F3FD-200D-2642-FE0F
+    0x00200004,  // "🤦🏽" 1F926-1F3FD
+    0x00200005,  // "🤦🏾\u200d♀️" 1F926-1F3FE-200D-2640-FE0F
+    0x00200005,  // "🤦🏾\u200d♂️" 1F926-1F3FE-200D-2642-FE0F
+    0x00200005,  // "🤦🏾" 1F926-1F3FE
+    0x00200005,  // "🤦🏿\u200d♀️" 1F926-1F3FF-200D-2640-FE0F
+    0x00200005,  // "🤦🏿\u200d♂️" 1F926-1F3FF-200D-2642-FE0F
+    0x00200005,  // "🤦🏿" 1F926-1F3FF
+    0x00200005,  // "🤦\u200d♀️" 1F926-200D-2640-FE0F
+    0x00200005,  // "🤦\u200d♂️" 1F926-200D-2642-FE0F
+    0x00200005,  // "🤦"
+    0x00200001,  // "🤧"
+    0x00200000,  // "🤩"
+    0x00200001,  // "🤪"
+    0x00200000,  // "🤬"
+    0x00200001,  // "🤭"
+    0x00200008,  // "🤰🏻" 1F930-1F3FB
+    0x00200009,  // "🤰🏼" 1F930-1F3FC
+    0x00200008,  // "🤰🏽" 1F930-1F3FD
+    0x00200008,  // "🤰🏾" 1F930-1F3FE
+    0x00200009,  // "🤰🏿" 1F930-1F3FF
+    0x00200008,  // "🤰"
+    0x00200005,  // "🤱🏻" 1F931-1F3FB
+    0x00200006,  // "🤱🏼" 1F931-1F3FC
+    0x00200005,  // "🤱🏽" 1F931-1F3FD
+    0x00200005,  // "🤱🏾" 1F931-1F3FE
+    0x00200006,  // "🤱🏿" 1F931-1F3FF
+    0x00200005,  // "🤱"
+    0x00200002,  // "🤲🏻" 1F932-1F3FB
+    0x00200001,  // "🤲🏼" 1F932-1F3FC
+    0x00200001,  // "🤲🏾" 1F932-1F3FE
+    0x00200000,  // "🤲🏿" 1F932-1F3FF
+    0x00200001,  // "🤲"
+    0x00200006,  // "🤳🏻" 1F933-1F3FB
+    0x00200006,  // "🤳🏼" 1F933-1F3FC
+    0x00200006,  // "🤳🏽" 1F933-1F3FD
+    0x00200006,  // "🤳🏾" 1F933-1F3FE
+    0x00200006,  // "🤳🏿" 1F933-1F3FF
+    0x00200006,  // "🤳"
+    0x00200004,  // "🤴🏻" 1F934-1F3FB
+    0x00200005,  // "🤴🏼" 1F934-1F3FC
+    0x00200004,  // "🤴🏽" 1F934-1F3FD
+    0x00200004,  // "🤴🏾" 1F934-1F3FE
+    0x00200004,  // "🤴🏿" 1F934-1F3FF
+    0x00200004,  // "🤴"
+    0x00200004,  // "🤵🏻\u200d♀️" 1F935-1F3FB-200D-2640-FE0F
+    0x00200004,  // "🤵🏻\u200d♂️" 1F935-1F3FB-200D-2642-FE0F
+    0x00200003,  // "🤵🏻" 1F935-1F3FB
+    0x00200004,  // "🤵🏼\u200d♀️" 1F935-1F3FC-200D-2640-FE0F
+    0x00200004,  // "🤵🏼\u200d♂️" 1F935-1F3FC-200D-2642-FE0F
+    0x00200003,  // "🤵🏼" 1F935-1F3FC
+    0x00200004,  // "🤵🏽\u200d♀️" 1F935-1F3FD-200D-2640-FE0F
+    0x00200004,  // "🤵🏽\u200d♂️" 1F935-1F3FD-200D-2642-FE0F
+    0x00200003,  // "🤵🏽" 1F935-1F3FD
+    0x00200004,  // "🤵🏾\u200d♀️" 1F935-1F3FE-200D-2640-FE0F
+    0x00200004,  // "🤵🏾\u200d♂️" 1F935-1F3FE-200D-2642-FE0F
+    0x00200003,  // "🤵🏾" 1F935-1F3FE
+    0x00200004,  // "🤵🏿\u200d♀️" 1F935-1F3FF-200D-2640-FE0F
+    0x00200004,  // "🤵🏿\u200d♂️" 1F935-1F3FF-200D-2642-FE0F
+    0x00200003,  // "🤵🏿" 1F935-1F3FF
+    0x00200004,  // "🤵\u200d♀️" 1F935-200D-2640-FE0F
+    0x00200004,  // "🤵\u200d♂️" 1F935-200D-2642-FE0F
+    0x00200004,  // "🤵"
+    0x00200003,  // "🤶🏻" 1F936-1F3FB
+    0x00200003,  // "🤶🏼" 1F936-1F3FC
+    0x00200004,  // "🤶🏽" 1F936-1F3FD
+    0x00200003,  // "🤶🏾" 1F936-1F3FE
+    0x00200003,  // "🤶🏿" 1F936-1F3FF
+    0x00200004,  // "🤶"
+    0x00200002,  // "🤷🏻\u200d♀️" 1F937-1F3FB-200D-2640-FE0F
+    0x00200003,  // "🤷🏻\u200d♂️" 1F937-1F3FB-200D-2642-FE0F
+    0x00200001,  // "🤷🏻" 1F937-1F3FB
+    0x00200002,  // "🤷🏼\u200d♀️" 1F937-1F3FC-200D-2640-FE0F
+    0x00200003,  // "🤷🏼\u200d♂️" 1F937-1F3FC-200D-2642-FE0F
+    0x00200001,  // "🤷🏼" 1F937-1F3FC
+    0x00200002,  // "🤷🏽\u200d♀️" 1F937-1F3FD-200D-2640-FE0F
+    0x00200003,  // "🤷🏽\u200d♂️" 1F937-1F3FD-200D-2642-FE0F
+    0x00200001,  // "🤷🏽" 1F937-1F3FD
+    0x00200001,  // "🤷🏾\u200d♀️" 1F937-1F3FE-200D-2640-FE0F
+    0x00200000,  // "🤷🏾\u200d♂️" 1F937-1F3FE-200D-2642-FE0F
+    0x00200001,  // "🤷🏾" 1F937-1F3FE
+    0x00200001,  // "🤷🏿\u200d♀️" 1F937-1F3FF-200D-2640-FE0F
+    0x00200000,  // "🤷🏿\u200d♂️" 1F937-1F3FF-200D-2642-FE0F
+    0x00200001,  // "🤷🏿" 1F937-1F3FF
+    0x00200002,  // "🤷\u200d♀️" 1F937-200D-2640-FE0F
+    0x00200000,  // "🤷\u200d♂️" 1F937-200D-2642-FE0F
+    0x00200001,  // "🤷"
+    0x00200003,  // "🤸🏻\u200d♀️" 1F938-1F3FB-200D-2640-FE0F
+    0x00200003,  // "🤸🏻\u200d♂️" 1F938-1F3FB-200D-2642-FE0F
+    0x00200003,  // "🤸🏻" 1F938-1F3FB
+    0x00200003,  // "🤸🏼\u200d♀️" 1F938-1F3FC-200D-2640-FE0F
+    0x00200003,  // "🤸🏼\u200d♂️" 1F938-1F3FC-200D-2642-FE0F
+    0x00200003,  // "🤸🏼" 1F938-1F3FC
+    0x00200003,  // "🤸🏽\u200d♀️" 1F938-1F3FD-200D-2640-FE0F
+    0x00200003,  // "🤸🏽\u200d♂️" 1F938-1F3FD-200D-2642-FE0F
+    0x00200003,  // "🤸🏽" 1F938-1F3FD
+    0x00200003,  // "🤸🏾\u200d♀️" 1F938-1F3FE-200D-2640-FE0F
+    0x00200003,  // "🤸🏾\u200d♂️" 1F938-1F3FE-200D-2642-FE0F
+    0x00200003,  // "🤸🏾" 1F938-1F3FE
+    0x00200003,  // "🤸🏿\u200d♀️" 1F938-1F3FF-200D-2640-FE0F
+    0x00200003,  // "🤸🏿\u200d♂️" 1F938-1F3FF-200D-2642-FE0F
+    0x00200003,  // "🤸🏿" 1F938-1F3FF
+    0x00200003,  // "🤸\u200d♀️" 1F938-200D-2640-FE0F
+    0x00200003,  // "🤸\u200d♂️" 1F938-200D-2642-FE0F
+    0x00200003,  // "🤸"
+    0x00200001,  // "🤹🏻\u200d♀️" 1F939-1F3FB-200D-2640-FE0F
+    0x00200001,  // "🤹🏻\u200d♂️" 1F939-1F3FB-200D-2642-FE0F
+    0x00200002,  // "🤹🏻" 1F939-1F3FB
+    0x00200001,  // "🤹🏼\u200d♀️" 1F939-1F3FC-200D-2640-FE0F
+    0x00200001,  // "🤹🏼\u200d♂️" 1F939-1F3FC-200D-2642-FE0F
+    0x00200001,  // "🤹🏼" 1F939-1F3FC
+    0x00200000,  // "🤹🏽\u200d♀️" 1F939-1F3FD-200D-2640-FE0F
+    0x00200001,  // "🤹🏽\u200d♂️" 1F939-1F3FD-200D-2642-FE0F
+    0x00200001,  // "🤹🏽" 1F939-1F3FD
+    0x00200000,  // "🤹🏾\u200d♀️" 1F939-1F3FE-200D-2640-FE0F
+    0x00200001,  // "🤹🏾\u200d♂️" 1F939-1F3FE-200D-2642-FE0F
+    0x00200001,  // "🤹🏾" 1F939-1F3FE
+    0x00200000,  // "🤹🏿\u200d♀️" 1F939-1F3FF-200D-2640-FE0F
+    0x00200001,  // "🤹🏿\u200d♂️" 1F939-1F3FF-200D-2642-FE0F
+    0x00200001,  // "🤹🏿" 1F939-1F3FF
+    0x00200000,  // "🤹\u200d♀️" 1F939-200D-2640-FE0F
+    0x00200001,  // "🤹\u200d♂️" 1F939-200D-2642-FE0F
+    0x00200001,  // "🤹"
+    0x00200003,  // "🤺"
+    0x00200001,  // "🤼\u200d♀️" 1F93C-200D-2640-FE0F
+    0x00200001,  // "🤼\u200d♂️" 1F93C-200D-2642-FE0F
+    0x00200001,  // "🤼"
+    0x00200000,  // "🤽🏻\u200d♀️" 1F93D-1F3FB-200D-2640-FE0F
+    0x00200000,  // "🤽🏻\u200d♂️" 1F93D-1F3FB-200D-2642-FE0F
+    0x00200001,  // "🤽🏻" 1F93D-1F3FB
+    0x00200000,  // "🤽🏼\u200d♀️" 1F93D-1F3FC-200D-2640-FE0F
+    0x00200000,  // "🤽🏼\u200d♂️" 1F93D-1F3FC-200D-2642-FE0F
+    0x00200001,  // "🤽🏼" 1F93D-1F3FC
+    0x00200000,  // "🤽🏽\u200d♀️" 1F93D-1F3FD-200D-2640-FE0F
+    0x00200000,  // "🤽🏽\u200d♂️" 1F93D-1F3FD-200D-2642-FE0F
+    0x00200001,  // "🤽🏽" 1F93D-1F3FD
+    0x00200000,  // "🤽🏾\u200d♀️" 1F93D-1F3FE-200D-2640-FE0F
+    0x00200000,  // "🤽🏾\u200d♂️" 1F93D-1F3FE-200D-2642-FE0F
+    0x00200001,  // "🤽🏾" 1F93D-1F3FE
+    0x00200000,  // "🤽🏿\u200d♀️" 1F93D-1F3FF-200D-2640-FE0F
+    0x00200000,  // "🤽🏿\u200d♂️" 1F93D-1F3FF-200D-2642-FE0F
+    0x00200001,  // "🤽🏿" 1F93D-1F3FF
+    0x00200001,  // "🤽\u200d♀️" 1F93D-200D-2640-FE0F
+    0x00200000,  // "🤽\u200d♂️" 1F93D-200D-2642-FE0F
+    0x00200000,  // "🤽"
+    0x00200002,  // "🤾🏻\u200d♀️" 1F93E-1F3FB-200D-2640-FE0F
+    0x00200003,  // "🤾🏻\u200d♂️" 1F93E-1F3FB-200D-2642-FE0F
+    0x00200004,  // "🤾🏻" 1F93E-1F3FB
+    0x00200002,  // "🤾🏼\u200d♀️" 1F93E-1F3FC-200D-2640-FE0F
+    0x00200003,  // "🤾🏼\u200d♂️" 1F93E-1F3FC-200D-2642-FE0F
+    0x00200004,  // "🤾🏼" 1F93E-1F3FC
+    0x00200002,  // "🤾🏽\u200d♀️" 1F93E-1F3FD-200D-2640-FE0F
+    0x00200003,  // "🤾🏽\u200d♂️" 1F93E-1F3FD-200D-2642-FE0F
+    0x00200001,  // "🤾🏽" 1F93E-1F3FD
+    0x00200002,  // "🤾🏾\u200d♀️" 1F93E-1F3FE-200D-2640-FE0F
+    0x00200003,  // "🤾🏾\u200d♂️" 1F93E-1F3FE-200D-2642-FE0F
+    0x00200001,  // "🤾🏾" 1F93E-1F3FE
+    0x00200002,  // "🤾🏿\u200d♀️" 1F93E-1F3FF-200D-2640-FE0F
+    0x00200004,  // "🤾🏿\u200d♂️" 1F93E-1F3FF-200D-2642-FE0F
+    0x00200002,  // "🤾🏿" 1F93E-1F3FF
+    0x0020000C,  // "🤾\u200d♀️" 1F93E-200D-2640-FE0F
+    0x00200008,  // "🤾\u200d♂️" 1F93E-200D-2642-FE0F
+    0x00200009,  // "🤾"
+    0x00200000,  // "🤿"
+    0x00200007,  // "🥀"
+    0x00200000,  // "🥁"
+    0x00200003,  // "🥂"
+    0x00200005,  // "🥃"
+    0x0020000A,  // "🥄"
+    0x00200001,  // "🥅"
+    0x00200000,  // "🥈"
+    0x00200001,  // "🥉"
+    0x00200004,  // "🥊"
+    0x00200002,  // "🥋"
+    0x00200000,  // "🥌"
+    0x00200000,  // "🥍"
+    0x00200002,  // "🥏"
+    0x00200001,  // "🥐"
+    0x00200001,  // "🥑"
+    0x00200002,  // "🥒"
+    0x00200001,  // "🥓"
+    0x00200001,  // "🥔"
+    0x00200000,  // "🥕"
+    0x00200003,  // "🥖"
+    0x00200000,  // "🥘"
+    0x00200001,  // "🥙"
+    0x00200007,  // "🥚"
+    0x00200007,  // "🥛"
+    0x00200001,  // "🥜"
+    0x00200001,  // "🥝"
+    0x00200001,  // "🥞"
+    0x00200000,  // "🥟"
+    0x00200001,  // "🥠"
+    0x00200002,  // "🥡"
+    0x00200003,  // "🥢"
+    0x00200000,  // "🥣"
+    0x00200004,  // "🥤"
+    0x00200001,  // "🥥"
+    0x00200003,  // "🥦"
+    0x00200002,  // "🥨"
+    0x00200001,  // "🥩"
+    0x00200007,  // "🥫"
+    0x00200000,  // "🥬"
+    0x00200000,  // "🥭"
+    0x00200000,  // "🥯"
+    0x00200000,  // "🥰"
+    0x00200000,  // "🥲"
+    0x00200000,  // "🥳"
+    0x00200000,  // "🥵"
+    0x00200000,  // "🥶"
+    0x00200001,  // "🥷🏻" 1F977-1F3FB
+    0x00200002,  // "🥷🏼" 1F977-1F3FC
+    0x00200001,  // "🥷🏽" 1F977-1F3FD
+    0x00200001,  // "🥷🏾" 1F977-1F3FE
+    0x00200002,  // "🥷🏿" 1F977-1F3FF
+    0x00200002,  // "🥷"
+    0x00200001,  // "🥸"
+    0x00200005,  // "🥻"
+    0x00200001,  // "🥼"
+    0x00200000,  // "🥽"
+    0x00200000,  // "🥾"
+    0x00200000,  // "🥿"
+    0x00200001,  // "🦀"
+    0x00200000,  // "🦁"
+    0x00200001,  // "🦂"
+    0x00200001,  // "🦃"
+    0x00200001,  // "🦄"
+    0x00200003,  // "🦅"
+    0x00200004,  // "🦆"
+    0x00200001,  // "🦇"
+    0x00200000,  // "🦈"
+    0x00200004,  // "🦉"
+    0x00200001,  // "🦊"
+    0x00200000,  // "🦋"
+    0x00200000,  // "🦌"
+    0x00200001,  // "🦍"
+    0x00200000,  // "🦎"
+    0x00200001,  // "🦏"
+    0x00200001,  // "🦐"
+    0x00200003,  // "🦑"
+    0x00200003,  // "🦒"
+    0x00200001,  // "🦓"
+    0x00200001,  // "🦔"
+    0x00200002,  // "🦕"
+    0x00200002,  // "🦖"
+    0x00200001,  // "🦗"
+    0x00200000,  // "🦘"
+    0x00200003,  // "🦙"
+    0x00200000,  // "🦛"
+    0x00200003,  // "🦜"
+    0x00200001,  // "🦝"
+    0x00200003,  // "🦞"
+    0x00200001,  // "🦟"
+    0x00200001,  // "🦠"
+    0x00200001,  // "🦡"
+    0x00200001,  // "🦢"
+    0x00200001,  // "🦣"
+    0x00200005,  // "🦤"
+    0x00200000,  // "🦥"
+    0x00200001,  // "🦦"
+    0x00200001,  // "🦧"
+    0x00200000,  // "🦨"
+    0x00200001,  // "🦩"
+    0x00200001,  // "🦪"
+    0x00200001,  // "🦫"
+    0x00200000,  // "🦬"
+    0x00200001,  // "🦭"
+    0x00200000,  // "🦮"
+    0x00200001,  // "🦯"
+    0x00200001,  // "🦰"
+    0x00200000,  // "🦱"
+    0x00200001,  // "🦲"
+    0x00200000,  // "🦴"
+    0x00200006,  // "🦵🏻" 1F9B5-1F3FB
+    0x00200005,  // "🦵🏼" 1F9B5-1F3FC
+    0x00200005,  // "🦵🏽" 1F9B5-1F3FD
+    0x00200005,  // "🦵🏾" 1F9B5-1F3FE
+    0x00200004,  // "🦵🏿" 1F9B5-1F3FF
+    0x00200005,  // "🦵"
+    0x00200002,  // "🦶🏻" 1F9B6-1F3FB
+    0x00200001,  // "🦶🏼" 1F9B6-1F3FC
+    0x00200001,  // "🦶🏾" 1F9B6-1F3FE
+    0x00200000,  // "🦶🏿" 1F9B6-1F3FF
+    0x00200006,  // "🦷"
+    0x00200002,  // "🦸🏻\u200d♀️" 1F9B8-1F3FB-200D-2640-FE0F
+    0x00200000,  // "🦸🏻\u200d♂️" 1F9B8-1F3FB-200D-2642-FE0F
+    0x00200001,  // "🦸🏻" 1F9B8-1F3FB
+    0x00200002,  // "🦸🏼\u200d♀️" 1F9B8-1F3FC-200D-2640-FE0F
+    0x00200000,  // "🦸🏼\u200d♂️" 1F9B8-1F3FC-200D-2642-FE0F
+    0x00200001,  // "🦸🏼" 1F9B8-1F3FC
+    0x00200002,  // "🦸🏽\u200d♀️" 1F9B8-1F3FD-200D-2640-FE0F
+    0x00200000,  // "🦸🏽\u200d♂️" 1F9B8-1F3FD-200D-2642-FE0F
+    0x00200001,  // "🦸🏽" 1F9B8-1F3FD
+    0x00200002,  // "🦸🏾\u200d♀️" 1F9B8-1F3FE-200D-2640-FE0F
+    0x00200000,  // "🦸🏾\u200d♂️" 1F9B8-1F3FE-200D-2642-FE0F
+    0x00200001,  // "🦸🏾" 1F9B8-1F3FE
+    0x00200002,  // "🦸🏿\u200d♀️" 1F9B8-1F3FF-200D-2640-FE0F
+    0x00200000,  // "🦸🏿\u200d♂️" 1F9B8-1F3FF-200D-2642-FE0F
+    0x00200001,  // "🦸🏿" 1F9B8-1F3FF
+    0x00200002,  // "🦸\u200d♀️" 1F9B8-200D-2640-FE0F
+    0x00200000,  // "🦸\u200d♂️" 1F9B8-200D-2642-FE0F
+    0x00200001,  // "🦸"
+    0x00200003,  // "🦹🏻\u200d♀️" 1F9B9-1F3FB-200D-2640-FE0F
+    0x00200001,  // "🦹🏻\u200d♂️" 1F9B9-1F3FB-200D-2642-FE0F
+    0x00200002,  // "🦹🏻" 1F9B9-1F3FB
+    0x00200004,  // "🦹🏼\u200d♀️" 1F9B9-1F3FC-200D-2640-FE0F
+    0x00200002,  // "🦹🏼\u200d♂️" 1F9B9-1F3FC-200D-2642-FE0F
+    0x00200003,  // "🦹🏼" 1F9B9-1F3FC
+    0x00200004,  // "🦹🏽\u200d♀️" 1F9B9-1F3FD-200D-2640-FE0F
+    0x00200002,  // "🦹🏽\u200d♂️" 1F9B9-1F3FD-200D-2642-FE0F
+    0x00200003,  // "🦹🏽" 1F9B9-1F3FD
+    0x00200004,  // "🦹🏾\u200d♀️" 1F9B9-1F3FE-200D-2640-FE0F
+    0x00200002,  // "🦹🏾\u200d♂️" 1F9B9-1F3FE-200D-2642-FE0F
+    0x00200003,  // "🦹🏾" 1F9B9-1F3FE
+    0x00200004,  // "🦹🏿\u200d♀️" 1F9B9-1F3FF-200D-2640-FE0F
+    0x00200002,  // "🦹🏿\u200d♂️" 1F9B9-1F3FF-200D-2642-FE0F
+    0x00200003,  // "🦹🏿" 1F9B9-1F3FF
+    0x00200004,  // "🦹\u200d♀️" 1F9B9-200D-2640-FE0F
+    0x00200002,  // "🦹\u200d♂️" 1F9B9-200D-2642-FE0F
+    0x00200003,  // "🦹"
+    0x00200004,  // "🦻🏻" 1F9BB-1F3FB
+    0x00200003,  // "🦻🏼" 1F9BB-1F3FC
+    0x00200003,  // "🦻🏽" 1F9BB-1F3FD
+    0x00200004,  // "🦻🏾" 1F9BB-1F3FE
+    0x00200003,  // "🦻🏿" 1F9BB-1F3FF
+    0x00200004,  // "🦻"
+    0x00200004,  // "🦼"
+    0x00200003,  // "🦽"
+    0x00200001,  // "🦾"
+    0x00200005,  // "🦿"
+    0x00200002,  // "🧀"
+    0x00200003,  // "🧁"
+    0x00200007,  // "🧂"
+    0x00200008,  // "🧃"
+    0x00200003,  // "🧄"
+    0x00200004,  // "🧅"
+    0x00200000,  // "🧆"
+    0x00200000,  // "🧇"
+    0x00200001,  // "🧈"
+    0x00200005,  // "🧉"
+    0x00200002,  // "🧊"
+    0x00200006,  // "🧋"
+    0x0020000B,  // "🧍🏻\u200d♀️" 1F9CD-1F3FB-200D-2640-FE0F
+    0x0020000B,  // "🧍🏻\u200d♂️" 1F9CD-1F3FB-200D-2642-FE0F
+    0x0020000A,  // "🧍🏻" 1F9CD-1F3FB
+    0x0020000B,  // "🧍🏼\u200d♀️" 1F9CD-1F3FC-200D-2640-FE0F
+    0x0020000B,  // "🧍🏼\u200d♂️" 1F9CD-1F3FC-200D-2642-FE0F
+    0x0020000A,  // "🧍🏼" 1F9CD-1F3FC
+    0x0020000B,  // "🧍🏽\u200d♀️" 1F9CD-1F3FD-200D-2640-FE0F
+    0x0020000B,  // "🧍🏽\u200d♂️" 1F9CD-1F3FD-200D-2642-FE0F
+    0x0020000A,  // "🧍🏽" 1F9CD-1F3FD
+    0x0020000B,  // "🧍🏾\u200d♀️" 1F9CD-1F3FE-200D-2640-FE0F
+    0x0020000A,  // "🧍🏾\u200d♂️" 1F9CD-1F3FE-200D-2642-FE0F
+    0x0020000B,  // "🧍🏾" 1F9CD-1F3FE
+    0x0020000B,  // "🧍🏿\u200d♀️" 1F9CD-1F3FF-200D-2640-FE0F
+    0x0020000A,  // "🧍🏿\u200d♂️" 1F9CD-1F3FF-200D-2642-FE0F
+    0x0020000B,  // "🧍🏿" 1F9CD-1F3FF
+    0x0020000C,  // "🧍\u200d♀️" 1F9CD-200D-2640-FE0F
+    0x0020000B,  // "🧍\u200d♂️" 1F9CD-200D-2642-FE0F
+    0x0020000B,  // "🧍"
+    0x00200007,  // "🧎🏻\u200d♀️" 1F9CE-1F3FB-200D-2640-FE0F
+    0x00200007,  // "🧎🏻\u200d♂️" 1F9CE-1F3FB-200D-2642-FE0F
+    0x00200008,  // "🧎🏻" 1F9CE-1F3FB
+    0x00200007,  // "🧎🏼\u200d♀️" 1F9CE-1F3FC-200D-2640-FE0F
+    0x00200007,  // "🧎🏼\u200d♂️" 1F9CE-1F3FC-200D-2642-FE0F
+    0x00200008,  // "🧎🏼" 1F9CE-1F3FC
+    0x00200007,  // "🧎🏽\u200d♀️" 1F9CE-1F3FD-200D-2640-FE0F
+    0x00200007,  // "🧎🏽\u200d♂️" 1F9CE-1F3FD-200D-2642-FE0F
+    0x00200007,  // "🧎🏽" 1F9CE-1F3FD
+    0x00200008,  // "🧎🏾\u200d♀️" 1F9CE-1F3FE-200D-2640-FE0F
+    0x00200008,  // "🧎🏾\u200d♂️" 1F9CE-1F3FE-200D-2642-FE0F
+    0x00200007,  // "🧎🏾" 1F9CE-1F3FE
+    0x00200008,  // "🧎🏿\u200d♀️" 1F9CE-1F3FF-200D-2640-FE0F
+    0x00200008,  // "🧎🏿\u200d♂️" 1F9CE-1F3FF-200D-2642-FE0F
+    0x00200007,  // "🧎🏿" 1F9CE-1F3FF
+    0x00200008,  // "🧎\u200d♀️" 1F9CE-200D-2640-FE0F
+    0x00200008,  // "🧎\u200d♂️" 1F9CE-200D-2642-FE0F
+    0x00200007,  // "🧎"
+    0x00200002,  // "🧏🏻\u200d♀️" 1F9CF-1F3FB-200D-2640-FE0F
+    0x00200001,  // "🧏🏻\u200d♂️" 1F9CF-1F3FB-200D-2642-FE0F
+    0x00200002,  // "🧏🏼\u200d♀️" 1F9CF-1F3FC-200D-2640-FE0F
+    0x00200001,  // "🧏🏼\u200d♂️" 1F9CF-1F3FC-200D-2642-FE0F
+    0x00200000,  // "🧏🏽\u200d♂️" 1F9CF-1F3FD-200D-2642-FE0F
+    0x00200001,  // "🧏🏽" 1F9CF-1F3FD
+    0x00200000,  // "🧏🏾\u200d♂️" 1F9CF-1F3FE-200D-2642-FE0F
+    0x00200001,  // "🧏🏾" 1F9CF-1F3FE
+    0x00200000,  // "🧏🏿\u200d♂️" 1F9CF-1F3FF-200D-2642-FE0F
+    0x00200001,  // "🧏🏿" 1F9CF-1F3FF
+    0x00200000,  // "🧏\u200d♂️" 1F9CF-200D-2642-FE0F
+    0x00200001,  // "🧏"
+    0x00200001,  // "🧐"
+    0x00200000,  // "🧑🏻\u200d🌾" 1F9D1-1F3FB-200D-1F33E
+    0x00200001,  // "🧑🏻\u200d🍳" 1F9D1-1F3FB-200D-1F373
+    0x00200005,  // "🧑🏻\u200d🍼" 1F9D1-1F3FB-200D-1F37C
+    0x00200002,  // "🧑🏻\u200d🎄" 1F9D1-1F3FB-200D-1F384
+    0x00200003,  // "🧑🏻\u200d🎓" 1F9D1-1F3FB-200D-1F393
+    0x00200000,  // "🧑🏻\u200d🎤" 1F9D1-1F3FB-200D-1F3A4
+    0x00200002,  // "🧑🏻\u200d🎨" 1F9D1-1F3FB-200D-1F3A8
+    0x00200000,  // "🧑🏻\u200d🏫" 1F9D1-1F3FB-200D-1F3EB
+    0x00200000,  // "🧑🏻\u200d🏭" 1F9D1-1F3FB-200D-1F3ED
+    0x00200000,  // "🧑🏻\u200d💼" 1F9D1-1F3FB-200D-1F4BC
+    0x00200000,  // "🧑🏻\u200d🔧" 1F9D1-1F3FB-200D-1F527
+    0x00200000,  // "🧑🏻\u200d🚀" 1F9D1-1F3FB-200D-1F680
+    0x00200004,  // "🧑🏻\u200d🚒" 1F9D1-1F3FB-200D-1F692
+    0x00200002,  // "🧑🏻\u200d🤝\u200d🧑🏻" 1F9D1-1F3FB-200D-1F91D-200D-1F9D1-1F3FB
+    0x00200003,  // "🧑🏻\u200d🤝\u200d🧑🏼" 1F9D1-1F3FB-200D-1F91D-200D-1F9D1-1F3FC
+    0x00200003,  // "🧑🏻\u200d🤝\u200d🧑🏽" 1F9D1-1F3FB-200D-1F91D-200D-1F9D1-1F3FD
+    0x00200002,  // "🧑🏻\u200d🤝\u200d🧑🏾" 1F9D1-1F3FB-200D-1F91D-200D-1F9D1-1F3FE
+    0x00200004,  // "🧑🏻\u200d🤝\u200d🧑🏿" 1F9D1-1F3FB-200D-1F91D-200D-1F9D1-1F3FF
+    0x00200002,  // "🧑🏻\u200d🦯" 1F9D1-1F3FB-200D-1F9AF
+    0x00200003,  // "🧑🏻\u200d🦰" 1F9D1-1F3FB-200D-1F9B0
+    0x00200003,  // "🧑🏻\u200d🦱" 1F9D1-1F3FB-200D-1F9B1
+    0x00200005,  // "🧑🏻\u200d🦲" 1F9D1-1F3FB-200D-1F9B2
+    0x00200004,  // "🧑🏻\u200d🦳" 1F9D1-1F3FB-200D-1F9B3
+    0x00200002,  // "🧑🏻\u200d🦼" 1F9D1-1F3FB-200D-1F9BC
+    0x00200002,  // "🧑🏻\u200d🦽" 1F9D1-1F3FB-200D-1F9BD
+    0x00200004,  // "🧑🏻\u200d⚕️" 1F9D1-1F3FB-200D-2695-FE0F
+    0x00200001,  // "🧑🏻\u200d⚖️" 1F9D1-1F3FB-200D-2696-FE0F
+    0x00200005,  // "🧑🏻\u200d✈️" 1F9D1-1F3FB-200D-2708-FE0F
+    0x00200003,  // "🧑🏻" 1F9D1-1F3FB
+    0x00200001,  // "🧑🏼\u200d🌾" 1F9D1-1F3FC-200D-1F33E
+    0x00200002,  // "🧑🏼\u200d🍳" 1F9D1-1F3FC-200D-1F373
+    0x00200006,  // "🧑🏼\u200d🍼" 1F9D1-1F3FC-200D-1F37C
+    0x00200002,  // "🧑🏼\u200d🎄" 1F9D1-1F3FC-200D-1F384
+    0x00200004,  // "🧑🏼\u200d🎓" 1F9D1-1F3FC-200D-1F393
+    0x00200000,  // "🧑🏼\u200d🎤" 1F9D1-1F3FC-200D-1F3A4
+    0x00200001,  // "🧑🏼\u200d🎨" 1F9D1-1F3FC-200D-1F3A8
+    0x00200000,  // "🧑🏼\u200d🏭" 1F9D1-1F3FC-200D-1F3ED
+    0x00200001,  // "🧑🏼\u200d💻" 1F9D1-1F3FC-200D-1F4BB
+    0x00200001,  // "🧑🏼\u200d💼" 1F9D1-1F3FC-200D-1F4BC
+    0x00200000,  // "🧑🏼\u200d🔧" 1F9D1-1F3FC-200D-1F527
+    0x00200001,  // "🧑🏼\u200d🔬" 1F9D1-1F3FC-200D-1F52C
+    0x00200003,  // "🧑🏼\u200d🚒" 1F9D1-1F3FC-200D-1F692
+    0x00200003,  // "🧑🏼\u200d🤝\u200d🧑🏻" 1F9D1-1F3FC-200D-1F91D-200D-1F9D1-1F3FB
+    0x00200002,  // "🧑🏼\u200d🤝\u200d🧑🏼" 1F9D1-1F3FC-200D-1F91D-200D-1F9D1-1F3FC
+    0x00200003,  // "🧑🏼\u200d🤝\u200d🧑🏽" 1F9D1-1F3FC-200D-1F91D-200D-1F9D1-1F3FD
+    0x00200003,  // "🧑🏼\u200d🤝\u200d🧑🏾" 1F9D1-1F3FC-200D-1F91D-200D-1F9D1-1F3FE
+    0x00200002,  // "🧑🏼\u200d🤝\u200d🧑🏿" 1F9D1-1F3FC-200D-1F91D-200D-1F9D1-1F3FF
+    0x00200001,  // "🧑🏼\u200d🦯" 1F9D1-1F3FC-200D-1F9AF
+    0x00200003,  // "🧑🏼\u200d🦰" 1F9D1-1F3FC-200D-1F9B0
+    0x00200003,  // "🧑🏼\u200d🦱" 1F9D1-1F3FC-200D-1F9B1
+    0x00200004,  // "🧑🏼\u200d🦲" 1F9D1-1F3FC-200D-1F9B2
+    0x00200003,  // "🧑🏼\u200d🦳" 1F9D1-1F3FC-200D-1F9B3
+    0x00200003,  // "🧑🏼\u200d🦼" 1F9D1-1F3FC-200D-1F9BC
+    0x00200002,  // "🧑🏼\u200d🦽" 1F9D1-1F3FC-200D-1F9BD
+    0x00200003,  // "🧑🏼\u200d⚕️" 1F9D1-1F3FC-200D-2695-FE0F
+    0x00200001,  // "🧑🏼\u200d⚖️" 1F9D1-1F3FC-200D-2696-FE0F
+    0x00200004,  // "🧑🏼\u200d✈️" 1F9D1-1F3FC-200D-2708-FE0F
+    0x00200003,  // "🧑🏼" 1F9D1-1F3FC
+    0x00200000,  // "🧑🏽\u200d🌾" 1F9D1-1F3FD-200D-1F33E
+    0x00200006,  // "🧑🏽\u200d🍼" 1F9D1-1F3FD-200D-1F37C
+    0x00200001,  // "🧑🏽\u200d🎄" 1F9D1-1F3FD-200D-1F384
+    0x00200004,  // "🧑🏽\u200d🎓" 1F9D1-1F3FD-200D-1F393
+    0x00200001,  // "🧑🏽\u200d🎤" 1F9D1-1F3FD-200D-1F3A4
+    0x00200002,  // "🧑🏽\u200d🎨" 1F9D1-1F3FD-200D-1F3A8
+    0x00200000,  // "🧑🏽\u200d🏫" 1F9D1-1F3FD-200D-1F3EB
+    0x00200001,  // "🧑🏽\u200d🏭" 1F9D1-1F3FD-200D-1F3ED
+    0x00200000,  // "🧑🏽\u200d💻" 1F9D1-1F3FD-200D-1F4BB
+    0x00200001,  // "🧑🏽\u200d💼" 1F9D1-1F3FD-200D-1F4BC
+    0x00200000,  // "🧑🏽\u200d🔬" 1F9D1-1F3FD-200D-1F52C
+    0x00200001,  // "🧑🏽\u200d🚀" 1F9D1-1F3FD-200D-1F680
+    0x00200004,  // "🧑🏽\u200d🚒" 1F9D1-1F3FD-200D-1F692
+    0x00200002,  // "🧑🏽\u200d🤝\u200d🧑🏻" 1F9D1-1F3FD-200D-1F91D-200D-1F9D1-1F3FB
+    0x00200003,  // "🧑🏽\u200d🤝\u200d🧑🏼" 1F9D1-1F3FD-200D-1F91D-200D-1F9D1-1F3FC
+    0x00200002,  // "🧑🏽\u200d🤝\u200d🧑🏽" 1F9D1-1F3FD-200D-1F91D-200D-1F9D1-1F3FD
+    0x00200002,  // "🧑🏽\u200d🤝\u200d🧑🏾" 1F9D1-1F3FD-200D-1F91D-200D-1F9D1-1F3FE
+    0x00200003,  // "🧑🏽\u200d🤝\u200d🧑🏿" 1F9D1-1F3FD-200D-1F91D-200D-1F9D1-1F3FF
+    0x00200001,  // "🧑🏽\u200d🦯" 1F9D1-1F3FD-200D-1F9AF
+    0x00200003,  // "🧑🏽\u200d🦰" 1F9D1-1F3FD-200D-1F9B0
+    0x00200003,  // "🧑🏽\u200d🦱" 1F9D1-1F3FD-200D-1F9B1
+    0x00200004,  // "🧑🏽\u200d🦲" 1F9D1-1F3FD-200D-1F9B2
+    0x00200004,  // "🧑🏽\u200d🦳" 1F9D1-1F3FD-200D-1F9B3
+    0x00200002,  // "🧑🏽\u200d🦼" 1F9D1-1F3FD-200D-1F9BC
+    0x00200003,  // "🧑🏽\u200d🦽" 1F9D1-1F3FD-200D-1F9BD
+    0x00200004,  // "🧑🏽\u200d⚕️" 1F9D1-1F3FD-200D-2695-FE0F
+    0x00200000,  // "🧑🏽\u200d⚖️" 1F9D1-1F3FD-200D-2696-FE0F
+    0x00200004,  // "🧑🏽\u200d✈️" 1F9D1-1F3FD-200D-2708-FE0F
+    0x00200003,  // "🧑🏽" 1F9D1-1F3FD
+    0x00200000,  // "🧑🏾\u200d🌾" 1F9D1-1F3FE-200D-1F33E
+    0x00200001,  // "🧑🏾\u200d🍳" 1F9D1-1F3FE-200D-1F373
+    0x00200005,  // "🧑🏾\u200d🍼" 1F9D1-1F3FE-200D-1F37C
+    0x00200002,  // "🧑🏾\u200d🎄" 1F9D1-1F3FE-200D-1F384
+    0x00200004,  // "🧑🏾\u200d🎓" 1F9D1-1F3FE-200D-1F393
+    0x00200001,  // "🧑🏾\u200d🎤" 1F9D1-1F3FE-200D-1F3A4
+    0x00200001,  // "🧑🏾\u200d🎨" 1F9D1-1F3FE-200D-1F3A8
+    0x00200000,  // "🧑🏾\u200d🏫" 1F9D1-1F3FE-200D-1F3EB
+    0x00200000,  // "🧑🏾\u200d🏭" 1F9D1-1F3FE-200D-1F3ED
+    0x00200000,  // "🧑🏾\u200d💼" 1F9D1-1F3FE-200D-1F4BC
+    0x00200000,  // "🧑🏾\u200d🔧" 1F9D1-1F3FE-200D-1F527
+    0x00200000,  // "🧑🏾\u200d🚀" 1F9D1-1F3FE-200D-1F680
+    0x00200004,  // "🧑🏾\u200d🚒" 1F9D1-1F3FE-200D-1F692
+    0x00200002,  // "🧑🏾\u200d🤝\u200d🧑🏻" 1F9D1-1F3FE-200D-1F91D-200D-1F9D1-1F3FB
+    0x00200003,  // "🧑🏾\u200d🤝\u200d🧑🏼" 1F9D1-1F3FE-200D-1F91D-200D-1F9D1-1F3FC
+    0x00200003,  // "🧑🏾\u200d🤝\u200d🧑🏽" 1F9D1-1F3FE-200D-1F91D-200D-1F9D1-1F3FD
+    0x00200002,  // "🧑🏾\u200d🤝\u200d🧑🏾" 1F9D1-1F3FE-200D-1F91D-200D-1F9D1-1F3FE
+    0x00200003,  // "🧑🏾\u200d🤝\u200d🧑🏿" 1F9D1-1F3FE-200D-1F91D-200D-1F9D1-1F3FF
+    0x00200001,  // "🧑🏾\u200d🦯" 1F9D1-1F3FE-200D-1F9AF
+    0x00200003,  // "🧑🏾\u200d🦰" 1F9D1-1F3FE-200D-1F9B0
+    0x00200003,  // "🧑🏾\u200d🦱" 1F9D1-1F3FE-200D-1F9B1
+    0x00200003,  // "🧑🏾\u200d🦲" 1F9D1-1F3FE-200D-1F9B2
+    0x00200004,  // "🧑🏾\u200d🦳" 1F9D1-1F3FE-200D-1F9B3
+    0x00200002,  // "🧑🏾\u200d🦼" 1F9D1-1F3FE-200D-1F9BC
+    0x00200002,  // "🧑🏾\u200d🦽" 1F9D1-1F3FE-200D-1F9BD
+    0x00200004,  // "🧑🏾\u200d⚕️" 1F9D1-1F3FE-200D-2695-FE0F
+    0x00200001,  // "🧑🏾\u200d⚖️" 1F9D1-1F3FE-200D-2696-FE0F
+    0x00200005,  // "🧑🏾\u200d✈️" 1F9D1-1F3FE-200D-2708-FE0F
+    0x00200003,  // "🧑🏾" 1F9D1-1F3FE
+    0x00200001,  // "🧑🏿\u200d🌾" 1F9D1-1F3FF-200D-1F33E
+    0x00200002,  // "🧑🏿\u200d🍳" 1F9D1-1F3FF-200D-1F373
+    0x00200006,  // "🧑🏿\u200d🍼" 1F9D1-1F3FF-200D-1F37C
+    0x00200002,  // "🧑🏿\u200d🎄" 1F9D1-1F3FF-200D-1F384
+    0x00200003,  // "🧑🏿\u200d🎓" 1F9D1-1F3FF-200D-1F393
+    0x00200000,  // "🧑🏿\u200d🎤" 1F9D1-1F3FF-200D-1F3A4
+    0x00200001,  // "🧑🏿\u200d🎨" 1F9D1-1F3FF-200D-1F3A8
+    0x00200000,  // "🧑🏿\u200d🏭" 1F9D1-1F3FF-200D-1F3ED
+    0x00200001,  // "🧑🏿\u200d💻" 1F9D1-1F3FF-200D-1F4BB
+    0x00200001,  // "🧑🏿\u200d💼" 1F9D1-1F3FF-200D-1F4BC
+    0x00200000,  // "🧑🏿\u200d🔧" 1F9D1-1F3FF-200D-1F527
+    0x00200001,  // "🧑🏿\u200d🔬" 1F9D1-1F3FF-200D-1F52C
+    0x00200003,  // "🧑🏿\u200d🚒" 1F9D1-1F3FF-200D-1F692
+    0x00200003,  // "🧑🏿\u200d🤝\u200d🧑🏻" 1F9D1-1F3FF-200D-1F91D-200D-1F9D1-1F3FB
+    0x00200002,  // "🧑🏿\u200d🤝\u200d🧑🏼" 1F9D1-1F3FF-200D-1F91D-200D-1F9D1-1F3FC
+    0x00200002,  // "🧑🏿\u200d🤝\u200d🧑🏽" 1F9D1-1F3FF-200D-1F91D-200D-1F9D1-1F3FD
+    0x00200003,  // "🧑🏿\u200d🤝\u200d🧑🏾" 1F9D1-1F3FF-200D-1F91D-200D-1F9D1-1F3FE
+    0x00200002,  // "🧑🏿\u200d🤝\u200d🧑🏿" 1F9D1-1F3FF-200D-1F91D-200D-1F9D1-1F3FF
+    0x00200001,  // "🧑🏿\u200d🦯" 1F9D1-1F3FF-200D-1F9AF
+    0x00200003,  // "🧑🏿\u200d🦰" 1F9D1-1F3FF-200D-1F9B0
+    0x00200003,  // "🧑🏿\u200d🦱" 1F9D1-1F3FF-200D-1F9B1
+    0x00200004,  // "🧑🏿\u200d🦲" 1F9D1-1F3FF-200D-1F9B2
+    0x00200003,  // "🧑🏿\u200d🦳" 1F9D1-1F3FF-200D-1F9B3
+    0x00200003,  // "🧑🏿\u200d🦼" 1F9D1-1F3FF-200D-1F9BC
+    0x00200002,  // "🧑🏿\u200d🦽" 1F9D1-1F3FF-200D-1F9BD
+    0x00200003,  // "🧑🏿\u200d⚕️" 1F9D1-1F3FF-200D-2695-FE0F
+    0x00200001,  // "🧑🏿\u200d⚖️" 1F9D1-1F3FF-200D-2696-FE0F
+    0x00200004,  // "🧑🏿\u200d✈️" 1F9D1-1F3FF-200D-2708-FE0F
+    0x00200003,  // "🧑🏿" 1F9D1-1F3FF
+    0x00200000,  // "🧑\u200d🌾" 1F9D1-200D-1F33E
+    0x00200006,  // "🧑\u200d🍼" 1F9D1-200D-1F37C
+    0x00200001,  // "🧑\u200d🎄" 1F9D1-200D-1F384
+    0x00200004,  // "🧑\u200d🎓" 1F9D1-200D-1F393
+    0x00200000,  // "🧑\u200d🎤" 1F9D1-200D-1F3A4
+    0x00200001,  // "🧑\u200d🎨" 1F9D1-200D-1F3A8
+    0x00200001,  // "🧑\u200d🏭" 1F9D1-200D-1F3ED
+    0x00200000,  // "🧑\u200d💻" 1F9D1-200D-1F4BB
+    0x00200001,  // "🧑\u200d💼" 1F9D1-200D-1F4BC
+    0x00200000,  // "🧑\u200d🔬" 1F9D1-200D-1F52C
+    0x00200001,  // "🧑\u200d🚀" 1F9D1-200D-1F680
+    0x00200004,  // "🧑\u200d🚒" 1F9D1-200D-1F692
+    0x00200003,  // "🧑\u200d🤝\u200d🧑" 1F9D1-200D-1F91D-200D-1F9D1
+    0x00200001,  // "🧑\u200d🦯" 1F9D1-200D-1F9AF
+    0x00200003,  // "🧑\u200d🦰" 1F9D1-200D-1F9B0
+    0x00200003,  // "🧑\u200d🦱" 1F9D1-200D-1F9B1
+    0x00200004,  // "🧑\u200d🦲" 1F9D1-200D-1F9B2
+    0x00200004,  // "🧑\u200d🦳" 1F9D1-200D-1F9B3
+    0x00200003,  // "🧑\u200d🦼" 1F9D1-200D-1F9BC
+    0x00200003,  // "🧑\u200d🦽" 1F9D1-200D-1F9BD
+    0x00200004,  // "🧑\u200d⚕️" 1F9D1-200D-2695-FE0F
+    0x00200001,  // "🧑\u200d⚖️" 1F9D1-200D-2696-FE0F
+    0x00200005,  // "🧑\u200d✈️" 1F9D1-200D-2708-FE0F
+    0x00200003,  // "🧑"
+    0x00200004,  // "🧒🏻" 1F9D2-1F3FB
+    0x00200005,  // "🧒🏼" 1F9D2-1F3FC
+    0x00200004,  // "🧒🏽" 1F9D2-1F3FD
+    0x00200004,  // "🧒🏾" 1F9D2-1F3FE
+    0x00200004,  // "🧒🏿" 1F9D2-1F3FF
+    0x00200004,  // "🧒"
+    0x00200004,  // "🧓🏻" 1F9D3-1F3FB
+    0x00200003,  // "🧓🏼" 1F9D3-1F3FC
+    0x00200003,  // "🧓🏽" 1F9D3-1F3FD
+    0x00200004,  // "🧓🏾" 1F9D3-1F3FE
+    0x00200003,  // "🧓🏿" 1F9D3-1F3FF
+    0x00200003,  // "🧓"
+    0x00200003,  // "🧔🏻" 1F9D4-1F3FB
+    0x00200004,  // "🧔🏼" 1F9D4-1F3FC
+    0x00200003,  // "🧔🏽" 1F9D4-1F3FD
+    0x00200003,  // "🧔🏾" 1F9D4-1F3FE
+    0x00200003,  // "🧔🏿" 1F9D4-1F3FF
+    0x00200003,  // "🧔"
+    0x00200004,  // "🧕🏻" 1F9D5-1F3FB
+    0x00200005,  // "🧕🏼" 1F9D5-1F3FC
+    0x00200005,  // "🧕🏽" 1F9D5-1F3FD
+    0x00200004,  // "🧕🏾" 1F9D5-1F3FE
+    0x00200005,  // "🧕🏿" 1F9D5-1F3FF
+    0x00200005,  // "🧕"
+    0x00200001,  // "🧖🏻\u200d♀️" 1F9D6-1F3FB-200D-2640-FE0F
+    0x00200000,  // "🧖🏻" 1F9D6-1F3FB
+    0x00200001,  // "🧖🏼\u200d♀️" 1F9D6-1F3FC-200D-2640-FE0F
+    0x00200000,  // "🧖🏽\u200d♀️" 1F9D6-1F3FD-200D-2640-FE0F
+    0x00200001,  // "🧖🏽\u200d♂️" 1F9D6-1F3FD-200D-2642-FE0F
+    0x00200000,  // "🧖🏾\u200d♀️" 1F9D6-1F3FE-200D-2640-FE0F
+    0x00200001,  // "🧖🏾\u200d♂️" 1F9D6-1F3FE-200D-2642-FE0F
+    0x00200000,  // "🧖🏿\u200d♀️" 1F9D6-1F3FF-200D-2640-FE0F
+    0x00200001,  // "🧖🏿\u200d♂️" 1F9D6-1F3FF-200D-2642-FE0F
+    0x00200000,  // "🧖\u200d♀️" 1F9D6-200D-2640-FE0F
+    0x00200001,  // "🧖\u200d♂️" 1F9D6-200D-2642-FE0F
+    0x00200000,  // "🧗🏻\u200d♀️" 1F9D7-1F3FB-200D-2640-FE0F
+    0x00200000,  // "🧗🏻\u200d♂️" 1F9D7-1F3FB-200D-2642-FE0F
+    0x00200001,  // "🧗🏻" 1F9D7-1F3FB
+    0x00200001,  // "🧗🏼\u200d♀️" 1F9D7-1F3FC-200D-2640-FE0F
+    0x00200000,  // "🧗🏼\u200d♂️" 1F9D7-1F3FC-200D-2642-FE0F
+    0x00200000,  // "🧗🏼" 1F9D7-1F3FC
+    0x00200001,  // "🧗🏽\u200d♀️" 1F9D7-1F3FD-200D-2640-FE0F
+    0x00200000,  // "🧗🏽\u200d♂️" 1F9D7-1F3FD-200D-2642-FE0F
+    0x00200000,  // "🧗🏽" 1F9D7-1F3FD
+    0x00200001,  // "🧗🏾\u200d♀️" 1F9D7-1F3FE-200D-2640-FE0F
+    0x00200000,  // "🧗🏾\u200d♂️" 1F9D7-1F3FE-200D-2642-FE0F
+    0x00200000,  // "🧗🏾" 1F9D7-1F3FE
+    0x00200001,  // "🧗🏿\u200d♀️" 1F9D7-1F3FF-200D-2640-FE0F
+    0x00200000,  // "🧗🏿\u200d♂️" 1F9D7-1F3FF-200D-2642-FE0F
+    0x00200000,  // "🧗🏿" 1F9D7-1F3FF
+    0x00200001,  // "🧗\u200d♀️" 1F9D7-200D-2640-FE0F
+    0x00200000,  // "🧗\u200d♂️" 1F9D7-200D-2642-FE0F
+    0x00200000,  // "🧗"
+    0x00200005,  // "🧘🏻\u200d♀️" 1F9D8-1F3FB-200D-2640-FE0F
+    0x00200005,  // "🧘🏻\u200d♂️" 1F9D8-1F3FB-200D-2642-FE0F
+    0x00200006,  // "🧘🏻" 1F9D8-1F3FB
+    0x00200006,  // "🧘🏼\u200d♀️" 1F9D8-1F3FC-200D-2640-FE0F
+    0x00200005,  // "🧘🏼\u200d♂️" 1F9D8-1F3FC-200D-2642-FE0F
+    0x00200005,  // "🧘🏼" 1F9D8-1F3FC
+    0x00200006,  // "🧘🏽\u200d♀️" 1F9D8-1F3FD-200D-2640-FE0F
+    0x00200005,  // "🧘🏽\u200d♂️" 1F9D8-1F3FD-200D-2642-FE0F
+    0x00200005,  // "🧘🏽" 1F9D8-1F3FD
+    0x00200006,  // "🧘🏾\u200d♀️" 1F9D8-1F3FE-200D-2640-FE0F
+    0x00200005,  // "🧘🏾\u200d♂️" 1F9D8-1F3FE-200D-2642-FE0F
+    0x00200005,  // "🧘🏾" 1F9D8-1F3FE
+    0x00200006,  // "🧘🏿\u200d♀️" 1F9D8-1F3FF-200D-2640-FE0F
+    0x00200005,  // "🧘🏿\u200d♂️" 1F9D8-1F3FF-200D-2642-FE0F
+    0x00200005,  // "🧘🏿" 1F9D8-1F3FF
+    0x00200006,  // "🧘\u200d♀️" 1F9D8-200D-2640-FE0F
+    0x00200005,  // "🧘\u200d♂️" 1F9D8-200D-2642-FE0F
+    0x00200005,  // "🧘"
+    0x00200001,  // "🧙🏻\u200d♀️" 1F9D9-1F3FB-200D-2640-FE0F
+    0x00200001,  // "🧙🏻\u200d♂️" 1F9D9-1F3FB-200D-2642-FE0F
+    0x00200001,  // "🧙🏻" 1F9D9-1F3FB
+    0x00200001,  // "🧙🏼\u200d♀️" 1F9D9-1F3FC-200D-2640-FE0F
+    0x00200001,  // "🧙🏼\u200d♂️" 1F9D9-1F3FC-200D-2642-FE0F
+    0x00200001,  // "🧙🏼" 1F9D9-1F3FC
+    0x00200001,  // "🧙🏽\u200d♀️" 1F9D9-1F3FD-200D-2640-FE0F
+    0x00200001,  // "🧙🏽\u200d♂️" 1F9D9-1F3FD-200D-2642-FE0F
+    0x00200001,  // "🧙🏽" 1F9D9-1F3FD
+    0x00200001,  // "🧙🏾\u200d♀️" 1F9D9-1F3FE-200D-2640-FE0F
+    0x00200001,  // "🧙🏾\u200d♂️" 1F9D9-1F3FE-200D-2642-FE0F
+    0x00200001,  // "🧙🏾" 1F9D9-1F3FE
+    0x00200001,  // "🧙🏿\u200d♀️" 1F9D9-1F3FF-200D-2640-FE0F
+    0x00200001,  // "🧙🏿\u200d♂️" 1F9D9-1F3FF-200D-2642-FE0F
+    0x00200001,  // "🧙🏿" 1F9D9-1F3FF
+    0x00200001,  // "🧙\u200d♀️" 1F9D9-200D-2640-FE0F
+    0x00200002,  // "🧙\u200d♂️" 1F9D9-200D-2642-FE0F
+    0x00200001,  // "🧙"
+    0x00200002,  // "🧚🏻\u200d♂️" 1F9DA-1F3FB-200D-2642-FE0F
+    0x00200001,  // "🧚🏻" 1F9DA-1F3FB
+    0x00200002,  // "🧚🏼\u200d♂️" 1F9DA-1F3FC-200D-2642-FE0F
+    0x00200001,  // "🧚🏼" 1F9DA-1F3FC
+    0x00200002,  // "🧚🏽\u200d♂️" 1F9DA-1F3FD-200D-2642-FE0F
+    0x00200001,  // "🧚🏽" 1F9DA-1F3FD
+    0x00200002,  // "🧚🏾\u200d♂️" 1F9DA-1F3FE-200D-2642-FE0F
+    0x00200001,  // "🧚🏾" 1F9DA-1F3FE
+    0x00200002,  // "🧚🏿" 1F9DA-1F3FF
+    0x00200001,  // "🧚\u200d♀️" 1F9DA-200D-2640-FE0F
+    0x00200002,  // "🧚"
+    0x00200002,  // "🧛🏻\u200d♀️" 1F9DB-1F3FB-200D-2640-FE0F
+    0x00200003,  // "🧛🏻\u200d♂️" 1F9DB-1F3FB-200D-2642-FE0F
+    0x00200002,  // "🧛🏻" 1F9DB-1F3FB
+    0x00200002,  // "🧛🏼\u200d♀️" 1F9DB-1F3FC-200D-2640-FE0F
+    0x00200003,  // "🧛🏼\u200d♂️" 1F9DB-1F3FC-200D-2642-FE0F
+    0x00200002,  // "🧛🏼" 1F9DB-1F3FC
+    0x00200002,  // "🧛🏽\u200d♀️" 1F9DB-1F3FD-200D-2640-FE0F
+    0x00200003,  // "🧛🏽\u200d♂️" 1F9DB-1F3FD-200D-2642-FE0F
+    0x00200002,  // "🧛🏽" 1F9DB-1F3FD
+    0x00200002,  // "🧛🏾\u200d♀️" 1F9DB-1F3FE-200D-2640-FE0F
+    0x00200003,  // "🧛🏾\u200d♂️" 1F9DB-1F3FE-200D-2642-FE0F
+    0x00200002,  // "🧛🏾" 1F9DB-1F3FE
+    0x00200002,  // "🧛🏿\u200d♀️" 1F9DB-1F3FF-200D-2640-FE0F
+    0x00200003,  // "🧛🏿\u200d♂️" 1F9DB-1F3FF-200D-2642-FE0F
+    0x00200002,  // "🧛🏿" 1F9DB-1F3FF
+    0x00200002,  // "🧛\u200d♀️" 1F9DB-200D-2640-FE0F
+    0x00200003,  // "🧛\u200d♂️" 1F9DB-200D-2642-FE0F
+    0x00200002,  // "🧛"
+    0x00200002,  // "🧜🏻\u200d♀️" 1F9DC-1F3FB-200D-2640-FE0F
+    0x00200002,  // "🧜🏻" 1F9DC-1F3FB
+    0x00200002,  // "🧜🏼\u200d♀️" 1F9DC-1F3FC-200D-2640-FE0F
+    0x00200002,  // "🧜🏼" 1F9DC-1F3FC
+    0x00200002,  // "🧜🏽\u200d♀️" 1F9DC-1F3FD-200D-2640-FE0F
+    0x00200002,  // "🧜🏽" 1F9DC-1F3FD
+    0x00200002,  // "🧜🏾\u200d♀️" 1F9DC-1F3FE-200D-2640-FE0F
+    0x00200002,  // "🧜🏾\u200d♂️" 1F9DC-1F3FE-200D-2642-FE0F
+    0x00200001,  // "🧜🏾" 1F9DC-1F3FE
+    0x00200002,  // "🧜🏿\u200d♂️" 1F9DC-1F3FF-200D-2642-FE0F
+    0x00200001,  // "🧜🏿" 1F9DC-1F3FF
+    0x00200002,  // "🧜\u200d♀️" 1F9DC-200D-2640-FE0F
+    0x00200002,  // "🧜\u200d♂️" 1F9DC-200D-2642-FE0F
+    0x00200001,  // "🧜"
+    0x00200004,  // "🧝🏻\u200d♀️" 1F9DD-1F3FB-200D-2640-FE0F
+    0x00200003,  // "🧝🏻\u200d♂️" 1F9DD-1F3FB-200D-2642-FE0F
+    0x00200004,  // "🧝🏻" 1F9DD-1F3FB
+    0x00200004,  // "🧝🏼\u200d♀️" 1F9DD-1F3FC-200D-2640-FE0F
+    0x00200003,  // "🧝🏼\u200d♂️" 1F9DD-1F3FC-200D-2642-FE0F
+    0x00200004,  // "🧝🏼" 1F9DD-1F3FC
+    0x00200004,  // "🧝🏽\u200d♀️" 1F9DD-1F3FD-200D-2640-FE0F
+    0x00200004,  // "🧝🏽\u200d♂️" 1F9DD-1F3FD-200D-2642-FE0F
+    0x00200004,  // "🧝🏽" 1F9DD-1F3FD
+    0x00200004,  // "🧝🏾\u200d♀️" 1F9DD-1F3FE-200D-2640-FE0F
+    0x00200004,  // "🧝🏾\u200d♂️" 1F9DD-1F3FE-200D-2642-FE0F
+    0x00200004,  // "🧝🏾" 1F9DD-1F3FE
+    0x00200004,  // "🧝🏿\u200d♀️" 1F9DD-1F3FF-200D-2640-FE0F
+    0x00200004,  // "🧝🏿\u200d♂️" 1F9DD-1F3FF-200D-2642-FE0F
+    0x00200004,  // "🧝🏿" 1F9DD-1F3FF
+    0x00200004,  // "🧝\u200d♀️" 1F9DD-200D-2640-FE0F
+    0x00200004,  // "🧝\u200d♂️" 1F9DD-200D-2642-FE0F
+    0x00200004,  // "🧝"
+    0x00200004,  // "🧞\u200d♀️" 1F9DE-200D-2640-FE0F
+    0x00200004,  // "🧞\u200d♂️" 1F9DE-200D-2642-FE0F
+    0x00200005,  // "🧞"
+    0x00200004,  // "🧟\u200d♀️" 1F9DF-200D-2640-FE0F
+    0x00200004,  // "🧟\u200d♂️" 1F9DF-200D-2642-FE0F
+    0x00200005,  // "🧟"
+    0x00200002,  // "🧠"
+    0x00200000,  // "🧡"
+    0x00200001,  // "🧢"
+    0x00200005,  // "🧣"
+    0x00200003,  // "🧤"
+    0x00200004,  // "🧦"
+    0x00200001,  // "🧧"
+    0x00200001,  // "🧨"
+    0x00200002,  // "🧩"
+    0x00200000,  // "🧪"
+    0x00200000,  // "🧬"
+    0x00200000,  // "🧭"
+    0x00200001,  // "🧮"
+    0x00200004,  // "🧯"
+    0x00200000,  // "🧰"
+    0x00200000,  // "🧱"
+    0x00200000,  // "🧲"
+    0x00200005,  // "🧳"
+    0x00200006,  // "🧴"
+    0x00200001,  // "🧵"
+    0x00200000,  // "🧶"
+    0x00200000,  // "🧷"
+    0x00200005,  // "🧸"
+    0x00200002,  // "🧹"
+    0x00200000,  // "🧺"
+    0x00200002,  // "🧼"
+    0x00200001,  // "🧽"
+    0x00200004,  // "🧾"
+    0x00200000,  // "🧿"
+    0x00200003,  // "🩰"
+    0x00200008,  // "🩱"
+    0x00200001,  // "🩲"
+    0x00200000,  // "🩳"
+    0x0020000A,  // "🩴"
+    0x00200007,  // "🩸"
+    0x00200002,  // "🩹"
+    0x00200001,  // "🩺"
+    0x00200001,  // "🪀"
+    0x00200002,  // "🪁"
+    0x00200000,  // "🪂"
+    0x00200002,  // "🪃"
+    0x00200003,  // "🪄"
+    0x00200007,  // "🪆"
+    0x00200001,  // "🪐"
+    0x00200003,  // "🪑"
+    0x00200001,  // "🪒"
+    0x00200001,  // "🪓"
+    0x00200000,  // "🪔"
+    0x00200001,  // "🪕"
+    0x00200001,  // "🪖"
+    0x00200000,  // "🪗"
+    0x00200001,  // "🪘"
+    0x00200002,  // "🪚"
+    0x00200001,  // "🪛"
+    0x00200007,  // "🪜"
+    0x00200008,  // "🪝"
+    0x00200006,  // "🪞"
+    0x00200001,  // "🪟"
+    0x00200003,  // "🪡"
+    0x00200001,  // "🪢"
+    0x00200001,  // "🪤"
+    0x00200003,  // "🪥"
+    0x00200001,  // "🪦"
+    0x00200005,  // "🪧"
+    0x00200001,  // "🪨"
+    0x00200002,  // "🪰"
+    0x00200002,  // "🪱"
+    0x00200001,  // "🪲"
+    0x00200002,  // "🪴"
+    0x00200003,  // "🪵"
+    0x00200001,  // "🪶"
+    0x00200005,  // "🫀"
+    0x00200002,  // "🫁"
+    0x00200003,  // "🫂"
+    0x00200002,  // "🫐"
+    0x00200003,  // "🫑"
+    0x00200001,  // "🫒"
+    0x00200002,  // "🫔"
+    0x00200000,  // "🫕"
+    0x00200007,  // "‼"
+    0x00200003,  // "⁉"
+    0x00200001,  // "™"
+    0x00200000,  // "ℹ"
+    0x00200000,  // "↔"
+    0x00200001,  // "↕"
+    0x00200001,  // "↖"
+    0x00200000,  // "↗"
+    0x00200000,  // "↘"
+    0x00200001,  // "↙"
+    0x00200000,  // "↩"
+    0x00200000,  // "↪"
+    0x00200005,  // "⌚"
+    0x00200006,  // "⌛"
+    0x00200000,  // "#⃣" 23-20E3
+    0x00200001,  // "⌨"
+    0x00200000,  // "⏏"
+    0x00200000,  // "⏩"
+    0x00200001,  // "⏪"
+    0x00200000,  // "⏫"
+    0x00200000,  // "⏬"
+    0x00200001,  // "⏭"
+    0x00200001,  // "⏮"
+    0x00200000,  // "⏯"
+    0x00200001,  // "⏰"
+    0x00200004,  // "⏱"
+    0x00200002,  // "⏲"
+    0x00200006,  // "⏳"
+    0x00200001,  // "⏸"
+    0x00200000,  // "⏹"
+    0x00200000,  // "⏺"
+    0x00200001,  // "Ⓜ"
+    0x00200009,  // "▪"
+    0x0020000A,  // "▫"
+    0x00200001,  // "▶"
+    0x00200000,  // "◀"
+    0x00200003,  // "◻"
+    0x00200003,  // "◼"
+    0x00200007,  // "◽"
+    0x00200006,  // "◾"
+    0x00200001,  // "☀"
+    0x00200003,  // "☂"
+    0x00200003,  // "☃"
+    0x00200002,  // "☄"
+    0x00200000,  // "☎"
+    0x00200000,  // "☑"
+    0x00200001,  // "☔"
+    0x00200000,  // "☕"
+    0x00200007,  // "☝🏻" 261D-1F3FB
+    0x00200006,  // "☝🏼" 261D-1F3FC
+    0x00200006,  // "☝🏽" 261D-1F3FD
+    0x00200007,  // "☝🏾" 261D-1F3FE
+    0x00200007,  // "☝🏿" 261D-1F3FF
+    0x00200006,  // "☝"
+    0x00200000,  // "☠"
+    0x00200002,  // "☣"
+    0x00200000,  // "☦"
+    0x00200001,  // "☪"
+    0x00200000,  // "☮"
+    0x00200000,  // "☯"
+    0x00200001,  // "☸"
+    0x00200000,  // "☹"
+    0x00200001,  // "☺"
+    0x00200001,  // "♀"
+    0x00200000,  // "♂"
+    0x00200000,  // "♈"
+    0x00200001,  // "♉"
+    0x00200001,  // "♊"
+    0x00200000,  // "♋"
+    0x00200000,  // "♌"
+    0x00200001,  // "♍"
+    0x00200000,  // "♎"
+    0x00200000,  // "♏"
+    0x00200001,  // "♐"
+    0x00200000,  // "♑"
+    0x00200000,  // "♒"
+    0x00200001,  // "♓"
+    0x00200006,  // "♟"
+    0x00200003,  // "♠"
+    0x00200003,  // "♣"
+    0x00200002,  // "♥"
+    0x00200004,  // "♦"
+    0x00200001,  // "♨"
+    0x00200000,  // "♾"
+    0x00200000,  // "♿"
+    0x00200001,  // "⚒"
+    0x00200002,  // "⚓"
+    0x00200000,  // "⚔"
+    0x00200001,  // "⚕"
+    0x00200002,  // "⚖"
+    0x00200001,  // "⚗"
+    0x00200001,  // "⚙"
+    0x00200000,  // "⚛"
+    0x00200000,  // "⚜"
+    0x00200003,  // "⚡"
+    0x00200000,  // "⚧"
+    0x00200000,  // "⚫"
+    0x00200004,  // "⚱"
+    0x00200000,  // "⚽"
+    0x00200000,  // "⚾"
+    0x00200003,  // "⛄"
+    0x00200000,  // "⛈"
+    0x00200000,  // "⛎"
+    0x00200002,  // "⛑"
+    0x00200004,  // "⛓"
+    0x00200000,  // "⛔"
+    0x00200000,  // "⛩"
+    0x00200003,  // "⛪"
+    0x00200001,  // "⛰"
+    0x00200001,  // "⛱"
+    0x00200002,  // "⛲"
+    0x00200000,  // "⛳"
+    0x00200001,  // "⛴"
+    0x00200000,  // "⛵"
+    0x00200001,  // "⛷🏻" 26F7-1F3FB
+    0x00200000,  // "⛷🏽" 26F7-1F3FD
+    0x00200001,  // "⛷🏾" 26F7-1F3FE
+    0x00200000,  // "⛷"
+    0x00200001,  // "⛸"
+    0x00200002,  // "⛹🏻\u200d♀️" 26F9-1F3FB-200D-2640-FE0F
+    0x00200003,  // "⛹🏻\u200d♂️" 26F9-1F3FB-200D-2642-FE0F
+    0x00200003,  // "⛹🏻" 26F9-1F3FB
+    0x00200002,  // "⛹🏼\u200d♀️" 26F9-1F3FC-200D-2640-FE0F
+    0x00200002,  // "⛹🏼\u200d♂️" 26F9-1F3FC-200D-2642-FE0F
+    0x00200003,  // "⛹🏼" 26F9-1F3FC
+    0x00200003,  // "⛹🏽\u200d♀️" 26F9-1F3FD-200D-2640-FE0F
+    0x00200002,  // "⛹🏽\u200d♂️" 26F9-1F3FD-200D-2642-FE0F
+    0x00200003,  // "⛹🏽" 26F9-1F3FD
+    0x00200003,  // "⛹🏾\u200d♀️" 26F9-1F3FE-200D-2640-FE0F
+    0x00200002,  // "⛹🏾\u200d♂️" 26F9-1F3FE-200D-2642-FE0F
+    0x00200003,  // "⛹🏾" 26F9-1F3FE
+    0x00200003,  // "⛹🏿\u200d♀️" 26F9-1F3FF-200D-2640-FE0F
+    0x00200002,  // "⛹🏿\u200d♂️" 26F9-1F3FF-200D-2642-FE0F
+    0x00200003,  // "⛹🏿" 26F9-1F3FF
+    0x00200003,  // "⛹️\u200d♀️" 26F9-FE0F-200D-2640-FE0F
+    0x00200002,  // "⛹️\u200d♂️" 26F9-FE0F-200D-2642-FE0F
+    0x00200003,  // "⛹"
+    0x00200001,  // "⛺"
+    0x00200004,  // "⛽"
+    0x00200002,  // "✂"
+    0x00200000,  // "✅"
+    0x00200001,  // "✈"
+    0x00200001,  // "✉"
+    0x00200002,  // "✊🏻" 270A-1F3FB
+    0x00200001,  // "✊🏼" 270A-1F3FC
+    0x00200002,  // "✊🏾" 270A-1F3FE
+    0x00200001,  // "✊🏿" 270A-1F3FF
+    0x00200005,  // "✋🏻" 270B-1F3FB
+    0x00200004,  // "✋🏼" 270B-1F3FC
+    0x00200004,  // "✋🏽" 270B-1F3FD
+    0x00200003,  // "✋🏾" 270B-1F3FE
+    0x00200004,  // "✋🏿" 270B-1F3FF
+    0x00200004,  // "✋"
+    0x00200007,  // "✌🏻" 270C-1F3FB
+    0x00200006,  // "✌🏼" 270C-1F3FC
+    0x00200006,  // "✌🏽" 270C-1F3FD
+    0x00200007,  // "✌🏾" 270C-1F3FE
+    0x00200006,  // "✌🏿" 270C-1F3FF
+    0x00200007,  // "✌"
+    0x00200002,  // "✍🏻" 270D-1F3FB
+    0x00200002,  // "✍🏼" 270D-1F3FC
+    0x00200001,  // "✍🏽" 270D-1F3FD
+    0x00200002,  // "✍🏾" 270D-1F3FE
+    0x00200002,  // "✍🏿" 270D-1F3FF
+    0x00200001,  // "✍"
+    0x00200001,  // "✏"
+    0x00200000,  // "✒"
+    0x00200000,  // "✔"
+    0x00200003,  // "✖"
+    0x00200001,  // "✝"
+    0x00200000,  // "✡"
+    0x00200003,  // "✨"
+    0x00200001,  // "✳"
+    0x00200003,  // "✴"
+    0x00200001,  // "❄"
+    0x00200001,  // "❇"
+    0x00200002,  // "❌"
+    0x00200000,  // "❎"
+    0x00200007,  // "❓"
+    0x00200008,  // "❔"
+    0x0020000D,  // "❕"
+    0x0020000D,  // "❗"
+    0x00200005,  // "❣"
+    0x00200000,  // "❤"
+    0x00200002,  // "➕"
+    0x00200002,  // "➖"
+    0x00200002,  // "➗"
+    0x00200000,  // "➡"
+    0x00200001,  // "➰"
+    0x00200000,  // "➿"
+    0x00200000,  // "⤴"
+    0x00200001,  // "⤵"
+    0x00200000,  // "*⃣" 2A-20E3
+    0x00200000,  // "⬅"
+    0x00200001,  // "⬆"
+    0x00200000,  // "⬇"
+    0x00200000,  // "⬛"
+    0x00200002,  // "⭐"
+    0x00200000,  // "⭕"
+    0x00200001,  // "0⃣" 30-20E3
+    0x00200001,  // "〰"
+    0x00200002,  // "〽"
+    0x00200000,  // "1⃣" 31-20E3
+    0x00200001,  // "2⃣" 32-20E3
+    0x00200000,  // "㊗"
+    0x00200000,  // "㊙"
+    0x00200001,  // "3⃣" 33-20E3
+    0x00200000,  // "4⃣" 34-20E3
+    0x00200000,  // "5⃣" 35-20E3
+    0x00200001,  // "6⃣" 36-20E3
+    0x00200000,  // "7⃣" 37-20E3
+    0x00200000,  // "8⃣" 38-20E3
+    0x00200001,  // "9⃣" 39-20E3
+    0x00200000,  // "©"
+    0x00200000,  // "®"
+    0x00200001,  // "\ue50a"
+];
+
+/// Return the kerning adjustment in pixels to add to the advance of the glyph
+/// with its blit pattern at DATA[left] when the glyph at DATA[right] follows it.
+/// Negative adjustments move the glyphs closer together.
+/// This font has no kerning pairs.
+pub fn get_kerning(_left: usize, _right: usize) -> isize {
+    0
+}
+
 /// Packed glyph pattern data.
 /// Record format:
 ///  [offset+0]: ((w as u8) << 16) | ((h as u8) << 8) | (yOffset as u8)
@@ -18371,12 +24663,12 @@ pub const DATA: [u32; 91228] = [
     0x00000400, 0x00006800, 0x0002c000, 0x001f0000, 0x00f80000, 0x0d800000, 0xb6000004, 0x9000005b,
     0x60000b7d, 0x80005fec, 0x0001b6d0, 0x00964b00, 0x04924900, 0x5b6db601, 0x6db6c009, 0x249205b6,
     0xdb6c96db, 0xedb0b6df, 0x6d8b6db6, 0xdb249249, 0x24000000,
-    // [58975]: 1f6d6 "\U0001f6d6"
+    // [58975]: 1f6d6 "🛖"
     0x001d1d03, 0x00010000, 0x00000000, 0x00000000, 0x12400000, 0x48000000, 0x08000124, 0x90000492,
     0x40000412, 0x00124924, 0x00492490, 0x02080081, 0x24924904, 0x92492000, 0x00001249, 0x24924924,
     0x92484924, 0x920db6db, 0x6c365b25, 0x8092ff24, 0x1b6db6d8, 0x64bfc903, 0x25fe4836, 0xdb6db0c9,
     0x7f92064b, 0xfc906db6, 0xdb609249, 0x24000000,
-    // [59003]: 1f6d7 "\U0001f6d7"
+    // [59003]: 1f6d7 "🛗"
     0x00202000, 0x12492490, 0x5b6db6d8, 0x5b65b6da, 0xb6cb65b6, 0x5b6582db, 0x5b0182db, 0xb6cb65b6,
     0x5b65b2db, 0x5b6db2db, 0xb2492496, 0x4124924b, 0x436db6c3, 0xb6db6db6, 0x4b2cb2cb, 0x430c3043,
     0xb6592cb6, 0x4b2c304b, 0x400c1043, 0xb64924b6, 0x480c104b, 0x400c1043, 0xb64924b6, 0x4b2cb24b,
@@ -18467,11 +24759,11 @@ pub const DATA: [u32; 91228] = [
     0x00024024, 0x02036124, 0x00090048, 0x00120360, 0x301b0b60, 0x205a16c0, 0x40b01b60, 0xb0d85b61,
     0xb2d8b2c3, 0xe590db05, 0x86dadb0d, 0x96dcfe1f, 0x2dc6db6d, 0xb6d65b6d, 0xb2ccf249, 0x2596c000,
     0xb61e0000, 0xbc040000, 0x20000000,
-    // [59533]: 1f6fb "\U0001f6fb"
+    // [59533]: 1f6fb "🛻"
     0x001f140b, 0x00036c00, 0x000b6800, 0x001e1800, 0x00192000, 0x00592000, 0x00e04024, 0x92db6024,
     0x92db6c49, 0x27fff96d, 0xb6db616d, 0xb6db63ff, 0xffffc36d, 0xb6db0bfd, 0xb6fb07ff, 0xffbe4b6d,
     0xb6590960, 0x1ac01ac0, 0x35801b00, 0x36001800, 0x10000000,
-    // [59554]: 1f6fc "\U0001f6fc"
+    // [59554]: 1f6fc "🛼"
     0x001f1e01, 0x0000b000, 0x0002d900, 0x0002c900, 0x00059200, 0x00164900, 0x00164900, 0x003c9000,
     0x00b24800, 0x01924000, 0x07248000, 0x2d924000, 0x6c924002, 0xc924801b, 0x64920059, 0x249200b2,
     0x49241249, 0x24b61249, 0x25bc2492, 0x5ff0924b, 0x6db1b6df, 0x0f83fff0, 0x0f0db6db, 0x6da59249,
@@ -18538,32 +24830,32 @@ pub const DATA: [u32; 91228] = [
     0x5b6db6db, 0x49249249, 0xb6db6db6, 0x5b6db6db, 0x49249249, 0xb6db6db6, 0x5b6db6db, 0x49249249,
     0xb6db6db6, 0x5b6db6db, 0x49249249, 0xb6db6db6, 0x5b6db6db, 0x49249249, 0xb6db6db6, 0x5b6db6d8,
     0x09249248,
-    // [59969]: 1f90c-1f3fb "\U0001f90c🏻" 1F90C-1F3FB
+    // [59969]: 1f90c-1f3fb "🤌🏻" 1F90C-1F3FB
     0x00191e01, 0x02000002, 0x40000490, 0x00020800, 0x02490000, 0x92400001, 0x00004924, 0x00124800,
     0x00200009, 0x24800249, 0x20002000, 0x01249200, 0x49248004, 0x00002492, 0x49492492, 0x40920004,
     0x92492924, 0x92480000, 0x00924925, 0x24924900, 0x00001249, 0x24849249, 0x20000000, 0x49249002,
     0x49200000,
-    // [59994]: 1f90c-1f3fc "\U0001f90c🏼" 1F90C-1F3FC
+    // [59994]: 1f90c-1f3fc "🤌🏼" 1F90C-1F3FC
     0x001b1f01, 0x02000002, 0x48000092, 0x40000924, 0x00010400, 0x00492000, 0x04920000, 0x02000024,
     0x92000249, 0x00000900, 0x0012c900, 0x01249000, 0x04000009, 0x24920092, 0x49200248, 0x00049049,
     0x22492492, 0x01240002, 0x49249124, 0x92492000, 0x00012492, 0x48924924, 0x80000000, 0x92492489,
     0x24924024, 0x00480124, 0x92000040, 0x00000000,
-    // [60022]: 1f90c-1f3fd "\U0001f90c🏽" 1F90C-1F3FD
+    // [60022]: 1f90c-1f3fd "🤌🏽" 1F90C-1F3FD
     0x00192000, 0x02000004, 0x80000240, 0x0006d800, 0x04920002, 0x490002db, 0x60009240, 0x00492000,
     0x5b6c0012, 0xc9000924, 0x800b6db0, 0x02492401, 0x2492016c, 0xb6c04924, 0x90248249, 0x2db6db29,
     0x64924492, 0x4925b6db, 0x6d249248, 0x924924b6, 0xdb6da492, 0x49124924, 0x96db6db4, 0x92492049,
     0x24900b6d, 0xb0000900,
-    // [60048]: 1f90c-1f3fe "\U0001f90c🏾" 1F90C-1F3FE
+    // [60048]: 1f90c-1f3fe "🤌🏾" 1F90C-1F3FE
     0x001a2000, 0x02000002, 0xc00000b2, 0x00005b60, 0x001b6c00, 0x02590003, 0x6d80016d, 0xb0004964,
     0x002db680, 0x05b6c001, 0x259000b6, 0xdb0016cb, 0x6004b249, 0x02db6db0, 0x5b0db612, 0xc1248b6c,
     0xb6d96db6, 0xdb496492, 0x6db6db6d, 0xb6db6d24, 0x9249b6db, 0x6d96db6d, 0xb4924926, 0xdb6db65b,
     0x6db6c249, 0x24b02db6, 0xc8001240,
-    // [60075]: 1f90c-1f3ff "\U0001f90c🏿" 1F90C-1F3FF
+    // [60075]: 1f90c-1f3ff "🤌🏿" 1F90C-1F3FF
     0x001c2000, 0x00400000, 0xb600000f, 0xe000016d, 0x80001b6c, 0x0001f7c0, 0x0016da00, 0x01b6c000,
     0x1bfc0003, 0x6db0005b, 0x6d8003bf, 0xd80036db, 0x6005b6db, 0x007bedb0, 0x036cb6c0, 0x5b6db605,
     0xbe5b6836, 0xcb6d85b6, 0xdb6c5bfd, 0xb6cb6db6, 0xdb5b6db6, 0xc5b6db6c, 0x36db6db5, 0xb6db6c7b,
     0x6db6c36d, 0xb6db1b6d, 0xb6c0bfdb, 0xfc02db6d, 0x80004900,
-    // [60104]: 1f90c "\U0001f90c"
+    // [60104]: 1f90c "🤌"
     0x00192000, 0x02000004, 0x80000240, 0x00025800, 0x04920000, 0x08000049, 0x20009240, 0x00010000,
     0x49240012, 0x49000020, 0x00092c90, 0x02492400, 0x04000124, 0x92404924, 0x90048000, 0x25924909,
     0x24924012, 0x00049249, 0x25249248, 0x00000092, 0x4924a492, 0x49000000, 0x12492494, 0x92492040,
@@ -19892,7 +26184,7 @@ pub const DATA: [u32; 91228] = [
     0xc8092492, 0x44924924, 0x92492490, 0x925f2489, 0x25b64924, 0xb6d92525, 0xf7c9125b, 0x6c92496c,
     0xb24a4f25, 0x9224b6db, 0x6492db24, 0x84964924, 0x492db6c9, 0x24b64901, 0x25924812, 0x596da049,
     0x24920049, 0x24900496, 0xdb000249, 0x24000049, 0x000000b2, 0x00000200,
-    // [67700]: 1f972 "\U0001f972"
+    // [67700]: 1f972 "🥲"
     0x001f1f00, 0x00090000, 0x00492000, 0x00924000, 0x12492400, 0x12492001, 0x24924804, 0x92492404,
     0x92492009, 0x24924025, 0xb2592125, 0x92d9224f, 0x25f2492d, 0x92c9092c, 0x96c91259, 0x26924924,
     0x92494924, 0x92489249, 0x24924b64, 0x924a4924, 0x92449249, 0x24925b25, 0x9212496d, 0x920493f9,
@@ -19918,34 +26210,34 @@ pub const DATA: [u32; 91228] = [
     0xb6db6db6, 0x496c9649, 0x09249248, 0x96db6db6, 0x4b6db6c8, 0x0b2482c8, 0x164b64b2, 0x0b6db6c8,
     0x0b249240, 0x164b64b2, 0x0b6db6c8, 0x016db640, 0x12db6d80, 0x01249248, 0x00249200, 0x005b6c00,
     0x00048000,
-    // [67859]: 1f977-1f3fb "\U0001f977🏻" 1F977-1F3FB
+    // [67859]: 1f977-1f3fb "🥷🏻" 1F977-1F3FB
     0x001b1c04, 0x00100000, 0x1fc00005, 0xb60000ff, 0xe0007ffc, 0x0006db40, 0x01fff000, 0x3ff70009,
     0x6db00192, 0x6b00320d, 0xe0059658, 0x607bee1e, 0x0fffc302, 0xdb68e07f, 0xfe180fff, 0xcf036db2,
     0xc013f860, 0x027f9c00, 0xb7db005b, 0xfdf01fff, 0xbf86db6d, 0xb07fb6ff, 0x1ff7ffe3, 0x6db6d812,
     0x49248000,
-    // [67884]: 1f977-1f3fc "\U0001f977🏼" 1F977-1F3FC
+    // [67884]: 1f977-1f3fc "🥷🏼" 1F977-1F3FC
     0x001a1c04, 0x00240000, 0x1f80002d, 0xb0000ffe, 0x0003ffc0, 0x01b6d800, 0x3ffe001f, 0xff8016db,
     0x2002796c, 0x00d81b00, 0x5965820f, 0x7df0c1ff, 0xf8f06db6, 0x583fff18, 0x0fff8605, 0xb6cb0037,
     0xf14007fe, 0xf812db7c, 0x07dfef07, 0xf7ffe2db, 0x6db4ff6d, 0xfe3ffb7f, 0xcb6db6d9, 0x24924800,
-    // [67908]: 1f977-1f3fd "\U0001f977🏽" 1F977-1F3FD
+    // [67908]: 1f977-1f3fd "🥷🏽" 1F977-1F3FD
     0x001c1c04, 0x00040000, 0x03f80000, 0xdb60001f, 0xfe0001ff, 0xf0002db6, 0x0003fff0, 0x003fff00,
     0x06db6c00, 0xf659800f, 0x6498406d, 0xb6cb037f, 0xb0c03fff, 0x0c02db61, 0x803fff38, 0x0fffe300,
     0x7db6f003, 0x7f9e0017, 0xf9e002df, 0x7c01f7fb, 0xe01f7fbe, 0x036db6d8, 0x7fedff87, 0xfedff8b6,
     0xdb6d8492, 0x49200000,
-    // [67934]: 1f977-1f3fe "\U0001f977🏾" 1F977-1F3FE
+    // [67934]: 1f977-1f3fe "🥷🏾" 1F977-1F3FE
     0x001b1c04, 0x00100000, 0x1fc00005, 0xb60000ff, 0xe0007ffc, 0x0006db40, 0x01fff000, 0x3fff000b,
     0x6db001b6, 0xfb00364f, 0xe005b6d8, 0x607bee1e, 0x0fffc302, 0xdb68e07f, 0xfe180fff, 0xcf036db2,
     0xc013f860, 0x027f9c00, 0xb7db005b, 0xfdf01fff, 0xbf86db6d, 0xb07fb6ff, 0x1ff7ffe3, 0x6db6d812,
     0x49248000,
-    // [67959]: 1f977-1f3ff "\U0001f977🏿" 1F977-1F3FF
+    // [67959]: 1f977-1f3ff "🥷🏿" 1F977-1F3FF
     0x001a1c04, 0x00240000, 0x2f80001f, 0xf8000db6, 0x0007ffc0, 0x01fff000, 0xb6db001f, 0xff800fff,
     0xf006fb6c, 0x00df7f80, 0x3fffc00b, 0x6db0c1ff, 0xf0607ffc, 0x302db618, 0x0fffcf03, 0xffe300b6,
     0xdb6013f9, 0xe005fff8, 0x16db6d87, 0xdbefe1fe, 0xfbfcdb6d, 0xb61fedbf, 0xcfff7ff3, 0x6d96d800,
-    // [67983]: 1f977 "\U0001f977"
+    // [67983]: 1f977 "🥷"
     0x001a1c04, 0x00480000, 0x1f00001f, 0xf8000b6d, 0x0003ffc0, 0x00fff000, 0x6db6003d, 0xf6c00d24,
     0xb005b2ca, 0x00925b00, 0x3492c306, 0xdb6180ff, 0xf8f03ffe, 0x381b6d9e, 0x0fff8603, 0xffe5816d,
     0xb2c005fe, 0xf809ffb8, 0x0db6db07, 0xf7dbe1fd, 0xf7f8b6db, 0x6dbfdb7f, 0x8ffefff2, 0xdb2db400,
-    // [68007]: 1f978 "\U0001f978"
+    // [68007]: 1f978 "🥸"
     0x001f1f01, 0x00092400, 0x00492400, 0x00924800, 0x36492d80, 0xfe497f01, 0xfe93ff05, 0xb64b6c2f,
     0xfe5ffc79, 0x2ff25c64, 0xb6d92d65, 0x9fd962cb, 0xb7f2c96d, 0xb6db2b2d, 0x96cb13cb, 0x2d9e4b6c,
     0x96d94b64, 0x96c89249, 0x24904924, 0x924a4924, 0x924092c9, 0x64825b6d, 0xb202ffef, 0xf205bfff,
@@ -20144,12 +26436,12 @@ pub const DATA: [u32; 91228] = [
     0x00002410, 0x00010000, 0x00000000, 0x00400000, 0x02000000, 0x00804904, 0x84124824, 0x00000001,
     0x24924949, 0x24924000, 0x00009249, 0x24949249, 0x24000000, 0x09249249, 0x49249240, 0x00000012,
     0x49248012, 0x49200000, 0x00000492, 0x00000000,
-    // [69156]: 1f9a3 "\U0001f9a3"
+    // [69156]: 1f9a3 "🦣"
     0x001f1a06, 0x00000b00, 0x00025b00, 0x0024b600, 0x00b6db00, 0x01b6d800, 0x0b7c9000, 0x6db6d80b,
     0x6db6d016, 0xd9ec805b, 0x6db6c0db, 0x6db6c096, 0x5bec82db, 0x6db606db, 0x6d944d96, 0xcb2016db,
     0x6db236db, 0x6c902db2, 0xd901b6db, 0x6584b60b, 0x65812c12, 0xc901b01b, 0x0585b05b, 0x05816096,
     0x010d80d8, 0x00040040, 0x00000000,
-    // [69183]: 1f9a4 "\U0001f9a4"
+    // [69183]: 1f9a4 "🦤"
     0x00171e01, 0x00008000, 0x0100000d, 0x90002490, 0x00492000, 0x64800120, 0x80004800, 0x01640049,
     0x20049244, 0xb6db6492, 0x49012492, 0x05b2d804, 0x92480924, 0x902db6c0, 0x24920009, 0x20002d80,
     0x00250000, 0x00000024, 0x00000400, 0x00090001, 0x2c000120, 0x00004000, 0x01000000,
@@ -20183,17 +26475,17 @@ pub const DATA: [u32; 91228] = [
     0x92492080, 0x01052492, 0x49224924, 0x92c00092, 0x0e492492, 0x58924925, 0x80400084, 0x32492cb0,
     0x64924940, 0x92490604, 0x92492819, 0x24924024, 0x0001006c, 0x9248005b, 0x24b0006c, 0xb700010d,
     0x90000000,
-    // [69383]: 1f9ab "\U0001f9ab"
+    // [69383]: 1f9ab "🦫"
     0x001e1a06, 0x00001a00, 0x0925b6c0, 0x36db6d82, 0x4924b316, 0xdb6db46d, 0xb6db6492, 0x496c2db6,
     0xdb245b6d, 0xb00124b6, 0x400b6db6, 0xc016db65, 0xa07b2400, 0x40db6002, 0x07b6c000, 0x1e490000,
     0xb6d80003, 0x7db00007, 0xffe8002d, 0xb6d8007b, 0x7ffc007f, 0xfff8016d, 0xb6c002ff, 0xff80017f,
     0xf0000124, 0x80000000,
-    // [69409]: 1f9ac "\U0001f9ac"
+    // [69409]: 1f9ac "🦬"
     0x00201a06, 0x0005fe00, 0x005b6d80, 0x007ffe08, 0x017ffe7c, 0x06db6db6, 0x0b7fffdf, 0x093fffdf,
     0x36db6db6, 0x5b6ffedb, 0x493fffca, 0xb6db6db6, 0x5b6ffed8, 0x493fff48, 0xb6db6db6, 0x5b6ffed8,
     0x492ffe48, 0x96db6cb0, 0x4b6ffe00, 0x0927fe00, 0x16016c00, 0x0b00b000, 0x09009000, 0x06006000,
     0x03003000, 0x00000000, 0x02002000,
-    // [69436]: 1f9ad "\U0001f9ad"
+    // [69436]: 1f9ad "🦭"
     0x001e1c02, 0x00000120, 0x00002480, 0x00016400, 0x00024800, 0x00090000, 0x00d80000, 0x04900000,
     0x12400000, 0xb6000009, 0x24000024, 0x9000016d, 0x80001249, 0x00024924, 0x00b6db60, 0x01249240,
     0x24924901, 0x6db6d802, 0x4964b009, 0x2490c05b, 0x2582c880, 0x1b059601, 0x20001000, 0x00006000,
@@ -20563,7 +26855,7 @@ pub const DATA: [u32; 91228] = [
     0x49000080, 0x04924924, 0x92492492, 0x00012009, 0x24924924, 0x92492400, 0x02481249, 0x24924924,
     0x92480024, 0x92249249, 0x24924924, 0x90924924, 0x49249249, 0x24924900, 0x24924002, 0x49240001,
     0x24000000,
-    // [71508]: 1f9cb "\U0001f9cb"
+    // [71508]: 1f9cb "🧋"
     0x00142000, 0x02000030, 0x00030000, 0x20000300, 0x00300016, 0xc904b248, 0x4924992c, 0x92492494,
     0x924992c9, 0x24924941, 0x00192c92, 0x49249410, 0x00924924, 0x92484924, 0x836db649, 0x24809248,
     0x36db61b6, 0x581b6583, 0x6db41b6d, 0x80fe4816, 0xdb001200,
@@ -22662,7 +28954,7 @@ pub const DATA: [u32; 91228] = [
     0xb6cb2009, 0x2492402d, 0xb6db606c, 0xb64b0049, 0x2492016d, 0xb6db0125, 0xb2480249, 0x24901b6d,
     0xb6d8492d, 0x92489249, 0x2490db6d, 0xb6c2492c, 0x92449249, 0x2496db2d, 0xb6124924, 0x92249249,
     0x24b6d86d, 0xb6924024, 0x91248049, 0x20b6c16c, 0x80120120, 0x00000000,
-    // [83370]: 1fa74 "\U0001fa74"
+    // [83370]: 1fa74 "🩴"
     0x000d1d01, 0x02004802, 0x482484b2, 0x24925929, 0x6c4925b6, 0xd658924b, 0x25a49124, 0x964b4920,
     0x490c9212, 0x40920924, 0x24812412, 0x48490048, 0x04901200,
     // [83383]: 1fa78 "🩸"
@@ -22695,22 +28987,22 @@ pub const DATA: [u32; 91228] = [
     0x7e000240, 0x32080490, 0x19040200, 0x08240240, 0x02430480, 0x01000240, 0x00248200, 0x001b0580,
     0x00049600, 0x0000b200, 0x00016c00, 0x00001200, 0x00001000, 0x00002c00, 0x00000200, 0x00000200,
     0x00000400,
-    // [83557]: 1fa83 "\U0001fa83"
+    // [83557]: 1fa83 "🪃"
     0x001b1d01, 0x04900002, 0x09000000, 0x00000001, 0x20000002, 0x00009240, 0x016db2c0, 0x36db2c12,
     0x492485b6, 0xdb6c5b6d, 0x92096400, 0x02d80010, 0x6c000104, 0x80000160, 0x10093609, 0x0082c000,
     0x01b04924, 0x9a049241, 0x00000058, 0x04920400, 0x09008000, 0x00600000, 0x16000000, 0x40000030,
     0x00000300, 0x00000000,
-    // [83583]: 1fa84 "\U0001fa84"
+    // [83583]: 1fa84 "🪄"
     0x001c1c01, 0x00010000, 0x00080000, 0x00800140, 0x12001a04, 0x9000b049, 0x00068120, 0x00340800,
     0x01608001, 0x0d100000, 0x68000002, 0xc000901a, 0x000480d0, 0x00000580, 0x01003400, 0x0101a000,
     0x100b0002, 0x40680010, 0x03400000, 0x16000000, 0xd0000006, 0x8000002c, 0x000001a0, 0x00000c00,
     0x00004000, 0x00010000,
-    // [83609]: 1fa85 "\U0001fa85"
+    // [83609]: 1fa85 "🪅"
     0x001d1e01, 0x0000b000, 0x00020000, 0x00100000, 0x01600200, 0x04001000, 0x20012402, 0x000c9048,
     0x00249240, 0x061b6c00, 0x082db248, 0x0164b240, 0x16db6c00, 0x59243002, 0x4920802d, 0xb6cb05b6,
     0x48002492, 0x4002db6d, 0x801b6c90, 0x02012480, 0x20160b00, 0x8040200c, 0x02002048, 0x20020301,
     0x80180804, 0x00400058, 0x04000300, 0x10000800, 0x00000000,
-    // [83638]: 1fa86 "\U0001fa86"
+    // [83638]: 1fa86 "🪆"
     0x00131e01, 0x00b00049, 0x00092002, 0xcb006db0, 0x05b00124, 0x80325002, 0x4800b6c0, 0x0be001fc,
     0x005b600c, 0x96009340, 0x6db4164f, 0x026bb0b6, 0xdb59ecbf, 0x3f96db6d, 0x8df7d9bf, 0xff6db6c6,
     0xdb6cf92f, 0x16db616f, 0xb0049000,
@@ -22743,174 +29035,174 @@ pub const DATA: [u32; 91228] = [
     0x60000001, 0x20000000, 0x40000001, 0x60000001, 0x20000000, 0x58000001, 0x60000001, 0x20900003,
     0x49200001, 0x24b00001, 0x20100002, 0x00200009, 0x24b00008, 0x20800010, 0x00000049, 0x2d800040,
     0x64000080, 0xc80000cb, 0x60000241, 0xe0000083, 0xc00002d9, 0x00000040, 0x00000000,
-    // [83817]: 1fa96 "\U0001fa96"
+    // [83817]: 1fa96 "🪖"
     0x001e1e01, 0x00024000, 0x01b6c000, 0x0b6d8000, 0x6db6c00b, 0x6db6c016, 0xdb6c00db, 0x6db406db,
     0x6db00db6, 0xdb60b6db, 0x6d81b6db, 0x6d0b6492, 0xd8249249, 0x216db6db, 0x62db6db6, 0x0bfffff8,
     0xdb6db6d9, 0xb6db6db6, 0xfffffed6, 0xdb6db62d, 0x924b6000, 0x00008080, 0x00040300, 0x00100400,
     0x01000c00, 0x0a001b6d, 0xb0002ffe, 0x00002db0, 0x00001b00, 0x00000000,
-    // [83847]: 1fa97 "\U0001fa97"
+    // [83847]: 1fa97 "🪗"
     0x001f1904, 0x41041641, 0x65965b6b, 0x6492fb26, 0xc820fe4b, 0x2cb2db7b, 0x2497d916, 0x410ff249,
     0x65964a59, 0x24be4892, 0x487c92db, 0x2db642c9, 0x25fe4482, 0x43e486d9, 0x6db21249, 0x2fb22612,
     0x7f24364b, 0x6d901649, 0x7db03893, 0xe021b24b, 0x6c80b24f, 0x2583e49f, 0xc005925b, 0x6c04000b,
     0x68000000, 0x40000000,
-    // [83873]: 1fa98 "\U0001fa98"
+    // [83873]: 1fa98 "🪘"
     0x001f1d02, 0x004b0000, 0x004b0000, 0x00160000, 0x125b0000, 0x127a0000, 0x01fc0004, 0x96d80004,
     0x9ed80000, 0x7db00025, 0xb6d80127, 0xb6d0005f, 0x67f00b6d, 0xb6c00bed, 0xb6c007cf, 0x6f800b6d,
     0xb6c0036d, 0xb6c000fb, 0x7fc0016d, 0xb6d8006d, 0xb6d0005f, 0xffe0002d, 0xb6c0000d, 0xb680000f,
     0xfe00002d, 0xb400002d, 0xb000003f, 0xc000006d, 0x8000006c, 0x00000000,
-    // [83903]: 1fa99 "\U0001fa99"
+    // [83903]: 1fa99 "🪙"
     0x001d1e01, 0x00248000, 0x12492000, 0x49248002, 0x49240124, 0x92480492, 0x49202480, 0x49024924,
     0x90492492, 0x42400012, 0x24924924, 0x92492484, 0x92482449, 0x24924924, 0x92492924, 0x90489249,
     0x24924924, 0x92520000, 0x9124924b, 0x24924924, 0x24924922, 0x492492c9, 0x24924809, 0x24924092,
     0x492c0249, 0x24800241, 0x24002c96, 0xc0001248, 0x00000000,
-    // [83932]: 1fa9a "\U0001fa9a"
+    // [83932]: 1fa9a "🪚"
     0x001d1805, 0x00000090, 0x00001240, 0x00000200, 0x00496000, 0x01240000, 0x01200004, 0x96000092,
     0x40002012, 0x0002c960, 0x00492400, 0x02412001, 0x6d960005, 0xb2400164, 0x9200161b, 0x6000d964,
     0x00024120, 0x000c3600, 0x0030c000, 0x00900000, 0x0b000000, 0x28000001, 0x00000000,
-    // [83955]: 1fa9b "\U0001fa9b"
+    // [83955]: 1fa9b "🪛"
     0x001e1d02, 0x0000002c, 0x00000240, 0x00000900, 0x00005800, 0x00009000, 0x00020000, 0x00900000,
     0x01000000, 0x04000001, 0x20000082, 0x00000208, 0x00003640, 0x00016c00, 0x0007f000, 0x006db000,
     0x02df6000, 0x0ffc0000, 0xdb680005, 0xbfc0001f, 0xfc0001b6, 0xd0000b7f, 0x80003ff8, 0x00006da0,
     0x0000df00, 0x0001f000, 0x00034000, 0x00020000, 0x00000000,
-    // [83984]: 1fa9c "\U0001fa9c"
+    // [83984]: 1fa9c "🪜"
     0x00131e01, 0x00002c00, 0x1bc001f8, 0x0076db6d, 0xedb7fc92, 0x7b0006f0, 0x007e001d, 0x924b7924,
     0xbffffec9, 0x25bc001f, 0x80076000, 0xde002fc9, 0x27b6db6f, 0x0017e001, 0xd8003780, 0x03f000ed,
     0xb6dbdb6f, 0xf8007600, 0x0d200040,
-    // [84003]: 1fa9d "\U0001fa9d"
+    // [84003]: 1fa9d "🪝"
     0x00111d02, 0x00480092, 0x00400041, 0x00104008, 0x20012000, 0x40002000, 0x0c000100, 0x00800090,
     0x00200011, 0x00124004, 0x80006000, 0x48009400, 0x4c004900, 0x12800912, 0x59049242, 0x49024900,
     0x12000000,
-    // [84020]: 1fa9e "\U0001fa9e"
+    // [84020]: 1fa9e "🪞"
     0x00141d02, 0x00480012, 0x40092489, 0x6db25b6d, 0xb4924b92, 0x4924124b, 0x40001924, 0x924124b4,
     0x00019249, 0x24024b40, 0x00192492, 0x4804b400, 0x01924924, 0x904b4000, 0x19249249, 0x00b40001,
     0xb6db6592, 0x59092480, 0x2d900020, 0x00000000,
-    // [84040]: 1fa9f "\U0001fa9f"
+    // [84040]: 1fa9f "🪟"
     0x001f2000, 0x12492493, 0x24924925, 0x924b2494, 0x92492499, 0x0010002c, 0x925924a4, 0x924924c9,
     0x00800164, 0x92c92524, 0x92492649, 0x04000b24, 0x96492924, 0x92493249, 0x20005924, 0xb2495b6d,
     0xb6db9249, 0x2492c925, 0x924a4924, 0x924c9249, 0x0016492c, 0x92524924, 0x92649249, 0x00b24964,
     0x92924924, 0x93249249, 0x05924b24, 0x94924924, 0x99249249, 0x2db6db6d, 0xadb6db6d, 0x89249249,
-    // [84072]: 1faa0 "\U0001faa0"
+    // [84072]: 1faa0 "🪠"
     0x001d1f00, 0x80000002, 0x00000010, 0x00000160, 0x00000480, 0x00002400, 0x00005800, 0x00012000,
     0x00090000, 0x00160000, 0x00480000, 0x02400000, 0x05800000, 0x12000000, 0x90000001, 0x60000004,
     0x90000024, 0x8000005b, 0x60000364, 0x80000b25, 0x8000b6d8, 0x0002db00, 0x00125800, 0x016d8000,
     0x05b00000, 0x25800000, 0xd8000003, 0x00000018, 0x00000080, 0x00000000,
-    // [84102]: 1faa1 "\U0001faa1"
+    // [84102]: 1faa1 "🪡"
     0x001c1e01, 0x00240000, 0x1ff80096, 0xc96004b0, 0x03004800, 0x18012400, 0xc0090000, 0x00800000,
     0x12400001, 0x92000009, 0x00000144, 0x80000824, 0x0000c000, 0x00060900, 0x00304800, 0x01000000,
     0x0c120000, 0x60900006, 0x00000050, 0x24000200, 0x20003002, 0x00018008, 0x00200000, 0x020004b0,
     0x400015b6, 0x00001f00, 0x00002000, 0x00000000,
-    // [84130]: 1faa2 "\U0001faa2"
+    // [84130]: 1faa2 "🪢"
     0x001e1a03, 0x20900002, 0x49200001, 0x2480000b, 0x25800012, 0x09000048, 0x04000258, 0x20000490,
     0x40001041, 0x0000b248, 0x00010092, 0x00008249, 0x000482cb, 0x00090c12, 0x00249008, 0x0025b048,
     0x00092480, 0x00049000, 0x0020b200, 0x00412000, 0x01008000, 0x0b048000, 0x12190000, 0x09640000,
     0x5b2c0000, 0x90400000,
-    // [84156]: 1faa3 "\U0001faa3"
+    // [84156]: 1faa3 "🪣"
     0x001d1e01, 0x01249000, 0x5ffff02d, 0xb6db6cf6, 0xdb6d87ff, 0xfffc5b6d, 0xb6d96db6, 0xdb292492,
     0x49b6db6d, 0xb25b6db2, 0x42492493, 0x24924924, 0x92492424, 0x924922c9, 0x2492c924, 0x92480924,
     0x9240b249, 0x2c024924, 0x8012c964, 0x012db6c8, 0x04bff900, 0x04be4800, 0x49248001, 0x24920009,
     0x24900092, 0x49000249, 0x24000249, 0x00002490, 0x00000000,
-    // [84185]: 1faa4 "\U0001faa4"
+    // [84185]: 1faa4 "🪤"
     0x001e1f00, 0x00492480, 0x01b6d800, 0x1e492000, 0xb6db6003, 0xedb6c00f, 0xf249006d, 0xb6db03ff,
     0x6db60ffc, 0x92485b6d, 0xb6d1ffdb, 0x6d9fffff, 0xbeb6dbed, 0xb3fffffb, 0xefffffe7, 0x2db7db6c,
     0xfffffec1, 0xfffff90b, 0x6ffed81f, 0xffff803f, 0xfcfe00db, 0x2db601f2, 0x4be003f9, 0x2e0016db,
     0x6c802d92, 0x40000248, 0x000002d8, 0x20000480, 0x00000200, 0x00000208, 0x00000000,
-    // [84216]: 1faa5 "\U0001faa5"
+    // [84216]: 1faa5 "🪥"
     0x001a1c03, 0x00002000, 0x00240000, 0x09000004, 0x96000492, 0x40012480, 0x00b2c800, 0x12480000,
     0x90000059, 0x00000900, 0x00020000, 0x0b600001, 0x60000040, 0x00016c00, 0x002c0000, 0x0800002d,
     0x80000580, 0x00010000, 0x05b00000, 0xb0000020, 0x0000b600, 0x00160000, 0x04000000, 0x40000000,
-    // [84240]: 1faa6 "\U0001faa6"
+    // [84240]: 1faa6 "🪦"
     0x001c2000, 0x00120000, 0x04920002, 0x492000d9, 0x2d801649, 0x2c010410, 0x4024b6cb, 0x01249240,
     0x10492002, 0x4b6c9012, 0x49240104, 0x900024b6, 0x49012492, 0x40100800, 0x02492490, 0x12492401,
     0x24920025, 0xb6c90124, 0x92401049, 0x20024924, 0x90125b24, 0x01049008, 0x24924961, 0x24925812,
     0x49248249, 0x24b61249, 0x24b12492, 0x4b2db6db, 0x69249248,
-    // [84269]: 1faa7 "\U0001faa7"
+    // [84269]: 1faa7 "🪧"
     0x00141e01, 0x00200002, 0x00924924, 0x92490000, 0x096db25b, 0x6d909240, 0x92492492, 0x49192d89,
     0x65b24924, 0x90000092, 0x49249249, 0x00000924, 0x92016000, 0x120000c0, 0x00120001, 0x20000c00,
     0x01200012, 0x0000c000, 0x12000120, 0x00040000,
-    // [84289]: 1faa8 "\U0001faa8"
+    // [84289]: 1faa8 "🪨"
     0x001e1c03, 0x00024000, 0x00248000, 0x00000000, 0x24924000, 0x49248008, 0x200200c9, 0x24960492,
     0x49201001, 0x2080924b, 0x25812492, 0x48040000, 0x24249249, 0x20492492, 0x41000001, 0x1924924b,
     0x92492492, 0x00040246, 0x492496e4, 0x92492412, 0x480491b6, 0x496da164, 0x924b0092, 0x492005b6,
     0xdb200365, 0x800005b0, 0x00000490, 0x00000000,
-    // [84317]: 1fab0 "\U0001fab0"
+    // [84317]: 1fab0 "🪰"
     0x001c1e01, 0x08000000, 0x80003016, 0x1b058023, 0xf860023f, 0xc60065b6, 0x40023f86, 0x0033fc60,
     0x02db6c00, 0x17ff0059, 0x7ff2cb6d, 0xb6db017f, 0xb20002f8, 0x00125b64, 0x8002d800, 0x00268001,
     0x25b64800, 0x6fb00002, 0xf90012db, 0x648017ff, 0x00017ff0, 0x002db6c0, 0x037ff600, 0x27fe6016,
     0x5b658182, 0xf8381804, 0x00c10000, 0x08000000,
-    // [84345]: 1fab1 "\U0001fab1"
+    // [84345]: 1fab1 "🪱"
     0x001b1e01, 0x05800000, 0xb000016d, 0x800036d8, 0x0002c900, 0x00b0c000, 0x182c0001, 0x04800041,
     0x60b00036, 0x5b00160b, 0x6405a2db, 0x40d96db0, 0x1b2fb606, 0xcb64b161, 0xb01b2430, 0x016b0580,
     0x59b0500d, 0x9008009d, 0x86c02cc0, 0x60069804, 0x02468360, 0xb660b05b, 0x04160961, 0x6d82d816,
     0xd82c02c8, 0x00001600, 0x00000000,
-    // [84372]: 1fab2 "\U0001fab2"
+    // [84372]: 1fab2 "🪲"
     0x001e1e01, 0x00082000, 0x00218200, 0xc16c0604, 0x06d82000, 0x1b608030, 0xdb618044, 0x92080192,
     0x492005b6, 0xdb000b6d, 0xb6002492, 0x40006db6, 0xc002cb2c, 0x80092492, 0x00db6db6, 0x04b2cb2c,
     0x32492491, 0x96db6c38, 0x2cb2c800, 0x92492005, 0xb6db0009, 0x2cb20024, 0x9248036d, 0xb6d8125b,
     0x24804124, 0x90008b6d, 0x860416d8, 0x08100920, 0x00004900, 0x80000000,
-    // [84402]: 1fab3 "\U0001fab3"
+    // [84402]: 1fab3 "🪳"
     0x001d2000, 0x16000580, 0x58003000, 0x40040001, 0x80400002, 0x00000010, 0x4000006c, 0x000001f0,
     0x00000f80, 0x0080da04, 0x06cfe5a0, 0x037f3400, 0x0db60000, 0x36d80000, 0xff0000db, 0x6da00f7f,
     0xfe004fff, 0x980c36d8, 0x6180ffe0, 0xb407ff00, 0x036db680, 0x3dfff801, 0x8ffe4016, 0x5b658043,
     0xff18020f, 0xf0c020b6, 0xc30182f8, 0x080816c0, 0x60c16d82, 0x00008008,
-    // [84432]: 1fab4 "\U0001fab4"
+    // [84432]: 1fab4 "🪴"
     0x001a2000, 0x02000000, 0xc0000030, 0x00000b00, 0x00036010, 0x0058258b, 0x0d96c325, 0x96c04964,
     0xb02db6d8, 0x0096d800, 0x24924816, 0xdb2d8249, 0x25b08241, 0x20492490, 0x19249006, 0x40250364,
     0x96c06c92, 0xd0092490, 0x0db6db01, 0xb6db002c, 0x96c016db, 0x6002db6c, 0x0016d800, 0x1b6d8003,
     0x6d800049, 0x20000db0, 0x00009000,
-    // [84459]: 1fab5 "\U0001fab5"
+    // [84459]: 1fab5 "🪵"
     0x00161d02, 0x024800b2, 0x580324b0, 0x04924064, 0x92824924, 0x09049019, 0x24a0f24b, 0x03f97c06,
     0xdb6dbfff, 0xfffffff1, 0xb6db6f7f, 0xfe3ffff8, 0x6db6c3ff, 0x7e0ffff8, 0x1b6db0ff, 0xdf03fffe,
     0x06db6c3f, 0xffc0ffff, 0x01b6d803, 0xfff007fe, 0x0005b000,
-    // [84480]: 1fab6 "\U0001fab6"
+    // [84480]: 1fab6 "🪶"
     0x001e1d01, 0x24000001, 0xbe00000d, 0xb600001f, 0x7c00007f, 0xf200016d, 0xb60003fb, 0x64000fec,
     0x90001b6d, 0xb00036d9, 0x20004964, 0x8000db6d, 0x800092c9, 0x00024924, 0x00025b6c, 0x00009640,
     0x00124900, 0x0036db00, 0x0024b200, 0x00124000, 0x01b6c000, 0x01248000, 0x04920000, 0x04940000,
     0x09600000, 0x04800000, 0x00800000, 0x00000000, 0x04000000,
-    // [84509]: 1fac0 "\U0001fac0"
+    // [84509]: 1fac0 "🫀"
     0x00161e01, 0x00010004, 0x90001240, 0x00360801, 0x64000490, 0x0025b202, 0x4b200924, 0x80496c80,
     0x92d80249, 0x78165b60, 0x64b7c193, 0xff8db6db, 0x5b6db9fd, 0xf7e36db6, 0xd6db7c7f, 0xfff2db6d,
     0x85b6fb1f, 0xdff0b6db, 0x616dfe07, 0xbfe02db6, 0x007fe000, 0x24000000,
-    // [84531]: 1fac1 "\U0001fac1"
+    // [84531]: 1fac1 "🫁"
     0x001d1e01, 0x00010000, 0x002c0000, 0x01200000, 0x06000000, 0x58000000, 0x00000b0c, 0x30006c92,
     0xc0012012, 0x40b6196c, 0x02d925b0, 0x12402481, 0x6c92db0d, 0xb2db6c24, 0x924926db, 0x05b65b60,
     0x16da4900, 0x926db00b, 0x6cb6c02d, 0xb4960124, 0xdb6016d9, 0x6d805b69, 0x2c0249b6, 0xc02db2db,
     0x01b6d6f0, 0x07b76d80, 0x1b65b000, 0x2d840000, 0x00000000,
-    // [84560]: 1fac2 "\U0001fac2"
+    // [84560]: 1fac2 "🫂"
     0x001a2000, 0x00008000, 0xb05b0092, 0x5b402496, 0xd816db6c, 0x024b6d80, 0x92db605b, 0x6db0092d,
     0xb6024b6d, 0x816db6c0, 0x24b6d059, 0x25b22db6, 0xdb65b259, 0x257c9248, 0x36db6d92, 0x4925a492,
     0x496edb6d, 0xb64b64b6, 0xd3d93dbb, 0x6db6d92d, 0xb6db496d, 0xb6edb6db, 0x6496db6d, 0x2496dbb6,
     0xdb6d9249, 0x2db4924b, 0x6e492492,
-    // [84587]: 1fad0 "\U0001fad0"
+    // [84587]: 1fad0 "🫐"
     0x001b1e01, 0x00100800, 0x09058000, 0x2090001b, 0x6c002d96, 0x800fb240, 0x0b6db600, 0xf6db601e,
     0xdffd85b6, 0xdb60db6d, 0xf60bfdfe, 0xc2db6db6, 0x2db6db45, 0xbefb616d, 0xb6db37fb, 0x6d86ff6d,
     0xb1b6db6c, 0x5b6db60b, 0x6db640db, 0x6d802db6, 0xd005b6d8, 0x006db6c0, 0x06db6000, 0x5b6c0016,
     0xdb00006d, 0x80000080, 0x00000000,
-    // [84614]: 1fad1 "\U0001fad1"
+    // [84614]: 1fad1 "🫑"
     0x001a1d01, 0x01600000, 0x6c00001b, 0x400004b0, 0x00001600, 0x00058000, 0x02c80092, 0x49242492,
     0x4916db6d, 0xb6c924b0, 0x924924db, 0x6db6db24, 0x96c24924, 0x916db6db, 0x2c925b09, 0x249245b6,
     0xdb60b249, 0x6c249249, 0x06db6d82, 0xc925b092, 0x49241b6d, 0xb60b6496, 0xc0492480, 0x6db6d805,
     0x92580000,
-    // [84639]: 1fad2 "\U0001fad2"
+    // [84639]: 1fad2 "🫒"
     0x001e1a03, 0xb0010401, 0xb0092c0b, 0x6032580d, 0xa049602d, 0x86cb405b, 0x0db6002c, 0x1258002c,
     0xb2d80059, 0x65800120, 0x16004b64, 0x3604b040, 0x60124101, 0x80b6d904, 0x016d8218, 0x04960020,
     0x05b00900, 0x1b64b600, 0x6c120803, 0x61b01816, 0x09603000, 0x24804001, 0x6c000002, 0xc0000001,
     0x00000000, 0x00100000,
-    // [84665]: 1fad3 "\U0001fad3"
+    // [84665]: 1fad3 "🫓"
     0x001d1d01, 0x00492000, 0x09248000, 0x49240004, 0xb6c80092, 0x4b200492, 0x59004b2c, 0xb2092c92,
     0x48496596, 0x4496cb2c, 0x924b2c90, 0x92596489, 0x65b2cb24, 0x92cb2524, 0x96591659, 0x6db25924,
     0xb2ca4925, 0x962596cb, 0x24965924, 0x84925924, 0x4965b2c9, 0x25924901, 0x24924812, 0x59648049,
     0x24900049, 0x24800592, 0x58000249, 0x00000000,
-    // [84693]: 1fad4 "\U0001fad4"
+    // [84693]: 1fad4 "🫔"
     0x001c1c02, 0x00000400, 0x00004000, 0x004b0000, 0x02480000, 0x24800024, 0x96000124, 0x90009209,
     0x00124920, 0x00924900, 0x49248009, 0x24920249, 0x24802092, 0x400492c9, 0x01249240, 0x10092002,
     0x49248092, 0x49200120, 0x10002492, 0x40092490, 0x00124800, 0x024b2000, 0x12480000, 0x24000005,
     0x90000024, 0x00000000,
-    // [84719]: 1fad5 "\U0001fad5"
+    // [84719]: 1fad5 "🫕"
     0x00201e01, 0x00000400, 0x00000600, 0x00001050, 0x000020b0, 0x002482c0, 0x09248200, 0x924b2c00,
     0x49249008, 0x49249afe, 0xb6db6db6, 0x5b6db6db, 0x7ffffffe, 0xb6db6db2, 0x5b6db000, 0x7ffff000,
     0x36db6000, 0x1b6d8000, 0x1fff8000, 0x36db6000, 0x7b6db000, 0x30203000, 0x30106000, 0x71243000,
     0x71203000, 0x36596000, 0x7924b000, 0x7924b000, 0xb6db6c00, 0x5b6db000, 0x01240000,
-    // [84750]: 1fad6 "\U0001fad6"
+    // [84750]: 1fad6 "🫖"
     0x001d1608, 0x00040000, 0x002c0000, 0x02c00000, 0x5b000002, 0x4900006d, 0xb60005b6, 0xd1082db6,
     0x4882db6d, 0x921b6db0, 0x50492482, 0x6db6db25, 0xb6db6084, 0x924904db, 0x6db6c36d, 0xb6d80b24,
     0x924016db, 0x60005b6d, 0x0002db60, 0x000db600, 0x00024000,
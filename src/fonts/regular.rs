@@ -20,6 +20,13 @@
 /// This will be true: h + y_offset <= MAX_HEIGHT
 pub const MAX_HEIGHT: u8 = 30;
 
+/// Distance in pixels from the top of the line down to the baseline
+pub const ASCENT: u8 = 24;
+
+/// Distance in pixels from the baseline down to the bottom of the line.
+/// This will be true: ASCENT + DESCENT == MAX_HEIGHT
+pub const DESCENT: u8 = 6;
+
 /// Seed for Murmur3 hashes in the HASH_* index arrays
 pub const M3_SEED: u32 = 0;
 
@@ -762,6 +769,594 @@ const OFFSET_SPECIALS: [usize; 1] = [
     1550, // "�"
 ];
 
+/// Return Some((advance, left bearing)) in pixels for the glyph with its blit
+/// pattern at DATA[offset]. The left edge of the pattern goes left bearing
+/// pixels right of the pen position (negative is to the left), and the pen
+/// moves advance pixels to the right after drawing the glyph. Glyphs without a
+/// record in METRICS get the spacing of blit.rs: a left bearing of 1, and an
+/// advance of the pattern width plus 3. Return None if offset is past the end
+/// of DATA.
+pub fn get_glyph_metrics(offset: usize) -> Option<(usize, isize)> {
+    if let Ok(index) = METRICS_OFFSET.binary_search(&offset) {
+        let m = METRICS[index];
+        return Some(((m >> 16) as usize, m as u16 as i16 as isize));
+    }
+    DATA.get(offset).map(|header| (((header >> 16) & 0xff) as usize + 3, 1))
+}
+
+/// Lookup table of blit pattern offsets in DATA order, for glyphs that do not
+/// have the default metrics; sort matches METRICS
+const METRICS_OFFSET: [usize; 219] = [
+    0,    // " "
+    2,    // "!"
+    5,    // "\""
+    7,    // "#"
+    13,   // "$"
+    21,   // "%"
+    30,   // "&"
+    40,   // "'"
+    42,   // "("
+    48,   // ")"
+    54,   // "*"
+    59,   // "+"
+    64,   // ","
+    66,   // "-"
+    68,   // "."
+    70,   // "/"
+    78,   // "0"
+    86,   // "1"
+    90,   // "2"
+    98,   // "3"
+    106,  // "4"
+    115,  // "5"
+    123,  // "6"
+    131,  // "7"
+    139,  // "8"
+    147,  // "9"
+    155,  // ":"
+    157,  // ";"
+    160,  // "<"
+    165,  // "="
+    169,  // ">"
+    174,  // "?"
+    182,  // "@"
+    191,  // "A"
+    200,  // "B"
+    208,  // "C"
+    216,  // "D"
+    224,  // "E"
+    231,  // "F"
+    238,  // "G"
+    246,  // "H"
+    254,  // "I"
+    257,  // "J"
+    265,  // "K"
+    273,  // "L"
+    280,  // "M"
+    289,  // "N"
+    297,  // "O"
+    305,  // "P"
+    313,  // "Q"
+    323,  // "R"
+    331,  // "S"
+    339,  // "T"
+    348,  // "U"
+    356,  // "V"
+    365,  // "W"
+    377,  // "X"
+    384,  // "Y"
+    391,  // "Z"
+    398,  // "["
+    402,  // "\\"
+    410,  // "]"
+    414,  // "^"
+    417,  // "_"
+    419,  // "`"
+    421,  // "a"
+    427,  // "b"
+    434,  // "c"
+    440,  // "d"
+    447,  // "e"
+    453,  // "f"
+    459,  // "g"
+    467,  // "h"
+    474,  // "i"
+    477,  // "j"
+    483,  // "k"
+    490,  // "l"
+    493,  // "m"
+    502,  // "n"
+    508,  // "o"
+    514,  // "p"
+    521,  // "q"
+    528,  // "r"
+    534,  // "s"
+    540,  // "t"
+    546,  // "u"
+    552,  // "v"
+    558,  // "w"
+    567,  // "x"
+    573,  // "y"
+    582,  // "z"
+    588,  // "{"
+    594,  // "|"
+    597,  // "}"
+    603,  // "~"
+    606,  // "\u00A0" No-Break Space
+    608,  // "¡"
+    611,  // "¢"
+    618,  // "£"
+    626,  // "¤"
+    633,  // "¥"
+    640,  // "¦"
+    643,  // "§"
+    653,  // "¨"
+    655,  // "©"
+    664,  // "ª"
+    669,  // "«"
+    674,  // "¬"
+    677,  // "\u00AD" Soft Hyphen
+    679,  // "®"
+    688,  // "¯"
+    690,  // "°"
+    693,  // "±"
+    699,  // "²"
+    702,  // "³"
+    705,  // "´"
+    707,  // "µ"
+    717,  // "¶"
+    728,  // "·"
+    730,  // "¸"
+    732,  // "¹"
+    735,  // "º"
+    740,  // "»"
+    745,  // "¼"
+    758,  // "½"
+    771,  // "¾"
+    784,  // "¿"
+    791,  // "À"
+    803,  // "Á"
+    815,  // "Â"
+    827,  // "Ã"
+    839,  // "Ä"
+    850,  // "Å"
+    861,  // "Æ"
+    874,  // "Ç"
+    884,  // "È"
+    893,  // "É"
+    902,  // "Ê"
+    911,  // "Ë"
+    919,  // "Ì"
+    923,  // "Í"
+    927,  // "Î"
+    933,  // "Ï"
+    939,  // "Ð"
+    948,  // "Ñ"
+    958,  // "Ò"
+    968,  // "Ó"
+    978,  // "Ô"
+    988,  // "Õ"
+    998,  // "Ö"
+    1008, // "×"
+    1013, // "Ø"
+    1023, // "Ù"
+    1033, // "Ú"
+    1043, // "Û"
+    1053, // "Ü"
+    1063, // "Ý"
+    1072, // "Þ"
+    1079, // "ß"
+    1087, // "à"
+    1095, // "á"
+    1103, // "â"
+    1111, // "ã"
+    1119, // "ä"
+    1126, // "å"
+    1135, // "æ"
+    1144, // "ç"
+    1151, // "è"
+    1159, // "é"
+    1167, // "ê"
+    1175, // "ë"
+    1182, // "ì"
+    1186, // "í"
+    1190, // "î"
+    1195, // "ï"
+    1200, // "ð"
+    1207, // "ñ"
+    1215, // "ò"
+    1223, // "ó"
+    1231, // "ô"
+    1239, // "õ"
+    1247, // "ö"
+    1254, // "÷"
+    1259, // "ø"
+    1267, // "ù"
+    1275, // "ú"
+    1283, // "û"
+    1291, // "ü"
+    1298, // "ý"
+    1309, // "þ"
+    1317, // "ÿ"
+    1327, // "Œ"
+    1340, // "œ"
+    1349, // "‘"
+    1351, // "’"
+    1353, // "‚"
+    1355, // "‛"
+    1357, // "“"
+    1360, // "”"
+    1363, // "„"
+    1366, // "‟"
+    1369, // "†"
+    1376, // "‡"
+    1384, // "•"
+    1390, // "€"
+    1399, // "\uE700" Battery_05
+    1409, // "\uE701" Battery_25
+    1419, // "\uE702" Battery_50
+    1429, // "\uE703" Battery_75
+    1439, // "\uE704" Battery_99
+    1449, // "\uE705" Radio_3
+    1462, // "\uE706" Radio_2
+    1475, // "\uE707" Radio_1
+    1488, // "\uE708" Radio_0
+    1501, // "\uE709" Radio_Off
+    1514, // "\uE70A" Shift_Arrow
+    1522, // "\uE70B" Backspace_Symbol
+    1538, // "\uE70C" Enter_Symbol
+    1550, // "�"
+];
+
+/// Glyph metrics; sort matches METRICS_OFFSET.
+/// Record format: ((advance as u16) << 16) | (left bearing as i16 as u16)
+const METRICS: [u32; 219] = [
+    0x00060000,  // " "
+    0x00040000,  // "!"
+    0x00080000,  // "\""
+    0x00100000,  // "#"
+    0x000C0000,  // "$"
+    0x00100000,  // "%"
+    0x00120000,  // "&"
+    0x00040000,  // "'"
+    0x00080000,  // "("
+    0x00080000,  // ")"
+    0x000E0000,  // "*"
+    0x000C0000,  // "+"
+    0x00060000,  // ","
+    0x000C0000,  // "-"
+    0x00040000,  // "."
+    0x000C0000,  // "/"
+    0x000E0000,  // "0"
+    0x00060000,  // "1"
+    0x000E0000,  // "2"
+    0x000E0000,  // "3"
+    0x00100000,  // "4"
+    0x000E0000,  // "5"
+    0x000E0000,  // "6"
+    0x000E0000,  // "7"
+    0x000E0000,  // "8"
+    0x000E0000,  // "9"
+    0x00040000,  // ":"
+    0x00060000,  // ";"
+    0x000A0000,  // "<"
+    0x000C0000,  // "="
+    0x000A0000,  // ">"
+    0x000E0000,  // "?"
+    0x00120000,  // "@"
+    0x00100000,  // "A"
+    0x000E0000,  // "B"
+    0x000E0000,  // "C"
+    0x000E0000,  // "D"
+    0x000C0000,  // "E"
+    0x000C0000,  // "F"
+    0x000E0000,  // "G"
+    0x000E0000,  // "H"
+    0x00040000,  // "I"
+    0x000E0000,  // "J"
+    0x000E0000,  // "K"
+    0x000C0000,  // "L"
+    0x00100000,  // "M"
+    0x000E0000,  // "N"
+    0x000E0000,  // "O"
+    0x000E0000,  // "P"
+    0x000E0000,  // "Q"
+    0x000E0000,  // "R"
+    0x000E0000,  // "S"
+    0x00120000,  // "T"
+    0x000E0000,  // "U"
+    0x00100000,  // "V"
+    0x00140000,  // "W"
+    0x000C0000,  // "X"
+    0x000C0000,  // "Y"
+    0x000C0000,  // "Z"
+    0x00060000,  // "["
+    0x000C0000,  // "\\"
+    0x00060000,  // "]"
+    0x000A0000,  // "^"
+    0x00120000,  // "_"
+    0x00060000,  // "`"
+    0x000C0000,  // "a"
+    0x000C0000,  // "b"
+    0x000C0000,  // "c"
+    0x000C0000,  // "d"
+    0x000C0000,  // "e"
+    0x000A0000,  // "f"
+    0x000C0000,  // "g"
+    0x000C0000,  // "h"
+    0x00040000,  // "i"
+    0x000A0000,  // "j"
+    0x000C0000,  // "k"
+    0x00040000,  // "l"
+    0x00140000,  // "m"
+    0x000C0000,  // "n"
+    0x000C0000,  // "o"
+    0x000C0000,  // "p"
+    0x000C0000,  // "q"
+    0x000C0000,  // "r"
+    0x000C0000,  // "s"
+    0x000A0000,  // "t"
+    0x000C0000,  // "u"
+    0x000C0000,  // "v"
+    0x00140000,  // "w"
+    0x000C0000,  // "x"
+    0x000E0000,  // "y"
+    0x000C0000,  // "z"
+    0x00080000,  // "{"
+    0x00040000,  // "|"
+    0x00080000,  // "}"
+    0x000E0000,  // "~"
+    0x00060000,  // "\u00A0" No-Break Space
+    0x00040000,  // "¡"
+    0x000C0000,  // "¢"
+    0x000E0000,  // "£"
+    0x000E0000,  // "¤"
+    0x000C0000,  // "¥"
+    0x00040000,  // "¦"
+    0x000E0000,  // "§"
+    0x00080000,  // "¨"
+    0x00120000,  // "©"
+    0x000A0000,  // "ª"
+    0x000E0000,  // "«"
+    0x000C0000,  // "¬"
+    0x000C0000,  // "\u00AD" Soft Hyphen
+    0x00120000,  // "®"
+    0x00080000,  // "¯"
+    0x000A0000,  // "°"
+    0x000C0000,  // "±"
+    0x00080000,  // "²"
+    0x00080000,  // "³"
+    0x00060000,  // "´"
+    0x00120000,  // "µ"
+    0x00100000,  // "¶"
+    0x00040000,  // "·"
+    0x00060000,  // "¸"
+    0x00080000,  // "¹"
+    0x000A0000,  // "º"
+    0x000E0000,  // "»"
+    0x00140000,  // "¼"
+    0x00140000,  // "½"
+    0x00140000,  // "¾"
+    0x000E0000,  // "¿"
+    0x00100000,  // "À"
+    0x00100000,  // "Á"
+    0x00100000,  // "Â"
+    0x00100000,  // "Ã"
+    0x00100000,  // "Ä"
+    0x00100000,  // "Å"
+    0x00160000,  // "Æ"
+    0x000E0000,  // "Ç"
+    0x000C0000,  // "È"
+    0x000C0000,  // "É"
+    0x000C0000,  // "Ê"
+    0x000C0000,  // "Ë"
+    0x00060000,  // "Ì"
+    0x00060000,  // "Í"
+    0x00080000,  // "Î"
+    0x00080000,  // "Ï"
+    0x00120000,  // "Ð"
+    0x000E0000,  // "Ñ"
+    0x000E0000,  // "Ò"
+    0x000E0000,  // "Ó"
+    0x000E0000,  // "Ô"
+    0x000E0000,  // "Õ"
+    0x000E0000,  // "Ö"
+    0x000C0000,  // "×"
+    0x00120000,  // "Ø"
+    0x000E0000,  // "Ù"
+    0x000E0000,  // "Ú"
+    0x000E0000,  // "Û"
+    0x000E0000,  // "Ü"
+    0x000C0000,  // "Ý"
+    0x000C0000,  // "Þ"
+    0x000E0000,  // "ß"
+    0x000C0000,  // "à"
+    0x000C0000,  // "á"
+    0x000C0000,  // "â"
+    0x000C0000,  // "ã"
+    0x000C0000,  // "ä"
+    0x000C0000,  // "å"
+    0x00140000,  // "æ"
+    0x000C0000,  // "ç"
+    0x000C0000,  // "è"
+    0x000C0000,  // "é"
+    0x000C0000,  // "ê"
+    0x000C0000,  // "ë"
+    0x00060000,  // "ì"
+    0x00060000,  // "í"
+    0x00080000,  // "î"
+    0x00080000,  // "ï"
+    0x000C0000,  // "ð"
+    0x000C0000,  // "ñ"
+    0x000C0000,  // "ò"
+    0x000C0000,  // "ó"
+    0x000C0000,  // "ô"
+    0x000C0000,  // "õ"
+    0x000C0000,  // "ö"
+    0x000C0000,  // "÷"
+    0x00100000,  // "ø"
+    0x000C0000,  // "ù"
+    0x000C0000,  // "ú"
+    0x000C0000,  // "û"
+    0x000C0000,  // "ü"
+    0x000E0000,  // "ý"
+    0x000C0000,  // "þ"
+    0x00120000,  // "ÿ"
+    0x00160000,  // "Œ"
+    0x00140000,  // "œ"
+    0x00060000,  // "‘"
+    0x00060000,  // "’"
+    0x00060000,  // "‚"
+    0x00060000,  // "‛"
+    0x000C0000,  // "“"
+    0x000C0000,  // "”"
+    0x000C0000,  // "„"
+    0x000C0000,  // "‟"
+    0x000C0000,  // "†"
+    0x000C0000,  // "‡"
+    0x000E0000,  // "•"
+    0x00120000,  // "€"
+    0x001A0000,  // "\uE700" Battery_05
+    0x001A0000,  // "\uE701" Battery_25
+    0x001A0000,  // "\uE702" Battery_50
+    0x001A0000,  // "\uE703" Battery_75
+    0x001A0000,  // "\uE704" Battery_99
+    0x00170000,  // "\uE705" Radio_3
+    0x00170000,  // "\uE706" Radio_2
+    0x00170000,  // "\uE707" Radio_1
+    0x00170000,  // "\uE708" Radio_0
+    0x00170000,  // "\uE709" Radio_Off
+    0x000C0000,  // "\uE70A" Shift_Arrow
+    0x001C0000,  // "\uE70B" Backspace_Symbol
+    0x001A0000,  // "\uE70C" Enter_Symbol
+    0x00160000,  // "�"
+];
+
+/// Return the kerning adjustment in pixels to add to the advance of the glyph
+/// with its blit pattern at DATA[left] when the glyph at DATA[right] follows it.
+/// Negative adjustments move the glyphs closer together.
+pub fn get_kerning(left: usize, right: usize) -> isize {
+    let key = ((left as u32) << 16) | right as u32;
+    match KERN_KEY.binary_search(&key) {
+        Ok(index) => KERN_ADJUST[index] as isize,
+        _ => 0,
+    }
+}
+
+/// Kerning pairs as ((left DATA offset) << 16) | (right DATA offset), sorted
+const KERN_KEY: [u32; 53] = [
+    0x000500BF,  // "\"" "A"
+    0x002800BF,  // "'" "A"
+    0x00BF0005,  // "A" "\""
+    0x00BF0028,  // "A" "'"
+    0x00BF0153,  // "A" "T"
+    0x00BF0164,  // "A" "V"
+    0x00BF0180,  // "A" "Y"
+    0x00BF023D,  // "A" "y"
+    0x00E70040,  // "F" ","
+    0x00E70044,  // "F" "."
+    0x00E700BF,  // "F" "A"
+    0x01110005,  // "L" "\""
+    0x01110028,  // "L" "'"
+    0x01110153,  // "L" "T"
+    0x01110164,  // "L" "V"
+    0x0111016D,  // "L" "W"
+    0x01110180,  // "L" "Y"
+    0x0111023D,  // "L" "y"
+    0x01310040,  // "P" ","
+    0x01310044,  // "P" "."
+    0x01530040,  // "T" ","
+    0x01530042,  // "T" "-"
+    0x01530044,  // "T" "."
+    0x0153009B,  // "T" ":"
+    0x0153009D,  // "T" ";"
+    0x015300BF,  // "T" "A"
+    0x015301A5,  // "T" "a"
+    0x015301B2,  // "T" "c"
+    0x015301BF,  // "T" "e"
+    0x015301FC,  // "T" "o"
+    0x01530210,  // "T" "r"
+    0x01530216,  // "T" "s"
+    0x01530222,  // "T" "u"
+    0x0153022E,  // "T" "w"
+    0x0153023D,  // "T" "y"
+    0x01640040,  // "V" ","
+    0x01640044,  // "V" "."
+    0x016400BF,  // "V" "A"
+    0x016D0040,  // "W" ","
+    0x016D0044,  // "W" "."
+    0x01800040,  // "Y" ","
+    0x01800044,  // "Y" "."
+    0x018000BF,  // "Y" "A"
+    0x01C50040,  // "f" ","
+    0x01C50044,  // "f" "."
+    0x02100040,  // "r" ","
+    0x02100044,  // "r" "."
+    0x02280040,  // "v" ","
+    0x02280044,  // "v" "."
+    0x022E0040,  // "w" ","
+    0x022E0044,  // "w" "."
+    0x023D0040,  // "y" ","
+    0x023D0044,  // "y" "."
+];
+
+/// Kerning adjustments in pixels; sort matches KERN_KEY
+const KERN_ADJUST: [i8; 53] = [
+    -2,   // "\"" "A"
+    -2,   // "'" "A"
+    -2,   // "A" "\""
+    -2,   // "A" "'"
+    -2,   // "A" "T"
+    -2,   // "A" "V"
+    -2,   // "A" "Y"
+    -2,   // "A" "y"
+    -4,   // "F" ","
+    -4,   // "F" "."
+    -2,   // "F" "A"
+    -4,   // "L" "\""
+    -4,   // "L" "'"
+    -2,   // "L" "T"
+    -2,   // "L" "V"
+    -2,   // "L" "W"
+    -2,   // "L" "Y"
+    -2,   // "L" "y"
+    -6,   // "P" ","
+    -4,   // "P" "."
+    -4,   // "T" ","
+    -2,   // "T" "-"
+    -2,   // "T" "."
+    -2,   // "T" ":"
+    -4,   // "T" ";"
+    -2,   // "T" "A"
+    -2,   // "T" "a"
+    -2,   // "T" "c"
+    -2,   // "T" "e"
+    -2,   // "T" "o"
+    -2,   // "T" "r"
+    -2,   // "T" "s"
+    -2,   // "T" "u"
+    -2,   // "T" "w"
+    -4,   // "T" "y"
+    -4,   // "V" ","
+    -2,   // "V" "."
+    -2,   // "V" "A"
+    -2,   // "W" ","
+    -2,   // "W" "."
+    -2,   // "Y" ","
+    -2,   // "Y" "."
+    -2,   // "Y" "A"
+    -2,   // "f" ","
+    -2,   // "f" "."
+    -4,   // "r" ","
+    -4,   // "r" "."
+    -2,   // "v" ","
+    -2,   // "v" "."
+    -2,   // "w" ","
+    -2,   // "w" "."
+    -2,   // "y" ","
+    -2,   // "y" "."
+];
+
 /// Packed glyph pattern data.
 /// Record format:
 ///  [offset+0]: ((w as u8) << 16) | ((h as u8) << 8) | (yOffset as u8)